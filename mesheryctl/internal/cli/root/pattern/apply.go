@@ -7,12 +7,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/asaskevich/govalidator"
+	"github.com/ghodss/yaml"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	"github.com/layer5io/meshery/server/models"
@@ -23,7 +26,10 @@ import (
 )
 
 var (
-	skipSave    bool // skip saving a pattern
+	skipSave    bool     // skip saving a pattern
+	atomic      bool     // roll back the pattern if any of its services fail
+	setInputs   []string // inputs of the pattern as <input>=<value>
+	valuesFile  string   // yaml file with the inputs of the pattern
 	patternFile string
 )
 
@@ -38,6 +44,12 @@ mesheryctl pattern apply -f [file | URL]
 // deploy a saved pattern
 mesheryctl pattern apply [pattern-name]
 
+// roll back the already deployed services if any of the services fail
+mesheryctl pattern apply -f [file | URL] --atomic
+
+// supply the inputs of the pattern, --set takes precedence over the values file
+mesheryctl pattern apply -f [file | URL] --values [values.yaml] --set replicas=3
+
 ! Refer below image link for usage
 * Usage of mesheryctl pattern apply
 # ![pattern-apply-usage](/assets/img/mesheryctl/patternApply.png)
@@ -54,6 +66,15 @@ mesheryctl pattern apply [pattern-name]
 		}
 
 		deployURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern/deploy"
+		deployQuery := url.Values{}
+
+		inputs, err := patternInputs(valuesFile, setInputs)
+		if err != nil {
+			return err
+		}
+		for _, input := range inputs {
+			deployQuery.Add("set", input)
+		}
 		patternURL := mctlCfg.GetBaseMesheryURL() + "/api/pattern"
 
 		// pattern name has been passed
@@ -99,6 +120,9 @@ mesheryctl pattern apply [pattern-name]
 				index = multiplePatternsConfirmation(response.Patterns)
 				patternFile = response.Patterns[index].PatternFile
 			}
+			if response.Patterns[index].ID != nil {
+				deployQuery.Set("patternID", response.Patterns[index].ID.String())
+			}
 		} else {
 			// Method to check if the entered file is a URL or not
 			if validURL := govalidator.IsURL(file); !validURL {
@@ -218,6 +242,13 @@ mesheryctl pattern apply [pattern-name]
 			}
 		}
 
+		if atomic {
+			deployQuery.Set("atomic", "true")
+		}
+		if len(deployQuery) > 0 {
+			deployURL += "?" + deployQuery.Encode()
+		}
+
 		req, err = utils.NewRequest("POST", deployURL, bytes.NewBuffer([]byte(patternFile)))
 		if err != nil {
 			return err
@@ -250,6 +281,61 @@ mesheryctl pattern apply [pattern-name]
 	},
 }
 
+// patternInputs merges the inputs from the values file with the ones passed
+// with --set into <input>=<value> pairs, --set overrides the values file
+func patternInputs(valuesFile string, set []string) ([]string, error) {
+	values := map[string]interface{}{}
+	if valuesFile != "" {
+		content, err := os.ReadFile(valuesFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read the values file")
+		}
+		if err := yaml.Unmarshal(content, &values); err != nil {
+			return nil, errors.Wrap(err, "failed to parse the values file")
+		}
+	}
+
+	for _, kv := range set {
+		pair := strings.SplitN(kv, "=", 2)
+		if len(pair) != 2 || pair[0] == "" {
+			return nil, errors.Errorf("invalid input %q, expected <input>=<value>", kv)
+		}
+		values[pair[0]] = pair[1]
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inputs := make([]string, 0, len(names))
+	for _, name := range names {
+		value, err := formatPatternInput(values[name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid input %q", name)
+		}
+		inputs = append(inputs, name+"="+value)
+	}
+
+	return inputs, nil
+}
+
+// formatPatternInput formats the value of an input from the values file the way it
+// is written in the file, the numbers of the values file are parsed as float64
+func formatPatternInput(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		return "", errors.Errorf("expected a string, a number or a boolean, got %v", value)
+	}
+}
+
 func multiplePatternsConfirmation(profiles []models.MesheryPattern) int {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -284,4 +370,7 @@ func multiplePatternsConfirmation(profiles []models.MesheryPattern) int {
 func init() {
 	applyCmd.Flags().StringVarP(&file, "file", "f", "", "Path to pattern file")
 	applyCmd.Flags().BoolVarP(&skipSave, "skip-save", "", false, "Skip saving a pattern")
+	applyCmd.Flags().BoolVarP(&atomic, "atomic", "", false, "Roll back the deployed services if any service of the pattern fails")
+	applyCmd.Flags().StringArrayVarP(&setInputs, "set", "", []string{}, "Set an input of the pattern as <input>=<value>, can be repeated")
+	applyCmd.Flags().StringVarP(&valuesFile, "values", "", "", "Path to a yaml file with the inputs of the pattern")
 }
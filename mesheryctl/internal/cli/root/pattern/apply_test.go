@@ -2,6 +2,7 @@ package pattern
 
 import (
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"testing"
 
@@ -129,3 +130,59 @@ func TestApplyCmd(t *testing.T) {
 	// stop mock server
 	utils.StopMockery(t)
 }
+
+func TestPatternInputs(t *testing.T) {
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	fixturesDir := filepath.Join(filepath.Dir(filename), "fixtures")
+	valuesFile := filepath.Join(fixturesDir, "values.golden")
+
+	tests := []struct {
+		Name        string
+		ValuesFile  string
+		Set         []string
+		Expected    []string
+		ExpectError bool
+	}{
+		{
+			Name:       "Inputs from the values file",
+			Expected:   []string{"namespace=web", "replicas=2"},
+			ValuesFile: valuesFile,
+		},
+		{
+			Name:       "Inputs from --set override the values file",
+			ValuesFile: valuesFile,
+			Set:        []string{"replicas=3", "env=prod=eu"},
+			Expected:   []string{"env=prod=eu", "namespace=web", "replicas=3"},
+		},
+		{
+			Name:        "Invalid input",
+			Set:         []string{"replicas"},
+			ExpectError: true,
+		},
+		{
+			Name:       "Numbers and booleans are passed as written in the values file",
+			ValuesFile: filepath.Join(fixturesDir, "values.types.golden"),
+			Expected:   []string{"canary=true", "namespace=web", "ratio=0.5", "replicas=1000000"},
+		},
+		{
+			Name:        "Values which are not scalars",
+			ValuesFile:  filepath.Join(fixturesDir, "values.nested.golden"),
+			ExpectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			got, err := patternInputs(tt.ValuesFile, tt.Set)
+			if (err != nil) != tt.ExpectError {
+				t.Fatalf("patternInputs() error = %v, ExpectError %v", err, tt.ExpectError)
+			}
+			if !tt.ExpectError && !reflect.DeepEqual(got, tt.Expected) {
+				t.Errorf("patternInputs() = %v, want %v", got, tt.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package pattern
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	exportFormat string // format of the exported pattern
+	exportOutput string // file the exported pattern is written to
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export [pattern-name | ID]",
+	Short: "Export a pattern to kubernetes manifests, a helm chart or a kustomize base",
+	Long:  `Renders the components of the pattern into plain kubernetes objects which can be applied without Meshery`,
+	Args:  cobra.MinimumNArgs(1),
+	Example: `
+// export a pattern as a kubernetes manifest
+mesheryctl pattern export [pattern-name | ID]
+
+// export a pattern as a helm chart
+mesheryctl pattern export [pattern-name | ID] --format helm
+
+// export a pattern as a kustomize base to the given file
+mesheryctl pattern export [pattern-name | ID] --format kustomize -o base.tar.gz
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		switch exportFormat {
+		case "manifest", "helm", "kustomize":
+		default:
+			return errors.Errorf("invalid format %q, supported formats are manifest, helm and kustomize", exportFormat)
+		}
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		patternID, err := getPatternID(baseURL, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+
+		req, err := utils.NewRequest("GET", baseURL+"/api/pattern/"+patternID+"/export?format="+url.QueryEscape(exportFormat), nil)
+		if err != nil {
+			return err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return errors.Wrap(err, "failed to read response body")
+		}
+		if res.StatusCode != http.StatusOK {
+			return errors.Errorf("failed to export the pattern: %s", strings.TrimSpace(string(body)))
+		}
+
+		output := exportOutput
+		if output == "" {
+			output = exportFileName(res.Header.Get("Content-Disposition"), patternID)
+		}
+		if err := os.WriteFile(output, body, 0644); err != nil {
+			return errors.Wrap(err, "failed to write the exported pattern")
+		}
+
+		utils.Log.Info(fmt.Sprintf("pattern exported to %s", output))
+		return nil
+	},
+}
+
+// exportFileName returns the file name suggested by the server, falling back to the pattern ID
+func exportFileName(contentDisposition, patternID string) string {
+	if _, params, err := mime.ParseMediaType(contentDisposition); err == nil && params["filename"] != "" {
+		return filepath.Base(params["filename"])
+	}
+
+	if exportFormat == "manifest" {
+		return patternID + ".yaml"
+	}
+	return patternID + ".tgz"
+}
+
+func init() {
+	exportCmd.Flags().StringVarP(&exportFormat, "format", "", "manifest", "Format of the export: manifest, helm or kustomize")
+	exportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "File to write the exported pattern to, named after the pattern by default")
+}
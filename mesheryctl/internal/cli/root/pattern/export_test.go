@@ -0,0 +1,104 @@
+package pattern
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestPatternExport(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+	outDir := t.TempDir()
+
+	tests := []struct {
+		Name             string
+		Args             []string
+		URL              string
+		Response         string
+		ExpectedFile     string
+		ExpectedResponse string
+		ExpectErr        bool
+	}{
+		{
+			Name:             "Export a pattern as a kubernetes manifest",
+			Args:             []string{"export", "9e4c2a75-0b4e-4f4a-8f7f-6f1f7b3c5a21", "-o", filepath.Join(outDir, "shop.yaml")},
+			URL:              testContext.BaseURL + "/api/pattern/9e4c2a75-0b4e-4f4a-8f7f-6f1f7b3c5a21/export?format=manifest",
+			Response:         "export.manifest.response.golden",
+			ExpectedFile:     filepath.Join(outDir, "shop.yaml"),
+			ExpectedResponse: "pattern exported to " + filepath.Join(outDir, "shop.yaml") + "\n",
+		},
+		{
+			Name:      "Export a pattern in an invalid format",
+			Args:      []string{"export", "9e4c2a75-0b4e-4f4a-8f7f-6f1f7b3c5a21", "--format", "terraform"},
+			ExpectErr: true,
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			exportFormat = "manifest"
+			exportOutput = ""
+
+			var apiResponse string
+			if tt.Response != "" {
+				apiResponse = utils.NewGoldenFile(t, tt.Response, fixturesDir).Load()
+				httpmock.RegisterResponder("GET", tt.URL, func(req *http.Request) (*http.Response, error) {
+					res := httpmock.NewStringResponse(200, apiResponse)
+					res.Header.Set("Content-Disposition", `attachment; filename="shop.yaml"`)
+					return res, nil
+				})
+			}
+
+			// set token
+			utils.TokenFlag = filepath.Join(fixturesDir, "token.golden")
+
+			// Grab the logs
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+			PatternCmd.SetArgs(tt.Args)
+			PatternCmd.SetOutput(os.Stdout)
+			err := PatternCmd.Execute()
+
+			if tt.ExpectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			utils.Equals(t, tt.ExpectedResponse, b.String())
+
+			exported, err := os.ReadFile(tt.ExpectedFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			utils.Equals(t, apiResponse, string(exported))
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -30,6 +30,12 @@ mesheryctl pattern view [pattern name | ID]
 
 // List all patterns
 mesheryctl pattern list
+
+// Display the drift of a deployed pattern
+mesheryctl pattern status [pattern name | ID]
+
+// Export a pattern as a helm chart
+mesheryctl pattern export [pattern name | ID] --format helm
 	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
@@ -45,6 +51,6 @@ mesheryctl pattern list
 func init() {
 	PatternCmd.PersistentFlags().StringVarP(&utils.TokenFlag, "token", "t", "", "Path to token file default from current context")
 
-	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, viewCmd, listCmd}
+	availableSubcommands = []*cobra.Command{applyCmd, deleteCmd, viewCmd, listCmd, statusCmd, exportCmd}
 	PatternCmd.AddCommand(availableSubcommands...)
 }
@@ -0,0 +1,186 @@
+package pattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var reconcile bool // deploy the pattern again if it drifted
+
+var statusCmd = &cobra.Command{
+	Use:   "status [pattern-name | ID]",
+	Short: "Display the drift of a deployed pattern",
+	Long:  `Compares the last deployment of the pattern with the state of the clusters as observed by MeshSync`,
+	Args:  cobra.MinimumNArgs(1),
+	Example: `
+// view the drift of a deployed pattern
+mesheryctl pattern status [pattern-name | ID]
+
+// deploy the pattern again if it drifted
+mesheryctl pattern status [pattern-name | ID] --reconcile
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return errors.Wrap(err, "error processing config")
+		}
+		baseURL := mctlCfg.GetBaseMesheryURL()
+
+		patternID, err := getPatternID(baseURL, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+
+		// The deployments are ordered by the time they started, latest first
+		query := url.Values{}
+		query.Set("pattern_id", patternID)
+		query.Set("page_size", "1")
+		var deployments models.PatternDeploymentsAPIResponse
+		if err := getJSON(baseURL+"/api/pattern/deployments?"+query.Encode(), &deployments); err != nil {
+			return err
+		}
+		if len(deployments.Deployments) == 0 || deployments.Deployments[0].IsDelete {
+			utils.Log.Info("pattern is not deployed")
+			return nil
+		}
+		deploymentID := deployments.Deployments[0].ID.String()
+
+		var report models.PatternDriftReport
+		if err := getJSON(baseURL+"/api/pattern/deployments/"+deploymentID+"/drift", &report); err != nil {
+			return err
+		}
+
+		printPatternDrift(&report)
+
+		if !reconcile || report.Status != models.PatternDrifted {
+			return nil
+		}
+
+		req, err := utils.NewRequest("POST", baseURL+"/api/pattern/deployments/"+deploymentID+"/reconcile", nil)
+		if err != nil {
+			return err
+		}
+		s := utils.CreateDefaultSpinner("Reconciling pattern "+report.PatternName, "")
+		s.Start()
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			s.Stop()
+			return err
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		s.Stop()
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			return errors.Errorf("failed to reconcile the pattern: %s", strings.TrimSpace(string(body)))
+		}
+
+		utils.Log.Info("pattern successfully reconciled")
+		utils.Log.Info(string(body))
+		return nil
+	},
+}
+
+// getPatternID returns the ID of the pattern with the given name or ID
+func getPatternID(baseURL, pattern string) (string, error) {
+	isID, err := regexp.MatchString("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$", pattern)
+	if err != nil {
+		return "", err
+	}
+	if isID {
+		return pattern, nil
+	}
+
+	var response models.PatternsAPIResponse
+	if err := getJSON(baseURL+"/api/pattern?search="+url.QueryEscape(pattern), &response); err != nil {
+		return "", err
+	}
+
+	index := 0
+	if len(response.Patterns) == 0 {
+		return "", errors.New("no patterns found with the given name")
+	} else if len(response.Patterns) > 1 {
+		// Multiple patterns with same name
+		index = multiplePatternsConfirmation(response.Patterns)
+	}
+	if response.Patterns[index].ID == nil {
+		return "", errors.New("pattern has no ID")
+	}
+
+	return response.Patterns[index].ID.String(), nil
+}
+
+func getJSON(url string, out interface{}) error {
+	req, err := utils.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response body")
+	}
+	// failsafe (bad api call)
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("Response Status Code %d, possible Server Error: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.Wrap(err, "failed to unmarshal response body")
+	}
+
+	return nil
+}
+
+func printPatternDrift(report *models.PatternDriftReport) {
+	utils.Log.Info(fmt.Sprintf("Pattern %s is %s\n", report.PatternName, strings.ReplaceAll(string(report.Status), "_", " ")))
+
+	rows := [][]string{}
+	for _, svc := range report.Services {
+		details := svc.Message
+		if len(svc.Fields) > 0 {
+			paths := make([]string, 0, len(svc.Fields))
+			for _, f := range svc.Fields {
+				paths = append(paths, f.Path)
+			}
+			details = strings.Join(paths, ", ")
+		}
+		rows = append(rows, []string{svc.Service, svc.Context, svc.Kind, svc.Name, string(svc.Status), details})
+	}
+	utils.PrintToTable([]string{"SERVICE", "CONTEXT", "KIND", "NAME", "STATUS", "DETAILS"}, rows)
+
+	if len(report.Orphans) == 0 {
+		return
+	}
+
+	utils.Log.Info("\nObjects without a matching pattern resource:")
+	rows = [][]string{}
+	for _, o := range report.Orphans {
+		rows = append(rows, []string{o.ResourceID, o.Context, o.Kind, o.Name, o.Namespace})
+	}
+	utils.PrintToTable([]string{"RESOURCE ID", "CONTEXT", "KIND", "NAME", "NAMESPACE"}, rows)
+}
+
+func init() {
+	statusCmd.Flags().BoolVarP(&reconcile, "reconcile", "", false, "Deploy the pattern again if it drifted")
+}
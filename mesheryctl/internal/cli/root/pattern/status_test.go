@@ -0,0 +1,110 @@
+package pattern
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+func TestPatternStatus(t *testing.T) {
+	// setup current context
+	utils.SetupContextEnv(t)
+
+	// initialize mock server for handling requests
+	utils.StartMockery(t)
+
+	// create a test helper
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures")
+
+	tests := []struct {
+		Name             string
+		Args             []string
+		ExpectedResponse string
+		URLs             []utils.MockURL
+		Token            string
+	}{
+		{
+			Name:             "Display the drift of a deployed pattern",
+			Args:             []string{"status", "9e4c2a75-0b4e-4f4a-8f7f-6f1f7b3c5a21"},
+			ExpectedResponse: "status.output.golden",
+			URLs: []utils.MockURL{
+				{
+					Method:       "GET",
+					URL:          testContext.BaseURL + "/api/pattern/deployments",
+					Response:     "status.deployments.response.golden",
+					ResponseCode: 200,
+				},
+				{
+					Method:       "GET",
+					URL:          testContext.BaseURL + "/api/pattern/deployments/6b9d7b3f-4b44-4a43-9d6a-2b8c1b0f1e10/drift",
+					Response:     "status.drift.response.golden",
+					ResponseCode: 200,
+				},
+			},
+			Token: filepath.Join(fixturesDir, "token.golden"),
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			for _, url := range tt.URLs {
+				apiResponse := utils.NewGoldenFile(t, url.Response, fixturesDir).Load()
+
+				// mock response
+				httpmock.RegisterResponder(url.Method, url.URL,
+					httpmock.NewStringResponder(url.ResponseCode, apiResponse))
+			}
+
+			// set token
+			utils.TokenFlag = tt.Token
+
+			// Expected response
+			testdataDir := filepath.Join(currDir, "testdata")
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+			_ = utils.SetupMeshkitLoggerTesting(t, false)
+			PatternCmd.SetArgs(tt.Args)
+			PatternCmd.SetOutput(rescueStdout)
+			err := PatternCmd.Execute()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			// response being printed in console
+			actualResponse := string(out)
+
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+
+			utils.Equals(t, expectedResponse, actualResponse)
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
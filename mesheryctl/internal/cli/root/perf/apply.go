@@ -1,16 +1,21 @@
 package perf
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/ghodss/yaml"
+	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
 	"github.com/layer5io/meshery/server/models"
@@ -34,6 +39,14 @@ var (
 	filePath           string
 	profileID          string
 	req                *http.Request
+	// SMP configuration the test is run with, if any
+	smpTestConfig *models.PerformanceTestConfigFile
+	// number of load test workers of the server the test is fanned out to
+	loadTestWorkers int
+	// size of the random payload of the requests
+	payloadSize int
+	// whether every request is sent on a new connection
+	disableConnectionReuse bool
 )
 
 var applyCmd = &cobra.Command{
@@ -49,8 +62,15 @@ mesheryctl perf apply meshery-profile --flags
 mesheryctl perf apply meshery-profile-new --url "https://google.com"
 
 // Run Performance test using SMP compatible test configuration
+// every endpoint of every client of the configuration is load tested at once
 mesheryctl perf apply -f perf-config.yaml
 
+// Split the load of the test across 4 load test workers of the Meshery server
+mesheryctl perf apply meshery-profile --url "https://google.com" --qps 4000 --workers 4
+
+// Run a TCP load test against an echo server behind the mesh with 1KB payloads
+mesheryctl perf apply tcp-echo --url tcp://echo.default.svc:8078 --qps 100 --payload-size 1024
+
 // Run performance test using SMP compatible test configuration and override values with flags
 mesheryctl perf apply -f [filepath] --flags
 
@@ -98,6 +118,7 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 			if testConfig.Config == nil || testConfig.ServiceMesh == nil {
 				return ErrInvalidTestConfigFile()
 			}
+			smpTestConfig = &testConfig
 
 			testClient := testConfig.Config.Clients[0]
 
@@ -239,8 +260,44 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 		if testMesh != "" {
 			q.Add("mesh", testMesh)
 		}
+
+		if loadTestWorkers > 0 {
+			q.Add("workers", strconv.Itoa(loadTestWorkers))
+		}
+
+		if payloadSize > 0 {
+			q.Add("payloadSize", strconv.Itoa(payloadSize))
+		}
+
+		if disableConnectionReuse {
+			q.Add("disableConnectionReuse", "true")
+		}
+		// the test is run under a uuid of its own so that it can be cancelled
+		testUUID, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		q.Add("uuid", testUUID.String())
 		req.URL.RawQuery = q.Encode()
 
+		// SMP configurations driving more than one endpoint are run as a whole
+		if smpTestConfig != nil && len(models.SMPClientEndpoints(smpTestConfig.Config.Clients)) > 1 {
+			smpTestConfig.Config.Id = profileID
+			smpTestConfig.Config.Name = testName
+			smpTestConfig.Config.Duration = testDuration
+			body, err := json.Marshal(smpTestConfig)
+			if err != nil {
+				return ErrFailMarshal(err)
+			}
+
+			req, err = utils.NewRequest("GET", req.URL.String(), bytes.NewBuffer(body))
+			if err != nil {
+				return err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			log.Debugf("running all the %d endpoints of the test configuration", len(models.SMPClientEndpoints(smpTestConfig.Config.Clients)))
+		}
+
 		utils.Log.Info("Initiating Performance test ...")
 
 		resp, err := utils.MakeRequest(req)
@@ -250,11 +307,72 @@ mesheryctl perf apply local-perf --url https://192.168.1.15/productpage --mesh i
 		}
 
 		defer utils.SafeClose(resp.Body)
-		data, err := io.ReadAll(resp.Body)
+
+		// Ctrl-C cancels the test on the server instead of leaving it running in the background
+		interrupted := make(chan os.Signal, 1)
+		signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+		defer signal.Stop(interrupted)
+		cancelled := make(chan struct{})
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-interrupted:
+				close(cancelled)
+				utils.Log.Info("Cancelling the performance test ...")
+				if err := cancelLoadTest(mctlCfg.GetBaseMesheryURL(), testUUID.String()); err != nil {
+					utils.Log.Error(err)
+					utils.SafeClose(resp.Body)
+				}
+			case <-done:
+			}
+		}()
+
+		// The test is streamed as server sent events, the results carry the SLO verdicts
+		breaches := []string{}
+		err = readLoadTestEvents(resp.Body, func(event models.LoadTestResponse) error {
+			switch event.Status {
+			case models.LoadTestProgress:
+				if event.Snapshot != nil {
+					utils.Log.Info(formatLoadTestSnapshot(event.Snapshot))
+				}
+			case models.LoadTestError:
+				select {
+				case <-cancelled:
+					return errLoadTestCancelled
+				default:
+				}
+				return ErrFailTestRun()
+			case models.LoadTestSuccess:
+				if event.Result == nil {
+					return nil
+				}
+				for _, line := range formatEndpointResults(event.Result.Result) {
+					utils.Log.Info(line)
+				}
+				verdict := event.Result.GetVerdict()
+				if verdict == nil {
+					return nil
+				}
+				utils.Log.Info("SLO verdict: ", verdict.Status)
+				for _, b := range verdict.Breaches {
+					breaches = append(breaches, b.Message)
+				}
+			}
+			return nil
+		})
+		select {
+		case <-cancelled:
+			utils.Log.Info("Performance test cancelled")
+			return nil
+		default:
+		}
 		if err != nil {
-			return errors.Wrap(err, utils.PerfError("failed to read response body"))
+			return err
+		}
+		if len(breaches) > 0 {
+			return ErrSLOBreached(breaches)
 		}
-		utils.Log.Debug(string(data))
 
 		utils.Log.Info("Test Completed Successfully!")
 		return nil
@@ -269,9 +387,80 @@ func init() {
 	applyCmd.Flags().StringVar(&concurrentRequests, "concurrent-requests", "", "(optional) Number of Parallel Requests")
 	applyCmd.Flags().StringVar(&testDuration, "duration", "", "(optional) Length of test (e.g. 10s, 5m, 2h). For more, see https://golang.org/pkg/time/#ParseDuration")
 	applyCmd.Flags().StringVar(&loadGenerator, "load-generator", "", "(optional) Load-Generator to be used (fortio/wrk2)")
+	applyCmd.Flags().IntVar(&loadTestWorkers, "workers", 0, "(optional) Number of load test workers of the Meshery server the load is split across")
+	applyCmd.Flags().IntVar(&payloadSize, "payload-size", 0, "(optional) Size in bytes of a random payload sent with every request, echoed back by tcp:// and udp:// endpoints")
+	applyCmd.Flags().BoolVar(&disableConnectionReuse, "disable-connection-reuse", false, "(optional) Open a new connection for every request")
 	applyCmd.Flags().StringVarP(&filePath, "file", "f", "", "(optional) file containing SMP-compatible test configuration. For more, see https://github.com/layer5io/service-mesh-performance-specification")
 }
 
+// errLoadTestCancelled stops reading the events of a load test cancelled by the user
+var errLoadTestCancelled = errors.New("load test cancelled")
+
+// readLoadTestEvents calls handle with the load test responses streamed by the server as
+// they arrive, until the stream is over or handle returns an error
+func readLoadTestEvents(r io.Reader, handle func(models.LoadTestResponse) error) error {
+	scanner := bufio.NewScanner(r)
+	// the results of a test are sent as a single event
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		utils.Log.Debug(line)
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		event := models.LoadTestResponse{}
+		if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &event); err != nil {
+			utils.Log.Debug("skipping malformed event: ", err)
+			continue
+		}
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+
+	return nil
+}
+
+// formatLoadTestSnapshot returns a line describing the progress of a running load test
+func formatLoadTestSnapshot(s *models.LoadTestSnapshot) string {
+	return fmt.Sprintf("[%3.0fs] %d requests, %.1f QPS, latency avg %.2fms p50 %.2fms p90 %.2fms p99 %.2fms max %.2fms",
+		s.Elapsed, s.Requests, s.QPS, s.LatencyAvg, s.LatencyP50, s.LatencyP90, s.LatencyP99, s.LatencyMax)
+}
+
+// formatEndpointResults returns a line for every endpoint of the results of a load test
+// driving multiple endpoints
+func formatEndpointResults(results map[string]interface{}) []string {
+	endpoints, _ := results[models.LoadTestEndpointsKey].([]interface{})
+	lines := make([]string, 0, len(endpoints))
+	for _, e := range endpoints {
+		res, _ := e.(map[string]interface{})
+		endpoint, _ := res["Endpoint"].(map[string]interface{})
+		actualQPS, _ := res["ActualQPS"].(float64)
+		lines = append(lines, fmt.Sprintf("Endpoint %v: %.1f QPS", endpoint["URL"], actualQPS))
+	}
+
+	return lines
+}
+
+// cancelLoadTest cancels the running load test with the given uuid
+func cancelLoadTest(baseURL, testUUID string) error {
+	req, err := utils.NewRequest("DELETE", baseURL+"/api/perf/run/"+testUUID, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return ErrCancelTestRun(err)
+	}
+	utils.SafeClose(resp.Body)
+
+	return nil
+}
+
 func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlConfig) (string, string, error) {
 	utils.Log.Debug("Creating new performance profile inside function")
 
@@ -318,17 +507,20 @@ func createPerformanceProfile(client *http.Client, mctlCfg *config.MesheryCtlCon
 		return "", "", errors.New("failed to convert qps")
 	}
 	values := map[string]interface{}{
-		"concurrent_request": convReq,
-		"duration":           testDuration,
-		"endpoints":          []string{testURL},
-		"load_generators":    []string{loadGenerator},
-		"name":               profileName,
-		"qps":                convQPS,
-		"service_mesh":       testMesh,
-		"request_body":       "",
-		"request_cookies":    "",
-		"request_headers":    "",
-		"content_type":       "",
+		"concurrent_request":       convReq,
+		"duration":                 testDuration,
+		"endpoints":                []string{testURL},
+		"load_generators":          []string{loadGenerator},
+		"name":                     profileName,
+		"qps":                      convQPS,
+		"service_mesh":             testMesh,
+		"request_body":             "",
+		"request_cookies":          "",
+		"request_headers":          "",
+		"content_type":             "",
+		"workers":                  loadTestWorkers,
+		"payload_size":             payloadSize,
+		"disable_connection_reuse": disableConnectionReuse,
 	}
 
 	jsonValue, err := json.Marshal(values)
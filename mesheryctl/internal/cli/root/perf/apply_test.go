@@ -1,12 +1,17 @@
 package perf
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"testing"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
 )
 
 var existingProfileID = "8f3daf25-e58e-4c59-8bf8-f474b76463ec"
@@ -26,6 +31,12 @@ var (
 	apply1005 = "1005.golden"
 	// server response for no profiles found
 	apply1006 = "1006.golden"
+	// server running test with existing profile breaching its SLO
+	apply1007 = "1007.golden"
+	// server running test with existing profile streaming its progress
+	apply1008 = "1008.golden"
+	// server running test with existing profile driving multiple endpoints
+	apply1009 = "1009.golden"
 )
 
 var (
@@ -41,6 +52,14 @@ var (
 	apply1005output = "1005.golden"
 	// mesheryctl response for no profiles found
 	apply1006output = "1006.golden"
+	// mesheryctl response for a test breaching the SLO
+	apply1007output = "1007.golden"
+	// mesheryctl response for a test streaming its progress
+	apply1008output = "1008.golden"
+	// mesheryctl response for a test driving multiple endpoints
+	apply1009output = "1009.golden"
+	// mesheryctl response for a test fanned out to load test workers
+	apply1010output = "1010.golden"
 )
 
 func TestApplyCmd(t *testing.T) {
@@ -70,6 +89,22 @@ func TestApplyCmd(t *testing.T) {
 			apply1001output,
 			testToken, false,
 		},
+		{"Run Test with Existing profile breaching its SLO", []string{"apply", "new"},
+			[]utils.MockURL{
+				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
+				{Method: "GET", URL: existingProfileRunTest, Response: apply1007, ResponseCode: 200},
+			},
+			apply1007output,
+			testToken, true,
+		},
+		{"Run Test with Existing profile streaming its progress", []string{"apply", "new"},
+			[]utils.MockURL{
+				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
+				{Method: "GET", URL: existingProfileRunTest, Response: apply1008, ResponseCode: 200},
+			},
+			apply1008output,
+			testToken, false,
+		},
 		{"Run Test with Existing profile with --url", []string{"apply", "new", "--url", "https://www.google.com"},
 			[]utils.MockURL{
 				{Method: "GET", URL: profileURL, Response: apply1001, ResponseCode: 200},
@@ -159,6 +194,109 @@ func TestApplyCmd(t *testing.T) {
 	utils.StopMockery(t)
 }
 
+func TestApplyCmdRequest(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	testContext := utils.NewTestHelper(t)
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures", "apply")
+	testToken := filepath.Join(currDir, "fixtures", "auth.json")
+	profileURL := testContext.BaseURL + "/api/user/performance/profiles"
+	existingProfileRunTest := testContext.BaseURL + "/api/user/performance/profiles/" + existingProfileID + "/run"
+	testdataDir := filepath.Join(currDir, "testdata", "apply")
+
+	// test scenarios checking the request running the test
+	tests := []struct {
+		Name             string
+		Args             []string
+		Response         string
+		ExpectedResponse string
+		Check            func(t *testing.T, req *http.Request, body []byte)
+	}{
+		{
+			Name:             "Run Test with Existing profile and SMP configuration driving multiple endpoints",
+			Args:             []string{"apply", "new", "-f", filepath.Join(fixturesDir, "perf-config.yaml")},
+			Response:         apply1009,
+			ExpectedResponse: apply1009output,
+			Check: func(t *testing.T, req *http.Request, body []byte) {
+				if req.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("got content type %q, want the SMP configuration as json", req.Header.Get("Content-Type"))
+				}
+				testConfig := models.PerformanceTestConfigFile{}
+				if err := json.Unmarshal(body, &testConfig); err != nil || testConfig.Config == nil {
+					t.Fatalf("request body %s is not a SMP configuration: %v", body, err)
+				}
+				got := []string{}
+				for _, e := range models.SMPClientEndpoints(testConfig.Config.Clients) {
+					got = append(got, e.URL)
+				}
+				want := []string{"https://meshery.io", "https://layer5.io", "https://docs.meshery.io"}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("got the endpoints %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			Name:             "Run Test with Existing profile fanned out to load test workers",
+			Args:             []string{"apply", "new", "--workers", "2"},
+			Response:         apply1002,
+			ExpectedResponse: apply1010output,
+			Check: func(t *testing.T, req *http.Request, body []byte) {
+				if got := req.URL.Query().Get("workers"); got != "2" {
+					t.Errorf("got workers %q, want the test fanned out to 2 workers", got)
+				}
+			},
+		},
+	}
+
+	// Run tests
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			profiles := utils.NewGoldenFile(t, apply1001, fixturesDir).Load()
+			httpmock.RegisterResponder("GET", profileURL, httpmock.NewStringResponder(200, profiles))
+
+			var req *http.Request
+			var body []byte
+			apiResponse := utils.NewGoldenFile(t, tt.Response, fixturesDir).Load()
+			httpmock.RegisterResponder("GET", existingProfileRunTest, func(r *http.Request) (*http.Response, error) {
+				req = r
+				if r.Body != nil {
+					body, _ = io.ReadAll(r.Body)
+				}
+				return httpmock.NewStringResponse(200, apiResponse), nil
+			})
+
+			utils.TokenFlag = testToken
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+
+			PerfCmd.SetArgs(tt.Args)
+			PerfCmd.SetOutput(b)
+			if err := PerfCmd.Execute(); err != nil {
+				t.Fatal(err)
+			}
+			if req == nil {
+				t.Fatal("the test was not run")
+			}
+			tt.Check(t, req, body)
+
+			actualResponse := b.String()
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+			utils.Equals(t, expectedResponse, actualResponse)
+			resetVariables()
+		})
+	}
+	utils.StopMockery(t)
+}
+
 func resetVariables() {
 	// reset the variables after each test
 	profileName = ""
@@ -173,4 +311,14 @@ func resetVariables() {
 	outputFormatFlag = ""
 	viewSingleProfile = false
 	viewSingleResult = false
+	baseResultID = ""
+	candidateResultID = ""
+	pinBaseline = false
+	smpTestConfig = nil
+	loadTestWorkers = 0
+	payloadSize = 0
+	disableConnectionReuse = false
+	exportFormat = ""
+	exportFrom = ""
+	exportTo = ""
 }
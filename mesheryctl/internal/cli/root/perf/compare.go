@@ -0,0 +1,216 @@
+package perf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/gofrs/uuid"
+
+	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/pkg/errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	baseResultID      string
+	candidateResultID string
+	pinBaseline       bool
+)
+
+var compareCmd = &cobra.Command{
+	Use:   "compare profile-name",
+	Short: "Compare performance test results",
+	Long:  `Compare a performance test result with a base result, or the pinned baseline of the profile, and detect regressions`,
+	Args:  cobra.MinimumNArgs(0),
+	Example: `
+// Compare a result with the pinned baseline of the profile
+mesheryctl perf compare saturday-profile --candidate 8f9a0d71-1c0e-4f7c-9a49-7c3e5f2b1d64
+
+// Compare two results of the profile
+mesheryctl perf compare saturday-profile --base 2b6e1a5f-4d3c-4a8b-8e5d-0f7a9c1b3e42 --candidate 8f9a0d71-1c0e-4f7c-9a49-7c3e5f2b1d64
+
+// Pin a result as the baseline which every new result of the profile is compared against
+mesheryctl perf compare saturday-profile --base 2b6e1a5f-4d3c-4a8b-8e5d-0f7a9c1b3e42 --pin
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// setting up for error formatting
+		cmdUsed = "compare"
+
+		mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+		if err != nil {
+			return ErrMesheryConfig(err)
+		}
+
+		// Throw error if a profile name is not provided
+		if len(args) == 0 {
+			return ErrNoProfileName()
+		}
+		if pinBaseline && baseResultID == "" {
+			return ErrInvalidComparison("a base result is required to pin a baseline")
+		}
+		if !pinBaseline && candidateResultID == "" {
+			return ErrInvalidComparison("a candidate result is required")
+		}
+		for _, id := range []string{baseResultID, candidateResultID} {
+			if id != "" && uuid.FromStringOrNil(id) == uuid.Nil {
+				return ErrInvalidComparison(fmt.Sprintf("%s is not a valid result id", id))
+			}
+		}
+
+		// handles spaces in args if quoted args passed
+		for i, arg := range args {
+			args[i] = strings.ReplaceAll(arg, " ", "%20")
+		}
+		// Merge args to get profile-name
+		searchString := strings.Join(args, "%20")
+
+		profiles, _, err := fetchPerformanceProfiles(mctlCfg.GetBaseMesheryURL(), searchString, pageSize, 0)
+		if err != nil {
+			return err
+		}
+		if len(profiles) == 0 {
+			utils.Log.Info("No Performance Profiles found with the given name")
+			return nil
+		}
+
+		index := 0
+		if len(profiles) > 1 {
+			// user prompt to select profile
+			index, err = userPrompt("profile", "Found multiple profiles with given name, select a profile", profilesToStringArrays(profiles))
+			if err != nil {
+				return err
+			}
+		}
+		profile := profiles[index]
+
+		if pinBaseline {
+			if err := pinPerformanceBaseline(mctlCfg.GetBaseMesheryURL(), profile, baseResultID); err != nil {
+				return err
+			}
+			utils.Log.Info("Pinned the result " + baseResultID + " as the baseline of the profile " + profile.Name)
+			if candidateResultID == "" {
+				return nil
+			}
+		}
+
+		comparison, body, err := fetchPerformanceComparison(mctlCfg.GetBaseMesheryURL(), profile.ID.String(), baseResultID, candidateResultID)
+		if err != nil {
+			return err
+		}
+
+		if outputFormatFlag != "" {
+			if outputFormatFlag == "yaml" {
+				body, _ = yaml.JSONToYAML(body)
+			} else if outputFormatFlag != "json" {
+				return ErrInvalidOutputChoice()
+			}
+			utils.Log.Info(string(body))
+		} else {
+			utils.PrintToTable([]string{"METRIC", "BASE", "CANDIDATE", "DELTA", "DELTA %"}, comparisonToStringArrays(comparison))
+			significance := "not significant"
+			if comparison.Significance.Significant {
+				significance = "significant"
+			}
+			utils.Log.Info(fmt.Sprintf("Latency change is %s (%s, p=%.4f)", significance, comparison.Significance.Method, comparison.Significance.PValue))
+		}
+
+		if comparison.Regressed {
+			return ErrPerformanceRegression(comparison.Reasons)
+		}
+		if outputFormatFlag == "" {
+			utils.Log.Info("No regression detected")
+		}
+		return nil
+	},
+}
+
+// Fetch the comparison of two results of a profile, the pinned baseline is used if the base is empty
+func fetchPerformanceComparison(baseURL, profileID, base, candidate string) (*models.PerformanceComparison, []byte, error) {
+	var response *models.PerformanceComparison
+
+	url := fmt.Sprintf("%s/api/user/performance/profiles/%s/compare?candidate=%s", baseURL, profileID, candidate)
+	if base != "" {
+		url += "&base=" + base
+	}
+
+	req, err := utils.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, nil, ErrFailUnmarshal(err)
+	}
+	return response, body, nil
+}
+
+// Pin the result as the baseline of the profile
+func pinPerformanceBaseline(baseURL string, profile models.PerformanceProfile, resultID string) error {
+	id := uuid.FromStringOrNil(resultID)
+	profile.BaselineResult = &id
+
+	jsonValue, err := json.Marshal(profile)
+	if err != nil {
+		return ErrFailMarshal(err)
+	}
+	req, err := utils.NewRequest("POST", baseURL+"/api/user/performance/profiles", bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return err
+	}
+
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	utils.Log.Debug("Baseline of the profile updated")
+	return nil
+}
+
+// change the comparison into string arrays for tabular format printing
+func comparisonToStringArrays(comparison *models.PerformanceComparison) [][]string {
+	var data [][]string
+
+	row := func(metric, format string, d models.MetricDelta) {
+		deltaPercent := "-"
+		if d.DeltaPercent != nil {
+			deltaPercent = fmt.Sprintf("%+.2f", *d.DeltaPercent)
+		}
+		data = append(data, []string{metric, fmt.Sprintf(format, d.Base), fmt.Sprintf(format, d.Candidate), fmt.Sprintf("%+"+strings.TrimPrefix(format, "%"), d.Delta), deltaPercent})
+	}
+
+	for _, p := range comparison.LatencyPercentiles {
+		row(fmt.Sprintf("P%g (ms)", p.Percentile), "%.3f", p.MetricDelta)
+	}
+	row("MEAN (ms)", "%.3f", comparison.MeanLatency)
+	row("QPS", "%.2f", comparison.QPS)
+	row("ERROR RATE", "%.4f", comparison.ErrorRate)
+
+	return data
+}
+
+func init() {
+	compareCmd.Flags().StringVarP(&baseResultID, "base", "", "", "(optional) id of the base result, defaults to the pinned baseline of the profile")
+	compareCmd.Flags().StringVarP(&candidateResultID, "candidate", "", "", "id of the result to compare against the base")
+	compareCmd.Flags().BoolVarP(&pinBaseline, "pin", "", false, "(optional) pin the base result as the baseline of the profile")
+}
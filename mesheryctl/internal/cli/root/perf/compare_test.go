@@ -0,0 +1,186 @@
+package perf
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
+)
+
+var (
+	tempBaseResultID      = "2b6e1a5f-4d3c-4a8b-8e5d-0f7a9c1b3e42"
+	tempCandidateResultID = "8f9a0d71-1c0e-4f7c-9a49-7c3e5f2b1d64"
+)
+
+// golden file responses
+var (
+	// standard api response for abhishek profile
+	compare1000 = "1000.golden"
+	// api response of a comparison without regression
+	compare1001 = "1001.golden"
+	// api response of a comparison with regression
+	compare1002 = "1002.golden"
+	// api response of the saved profile
+	compare1003 = "1003.golden"
+)
+
+// golden file mesheryctl outputs
+var (
+	// mesheryctl response of a comparison without regression
+	compare1001output = "1001.golden"
+	// mesheryctl response of a comparison with regression
+	compare1002output = "1002.golden"
+	// mesheryctl response when no candidate is passed
+	compare1003output = "1003.golden"
+	// mesheryctl response when pinning without a base
+	compare1004output = "1004.golden"
+	// mesheryctl response of a comparison in json output
+	compare1005output = "1005.golden"
+	// mesheryctl response of pinning a baseline
+	compare1006output = "1006.golden"
+)
+
+func TestCompareCmd(t *testing.T) {
+	utils.SetupContextEnv(t)
+	utils.StartMockery(t)
+	testContext := utils.NewTestHelper(t)
+
+	// get current directory
+	_, filename, _, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("Not able to get current working directory")
+	}
+	currDir := filepath.Dir(filename)
+	fixturesDir := filepath.Join(currDir, "fixtures", "compare")
+	testToken := filepath.Join(currDir, "fixtures", "auth.json")
+	testdataDir := filepath.Join(currDir, "testdata", "compare")
+
+	profileURL := testContext.BaseURL + "/api/user/performance/profiles"
+	compareURL := testContext.BaseURL + "/api/user/performance/profiles/" + tempProfileID + "/compare?candidate=" + tempCandidateResultID + "&base=" + tempBaseResultID
+
+	tests := []tempTestStruct{
+		{"standard comparison output", []string{"compare", "abhishek", "--base", tempBaseResultID, "--candidate", tempCandidateResultID}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: compare1000, ResponseCode: 200},
+			{Method: "GET", URL: compareURL, Response: compare1001, ResponseCode: 200},
+		}, compare1001output, testToken, false},
+		{"comparison with regression", []string{"compare", "abhishek", "--base", tempBaseResultID, "--candidate", tempCandidateResultID}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: compare1000, ResponseCode: 200},
+			{Method: "GET", URL: compareURL, Response: compare1002, ResponseCode: 200},
+		}, compare1002output, testToken, true},
+		{"No candidate passed", []string{"compare", "abhishek"}, []utils.MockURL{}, compare1003output, testToken, true},
+		{"Pin without base", []string{"compare", "abhishek", "--pin"}, []utils.MockURL{}, compare1004output, testToken, true},
+	}
+
+	testsforLogrusOutputs := []tempTestStruct{
+		{"comparison in json output", []string{"compare", "abhishek", "--base", tempBaseResultID, "--candidate", tempCandidateResultID, "-o", "json"}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: compare1000, ResponseCode: 200},
+			{Method: "GET", URL: compareURL, Response: compare1001, ResponseCode: 200},
+		}, compare1005output, testToken, false},
+		{"pin baseline", []string{"compare", "abhishek", "--base", tempBaseResultID, "--pin"}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: compare1000, ResponseCode: 200},
+			{Method: "POST", URL: profileURL, Response: compare1003, ResponseCode: 200},
+		}, compare1006output, testToken, false},
+	}
+
+	// Run tests in list format
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			utils.TokenFlag = tt.Token
+
+			for _, mock := range tt.URLs {
+				apiResponse := utils.NewGoldenFile(t, mock.Response, fixturesDir).Load()
+				httpmock.RegisterResponder(mock.Method, mock.URL,
+					httpmock.NewStringResponder(mock.ResponseCode, apiResponse))
+			}
+
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+			_ = utils.SetupMeshkitLoggerTesting(t, false)
+
+			// Grab console prints
+			rescueStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			PerfCmd.SetArgs(tt.Args)
+			PerfCmd.SetOutput(rescueStdout)
+			err := PerfCmd.Execute()
+			if err != nil {
+				os.Stdout = rescueStdout
+				if tt.ExpectError {
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+					utils.Equals(t, expectedResponse, err.Error())
+					resetVariables()
+					return
+				}
+				t.Error(err)
+			}
+
+			w.Close()
+			out, _ := io.ReadAll(r)
+			os.Stdout = rescueStdout
+
+			// response being printed in console
+			actualResponse := string(out)
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+			utils.Equals(t, expectedResponse, actualResponse)
+			resetVariables()
+		})
+	}
+
+	// Run tests in list format
+	for _, tt := range testsforLogrusOutputs {
+		t.Run(tt.Name, func(t *testing.T) {
+			utils.TokenFlag = tt.Token
+
+			for _, mock := range tt.URLs {
+				apiResponse := utils.NewGoldenFile(t, mock.Response, fixturesDir).Load()
+				httpmock.RegisterResponder(mock.Method, mock.URL,
+					httpmock.NewStringResponder(mock.ResponseCode, apiResponse))
+			}
+
+			golden := utils.NewGoldenFile(t, tt.ExpectedResponse, testdataDir)
+
+			b := utils.SetupMeshkitLoggerTesting(t, false)
+
+			PerfCmd.SetArgs(tt.Args)
+			PerfCmd.SetOutput(b)
+			err := PerfCmd.Execute()
+			if err != nil {
+				if tt.ExpectError {
+					if *update {
+						golden.Write(err.Error())
+					}
+					expectedResponse := golden.Load()
+					utils.Equals(t, expectedResponse, err.Error())
+					resetVariables()
+					return
+				}
+				t.Error(err)
+			}
+
+			// response being printed in console
+			actualResponse := b.String()
+			// write it in file
+			if *update {
+				golden.Write(actualResponse)
+			}
+			expectedResponse := golden.Load()
+			utils.Equals(t, expectedResponse, actualResponse)
+			resetVariables()
+		})
+	}
+
+	// stop mock server
+	utils.StopMockery(t)
+}
@@ -3,6 +3,7 @@ package perf
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/layer5io/meshkit/errors"
 )
@@ -24,6 +25,11 @@ const (
 	ErrUnauthenticatedCode       = "1040"
 	ErrFailUnmarshalFileCode     = "1041"
 	ErrInvalidTestConfigFileCode = "1042"
+	ErrSLOBreachedCode           = "1060"
+	ErrInvalidComparisonCode     = "1061"
+	ErrPerformanceRegressionCode = "1062"
+	ErrCancelTestRunCode         = "1063"
+	ErrInvalidExportFormatCode   = "1064"
 )
 
 func ErrMesheryConfig(err error) error {
@@ -110,6 +116,21 @@ func ErrInvalidTestConfigFile() error {
 		[]string{"invalid test configuration file", formatErrorWithReference()}, []string{"the test configuration is outdated or incorrect"}, []string{"see https://docs.meshery.io/guides/performance-management#running-performance-benchmarks-through-mesheryctl for a valid configuration file"})
 }
 
+func ErrSLOBreached(breaches []string) error {
+	return errors.New(ErrSLOBreachedCode, errors.Alert, []string{},
+		[]string{"performance test breached the SLO of the profile:\n" + strings.Join(breaches, "\n")}, []string{"the results did not meet the thresholds of the performance profile"}, []string{"review the results of the test or the thresholds of the performance profile"})
+}
+
+func ErrInvalidComparison(msg string) error {
+	return errors.New(ErrInvalidComparisonCode, errors.Alert, []string{},
+		[]string{msg, formatErrorWithReference()}, []string{}, []string{})
+}
+
+func ErrPerformanceRegression(reasons []string) error {
+	return errors.New(ErrPerformanceRegressionCode, errors.Alert, []string{},
+		[]string{"performance regressed against the base result:\n" + strings.Join(reasons, "\n")}, []string{"the candidate result performs worse than the base result"}, []string{"review the changes made between the two test runs"})
+}
+
 func formatErrorWithReference() string {
 	baseURL := "https://docs.meshery.io/reference/mesheryctl/perf"
 	switch cmdUsed {
@@ -119,6 +140,18 @@ func formatErrorWithReference() string {
 		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/profile")
 	case "result":
 		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/result")
+	case "compare":
+		return fmt.Sprintf("\nSee %s for usage details\n", baseURL+"/compare")
 	}
 	return fmt.Sprintf("\nSee %s for usage details\n", baseURL)
 }
+
+func ErrCancelTestRun(err error) error {
+	return errors.New(ErrCancelTestRunCode, errors.Alert, []string{},
+		[]string{"failed to cancel the test, it keeps running on the Meshery server", err.Error(), formatErrorWithReference()}, []string{}, []string{})
+}
+
+func ErrInvalidExportFormat(format string) error {
+	return errors.New(ErrInvalidExportFormatCode, errors.Alert, []string{},
+		[]string{fmt.Sprintf("unsupported export format %q, use csv, jsonl, hdr or junit", format), formatErrorWithReference()}, []string{}, []string{})
+}
@@ -47,6 +47,9 @@ mesheryctl perf profile sam-test
 // List performance results
 mesheryctl perf result sam-test
 
+// Compare performance results
+mesheryctl perf compare sam-test --base <result-id> --candidate <result-id>
+
 // Display Perf profile in JSON or YAML
 mesheryctl perf result -o json
 mesheryctl perf result -o yaml
@@ -80,6 +83,6 @@ func init() {
 	PerfCmd.PersistentFlags().StringVarP(&outputFormatFlag, "output-format", "o", "", "(optional) format to display in [json|yaml]")
 	PerfCmd.PersistentFlags().BoolVarP(&utils.SilentFlag, "yes", "y", false, "(optional) assume yes for user interactive prompts.")
 
-	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd}
+	availableSubcommands = []*cobra.Command{profileCmd, resultCmd, applyCmd, compareCmd}
 	PerfCmd.AddCommand(availableSubcommands...)
 }
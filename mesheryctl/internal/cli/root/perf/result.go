@@ -34,6 +34,11 @@ type resultStruct struct {
 var (
 	pageNumber       int
 	viewSingleResult bool
+	// format the results are exported in, if any
+	exportFormat string
+	// range of the start times of the exported results
+	exportFrom string
+	exportTo   string
 )
 
 var resultCmd = &cobra.Command{
@@ -51,6 +56,12 @@ mesheryctl perf result saturday-profile --page 2
 // View single performance result with detailed information
 mesheryctl perf result saturday-profile --view
 
+// Export the results of March as CSV (csv, jsonl, hdr and junit are supported)
+mesheryctl perf result saturday-profile --export csv --from 2022-03-01 --to 2022-03-31 > results.csv
+
+// Export a single result as a JUnit report checked against the SLO of the profile
+mesheryctl perf result saturday-profile --view --export junit > results.xml
+
 ! Refer below image link for usage
 * Usage of mesheryctl perf result
 # ![perf-result-usage](/assets/img/mesheryctl/perf-result.png)
@@ -120,7 +131,29 @@ mesheryctl perf result saturday-profile --view
 			return nil
 		}
 
-		if outputFormatFlag != "" {
+		if exportFormat != "" {
+			if !models.PerformanceExportFormat(exportFormat).IsValid() {
+				return ErrInvalidExportFormat(exportFormat)
+			}
+
+			exportURL := mctlCfg.GetBaseMesheryURL() + "/api/user/performance/profiles/" + profileID + "/results/export"
+			if viewSingleResult {
+				index := 0
+				if len(data) > 1 {
+					index, err = userPrompt("result", "Select Performance-test result to export", data)
+					if err != nil {
+						return err
+					}
+				}
+				exportURL = mctlCfg.GetBaseMesheryURL() + "/api/perf/profile/result/" + expandedData[index].MesheryID.String() + "/export"
+			}
+
+			body, err := exportPerformanceResults(exportURL, exportFormat, exportFrom, exportTo)
+			if err != nil {
+				return err
+			}
+			utils.Log.Info(string(body))
+		} else if outputFormatFlag != "" {
 			body, _ := json.Marshal(results)
 			if outputFormatFlag == "yaml" {
 				body, _ = yaml.JSONToYAML(body)
@@ -184,6 +217,35 @@ func fetchPerformanceProfileResults(baseURL, profileID string, pageSize, pageNum
 	return response.Results, body, nil
 }
 
+// Export the results in the format, the range only applies to the results of a profile
+func exportPerformanceResults(exportURL, format, from, to string) ([]byte, error) {
+	req, err := utils.NewRequest("GET", exportURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	q := req.URL.Query()
+	q.Add("format", format)
+	if from != "" {
+		q.Add("from", from)
+	}
+	if to != "" {
+		q.Add("to", to)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, utils.PerfError("failed to read response body"))
+	}
+	return body, nil
+}
+
 // change performance results into string arrays(for tabular format printing) and profileStruct (to print single performance result)
 func performanceResultsToStringArrays(results []models.PerformanceResult) ([][]string, []resultStruct) {
 	var data [][]string
@@ -269,4 +331,7 @@ func performanceResultsToStringArrays(results []models.PerformanceResult) ([][]s
 func init() {
 	resultCmd.Flags().BoolVarP(&viewSingleResult, "view", "", false, "(optional) View single performance results with more info")
 	resultCmd.Flags().IntVarP(&pageNumber, "page", "p", 1, "(optional) List next set of performance results with --page (default = 1)")
+	resultCmd.Flags().StringVar(&exportFormat, "export", "", "(optional) Export the results as csv, jsonl, hdr or junit, a single result with --view")
+	resultCmd.Flags().StringVar(&exportFrom, "from", "", "(optional) Export the results which started from this date or RFC 3339 time")
+	resultCmd.Flags().StringVar(&exportTo, "to", "", "(optional) Export the results which started up to this date or RFC 3339 time")
 }
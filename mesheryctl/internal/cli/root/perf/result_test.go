@@ -25,6 +25,8 @@ var (
 	result1005 = "1005.golden"
 	// empty response
 	result1006 = "1006.golden"
+	// api response of the results exported as csv
+	result1007 = "1007.golden"
 )
 
 // golden file mesheryctl outputs
@@ -49,6 +51,10 @@ var (
 	result1011output = "1011.golden"
 	// mesheryctl response for no profile-id passed
 	result1012output = "1012.golden"
+	// mesheryctl response of the results exported as csv
+	result1013output = "1013.golden"
+	// mesheryctl response for invalid export format
+	result1014output = "1014.golden"
 )
 
 func TestResultCmd(t *testing.T) {
@@ -68,6 +74,7 @@ func TestResultCmd(t *testing.T) {
 
 	profileURL := testContext.BaseURL + "/api/user/performance/profiles"
 	resultURL := testContext.BaseURL + "/api/user/performance/profiles/" + tempProfileID + "/results"
+	exportURL := resultURL + "/export"
 
 	tests := []tempTestStruct{
 		{"standard results output", []string{"result", "abhishek"}, []utils.MockURL{
@@ -99,6 +106,15 @@ func TestResultCmd(t *testing.T) {
 			{Method: "GET", URL: profileURL, Response: result1000, ResponseCode: 200},
 			{Method: "GET", URL: resultURL, Response: result1001, ResponseCode: 200},
 		}, result1008output, testToken, true},
+		{"results exported as csv", []string{"result", "abhishek", "--export", "csv", "--from", "2021-06-01"}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: result1000, ResponseCode: 200},
+			{Method: "GET", URL: resultURL, Response: result1001, ResponseCode: 200},
+			{Method: "GET", URL: exportURL, Response: result1007, ResponseCode: 200},
+		}, result1013output, testToken, false},
+		{"invalid export format", []string{"result", "abhishek", "--export", "xlsx"}, []utils.MockURL{
+			{Method: "GET", URL: profileURL, Response: result1000, ResponseCode: 200},
+			{Method: "GET", URL: resultURL, Response: result1001, ResponseCode: 200},
+		}, result1014output, testToken, true},
 	}
 
 	// Run tests in list format
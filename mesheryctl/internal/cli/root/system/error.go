@@ -28,6 +28,8 @@ const (
 	ErrInitPortForwardCode               = "1047"
 	ErrRunPortForwardCode                = "1048"
 	ErrFailedGetEphemeralPortCode        = "1049"
+	ErrIssueAPITokenCode                 = "1065"
+	ErrRevokeAPITokenCode                = "1066"
 )
 
 func ErrHealthCheckFailed(err error) error {
@@ -132,3 +134,11 @@ func ErrFailedGetEphemeralPort(err error) error {
 		nil, nil,
 	)
 }
+
+func ErrIssueAPIToken(err error) error {
+	return errors.New(ErrIssueAPITokenCode, errors.Alert, []string{"Error issuing the API token"}, []string{err.Error()}, []string{"The scopes, the role, the expiry or the kubernetes contexts of the token are invalid", "The current token is not valid", "The current token is an API token"}, []string{"Use the read-only, pattern:deploy or perf:run scopes and the ids of the contexts of `mesheryctl system context view`", "Log in with `mesheryctl system login` before issuing API tokens"})
+}
+
+func ErrRevokeAPIToken(err error) error {
+	return errors.New(ErrRevokeAPITokenCode, errors.Alert, []string{"Error revoking the API token"}, []string{err.Error()}, []string{"The token was not issued by Meshery server", "The token was already revoked"}, []string{"Revoke the token from the tokens of the user in Meshery UI or through /api/user/tokens"})
+}
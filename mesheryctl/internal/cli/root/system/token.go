@@ -1,8 +1,13 @@
 package system
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
+	"path/filepath"
 
 	"github.com/layer5io/meshery/mesheryctl/internal/cli/root/config"
 	"github.com/layer5io/meshery/mesheryctl/pkg/utils"
@@ -17,8 +22,35 @@ var (
 	tokenPath     string
 	ctx           string
 	viewAllTokens bool
+
+	// flags of the API tokens issued by Meshery server
+	tokenScopes         []string
+	tokenExpiry         string
+	tokenServiceAccount string
+	tokenRole           string
+	tokenK8sContexts    []string
+	revokeToken         bool
 )
 
+// apiTokenRequest is the payload creating an API token on Meshery server
+type apiTokenRequest struct {
+	Name           string   `json:"name"`
+	ServiceAccount string   `json:"service_account,omitempty"`
+	Role           string   `json:"role,omitempty"`
+	Scopes         []string `json:"scopes,omitempty"`
+	Contexts       []string `json:"contexts,omitempty"`
+	ExpiresIn      string   `json:"expires_in,omitempty"`
+}
+
+// apiTokenResponse is the API token created by Meshery server along with its secret
+type apiTokenResponse struct {
+	Token struct {
+		ID        string `json:"id"`
+		ExpiresAt string `json:"expires_at"`
+	} `json:"token"`
+	Secret string `json:"secret"`
+}
+
 var tokenCmd = &cobra.Command{
 	Use:   "token",
 	Short: "Manage Meshery user tokens",
@@ -45,15 +77,37 @@ func checkTokenName(n int) cobra.PositionalArgs {
 var createTokenCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Create a token in your meshconfig",
-	Long:  "Create the token with provided token name (optionally token path) to your meshconfig tokens.",
+	Long: `Create the token with provided token name (optionally token path) to your meshconfig tokens.
+
+An API token is issued by Meshery server for automation when a scope, an expiry, a service account,
+a role or a kubernetes context is passed. The token is bound to the logged in user, or to the service
+account, and is written to [token-name].json by default. Its secret is only shown once by the server.`,
 	Example: `
 mesheryctl system token create [token-name] -f [token-path]
 mesheryctl system token create [token-name] (default path is auth.json)
 mesheryctl system token create [token-name] -f [token-path] --set
+
+// Issue an API token allowed to deploy patterns on a single kubernetes context for a week
+mesheryctl system token create ci --scope pattern:deploy --k8s-context [context-id] --expires 168h
+
+// Issue a read-only API token of a service account
+mesheryctl system token create dashboards --service-account grafana --scope read-only
 	`,
 	Args: checkTokenName(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		tokenName := args[0]
+		issue := false
+		for _, flag := range []string{"scope", "expires", "service-account", "role", "k8s-context"} {
+			issue = issue || cmd.Flags().Changed(flag)
+		}
+		if issue {
+			if tokenPath == "" {
+				tokenPath = tokenName + ".json"
+			}
+			if err := issueAPIToken(tokenName); err != nil {
+				return err
+			}
+		}
 		if tokenPath == "" {
 			tokenPath = "auth.json"
 		}
@@ -81,13 +135,19 @@ mesheryctl system token create [token-name] -f [token-path] --set
 var deleteTokenCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete a token from your meshconfig",
-	Long:  "Delete the token with provided token name from your meshconfig tokens.",
+	Long:  "Delete the token with provided token name from your meshconfig tokens, API tokens are revoked on Meshery server with --revoke.",
 	Example: `
 mesheryctl system token delete [token-name]
+mesheryctl system token delete [token-name] --revoke
 	`,
 	Args: checkTokenName(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		tokenName := args[0]
+		if revokeToken {
+			if err := revokeAPIToken(tokenName); err != nil {
+				return err
+			}
+		}
 
 		if err = config.DeleteTokenFromConfig(tokenName, utils.DefaultConfigPath); err != nil {
 			return errors.Wrapf(err, "Could not delete token \"%s\" from config", tokenName)
@@ -217,10 +277,127 @@ mesheryctl system token view (show token of current context)
 	},
 }
 
+// issueAPIToken creates an API token on Meshery server with the credentials of the
+// current context and writes it to the token path
+func issueAPIToken(tokenName string) error {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return ErrProcessingMctlConfig(err)
+	}
+
+	body, err := json.Marshal(&apiTokenRequest{
+		Name:           tokenName,
+		ServiceAccount: tokenServiceAccount,
+		Role:           tokenRole,
+		Scopes:         tokenScopes,
+		Contexts:       tokenK8sContexts,
+		ExpiresIn:      tokenExpiry,
+	})
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+
+	req, err := utils.NewRequest(http.MethodPost, mctlCfg.GetBaseMesheryURL()+"/api/user/tokens", bytes.NewBuffer(body))
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	defer utils.SafeClose(resp.Body)
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		return ErrIssueAPIToken(fmt.Errorf("server responded with %s: %s", resp.Status, bytes.TrimSpace(data)))
+	}
+	issued := apiTokenResponse{}
+	if err := json.Unmarshal(data, &issued); err != nil {
+		return ErrIssueAPIToken(err)
+	}
+
+	// the API token is sent along with the provider of the user who created it
+	current, err := utils.ReadToken(utils.TokenFlag)
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	tokenFile, err := json.Marshal(map[string]string{
+		"meshery-provider": current["meshery-provider"],
+		"token":            issued.Secret,
+		"id":               issued.Token.ID,
+	})
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	location, err := utils.GetTokenLocation(config.Token{Name: tokenName, Location: tokenPath})
+	if err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(location), 0750); err != nil {
+		return ErrIssueAPIToken(err)
+	}
+	if err := os.WriteFile(location, tokenFile, 0600); err != nil {
+		return ErrIssueAPIToken(err)
+	}
+
+	utils.Log.Info(fmt.Sprintf("API token %s issued, it expires on %s.", tokenName, issued.Token.ExpiresAt))
+	return nil
+}
+
+// revokeAPIToken revokes the API token of the meshconfig on Meshery server
+func revokeAPIToken(tokenName string) error {
+	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
+	if err != nil {
+		return ErrProcessingMctlConfig(err)
+	}
+
+	var location string
+	for _, t := range mctlCfg.Tokens {
+		if t.Name == tokenName {
+			location, err = utils.GetTokenLocation(t)
+			if err != nil {
+				return ErrRevokeAPIToken(err)
+			}
+		}
+	}
+	if location == "" {
+		return ErrRevokeAPIToken(fmt.Errorf("token %s not found", tokenName))
+	}
+	tokenObj, err := utils.ReadToken(location)
+	if err != nil {
+		return ErrRevokeAPIToken(err)
+	}
+	if tokenObj["id"] == "" {
+		return ErrRevokeAPIToken(fmt.Errorf("token %s is not an API token issued by Meshery server", tokenName))
+	}
+
+	req, err := utils.NewRequest(http.MethodDelete, mctlCfg.GetBaseMesheryURL()+"/api/user/tokens/"+tokenObj["id"], nil)
+	if err != nil {
+		return ErrRevokeAPIToken(err)
+	}
+	resp, err := utils.MakeRequest(req)
+	if err != nil {
+		return ErrRevokeAPIToken(err)
+	}
+	defer utils.SafeClose(resp.Body)
+
+	utils.Log.Info(fmt.Sprintf("API token %s revoked.", tokenName))
+	return nil
+}
+
 func init() {
 	tokenCmd.AddCommand(createTokenCmd, deleteTokenCmd, setTokenCmd, listTokenCmd, viewTokenCmd)
 	createTokenCmd.Flags().StringVarP(&tokenPath, "filepath", "f", "", "Add the token location")
 	createTokenCmd.Flags().BoolVarP(&set, "set", "s", false, "Set as current token")
+	createTokenCmd.Flags().StringSliceVar(&tokenScopes, "scope", []string{}, "Issue an API token restricted to the scopes: read-only, pattern:deploy, perf:run")
+	createTokenCmd.Flags().StringVar(&tokenExpiry, "expires", "", "Issue an API token expiring after the duration, 720h by default and 8760h at most")
+	createTokenCmd.Flags().StringVar(&tokenServiceAccount, "service-account", "", "Issue an API token of the named service account instead of the current user")
+	createTokenCmd.Flags().StringVar(&tokenRole, "role", "", "Role of the service account on the local provider: viewer, operator or admin")
+	createTokenCmd.Flags().StringSliceVar(&tokenK8sContexts, "k8s-context", []string{}, "Issue an API token restricted to the ids of the kubernetes contexts")
+	deleteTokenCmd.Flags().BoolVar(&revokeToken, "revoke", false, "Revoke the API token on Meshery server")
 	setTokenCmd.Flags().StringVar(&ctx, "context", "", "Pass the context")
 	viewTokenCmd.Flags().BoolVar(&viewAllTokens, "all", false, "set the flag to view all the tokens.")
 }
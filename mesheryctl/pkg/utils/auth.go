@@ -151,11 +151,16 @@ func AddAuthDetails(req *http.Request, filepath string) error {
 		err = errors.Wrap(err, "token file invalid: ")
 		return err
 	}
-	req.AddCookie(&http.Cookie{
-		Name:     tokenName,
-		Value:    tokenObj[tokenName],
-		HttpOnly: true,
-	})
+	// API tokens issued by Meshery server are bearer tokens
+	if IsAPIToken(tokenObj[tokenName]) {
+		req.Header.Set("Authorization", "Bearer "+tokenObj[tokenName])
+	} else {
+		req.AddCookie(&http.Cookie{
+			Name:     tokenName,
+			Value:    tokenObj[tokenName],
+			HttpOnly: true,
+		})
+	}
 	req.AddCookie(&http.Cookie{
 		Name:     providerName,
 		Value:    tokenObj[providerName],
@@ -164,8 +169,18 @@ func AddAuthDetails(req *http.Request, filepath string) error {
 	return nil
 }
 
+// IsAPIToken returns true if the token is an API token issued by Meshery server
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, apiTokenPrefix)
+}
+
 // UpdateAuthDetails checks gets the token (old/refreshed) from meshery server and writes it back to the config file
 func UpdateAuthDetails(filepath string) error {
+	// API tokens are not refreshed
+	if tokenObj, err := ReadToken(filepath); err == nil && IsAPIToken(tokenObj[tokenName]) {
+		return nil
+	}
+
 	mctlCfg, err := config.GetMesheryCtl(viper.GetViper())
 	if err != nil {
 		return errors.Wrap(err, "error processing config")
@@ -199,6 +199,9 @@ func BackupConfigFile(cfgFile string) {
 const tokenName = "token"
 const providerName = "meshery-provider"
 
+// apiTokenPrefix prefixes the API tokens issued by Meshery server
+const apiTokenPrefix = "mshry_"
+
 var seededRand = rand.New(
 	rand.NewSource(time.Now().UnixNano()))
 
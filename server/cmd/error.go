@@ -14,6 +14,10 @@ const (
 	ErrListenAndServeCode                         = "2248"
 	ErrCleaningUpLocalProviderCode                = "2249"
 	ErrClosingDatabaseInstanceCode                = "2250"
+	ErrInitializingLocalUsersCode                 = "2287"
+	ErrInitializingKubeconfigEncryptionCode       = "2293"
+	ErrInitializingAdapterAuthCode                = "2300"
+	ErrInitializingAuditLogCode                   = "2302"
 )
 
 func ErrCreatingUUIDInstance(err error) error {
@@ -59,3 +63,19 @@ func ErrCleaningUpLocalProvider(err error) error {
 func ErrClosingDatabaseInstance(err error) error {
 	return errors.New(ErrClosingDatabaseInstanceCode, errors.Alert, []string{"Error closing database instance"}, []string{"Error closing database instance: ", err.Error()}, []string{}, []string{})
 }
+
+func ErrInitializingLocalUsers(err error) error {
+	return errors.New(ErrInitializingLocalUsersCode, errors.Alert, []string{"Unable to initialize the users of the local provider"}, []string{"Unable to initialize the users of the local provider: ", err.Error()}, []string{"No admin exists and MESHERY_ADMIN_USERNAME or MESHERY_ADMIN_PASSWORD is not set", "The admin password is shorter than 8 characters"}, []string{"Set MESHERY_ADMIN_USERNAME and MESHERY_ADMIN_PASSWORD, with a password of at least 8 characters, and restart Meshery"})
+}
+
+func ErrInitializingKubeconfigEncryption(err error) error {
+	return errors.New(ErrInitializingKubeconfigEncryptionCode, errors.Alert, []string{"Unable to initialize the encryption of the kubeconfig credentials"}, []string{err.Error()}, []string{"KUBECONFIG_ENCRYPTION_KEY is invalid", "The keyring file is not readable", "The key some contexts were encrypted with is missing"}, []string{"Pass a base64 encoded 32 bytes key", "Pass the previous keys through KUBECONFIG_ENCRYPTION_PREVIOUS_KEYS"})
+}
+
+func ErrInitializingAdapterAuth(err error) error {
+	return errors.New(ErrInitializingAdapterAuthCode, errors.Fatal, []string{"Unable to initialize the authentication of the adapters"}, []string{err.Error()}, []string{"ADAPTER_TRUSTED_CIDRS is not a space separated list of CIDRs", "ADAPTER_CLIENT_CA is not readable or holds no PEM certificate", "ADAPTER_CLIENT_CA is set but Meshery doesn't serve TLS", "ADAPTER_REGISTRATION_TOKEN_FILE is not readable or writable"}, []string{"Fix ADAPTER_TRUSTED_CIDRS, for example 10.0.0.0/8 192.168.0.0/16", "Set SERVER_TLS_CERT and SERVER_TLS_KEY along with ADAPTER_CLIENT_CA", "Make sure that ADAPTER_REGISTRATION_TOKEN_FILE is in a folder writable by Meshery Server"})
+}
+
+func ErrInitializingAuditLog(err error) error {
+	return errors.New(ErrInitializingAuditLogCode, errors.Fatal, []string{"Unable to initialize the audit log"}, []string{err.Error()}, []string{"AUDIT_LOG_KEY is invalid", "The key file of the audit log is not readable or writable"}, []string{"Pass a base64 encoded key of at least 32 bytes", "Check the permissions of AUDIT_LOG_KEY_FILE"})
+}
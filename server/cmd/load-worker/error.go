@@ -0,0 +1,16 @@
+package main
+
+import "github.com/layer5io/meshkit/errors"
+
+const (
+	ErrListenAndServeCode = "2272"
+	ErrShutdownCode       = "2273"
+)
+
+func ErrListenAndServe(err error) error {
+	return errors.New(ErrListenAndServeCode, errors.Fatal, []string{"Error occurred while starting the load test worker"}, []string{err.Error()}, []string{"The port of the worker might be in use"}, []string{"Set PORT to a free port"})
+}
+
+func ErrShutdown(err error) error {
+	return errors.New(ErrShutdownCode, errors.Alert, []string{"Error occurred while shutting down the load test worker"}, []string{err.Error()}, []string{"Load tests were still running on the worker"}, []string{})
+}
@@ -0,0 +1,63 @@
+// The load test worker generates the load of the load tests the Meshery server fans out
+// to it and streams their results back. Several workers can run on one machine, each
+// listening on its own PORT, or in the cluster next to the workloads under test.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/layer5io/meshery/server/helpers"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	log, err := logger.New("meshery-load-worker", logger.Options{
+		Format: logger.SyslogLogFormat,
+	})
+	if err != nil {
+		logrus.Error(err)
+		os.Exit(1)
+	}
+
+	viper.AutomaticEnv()
+	viper.SetDefault("PORT", 8090)
+
+	if viper.GetBool("DEBUG") {
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+	if viper.GetString("LOAD_TEST_WORKER_TOKEN") == "" {
+		log.Warn(fmt.Errorf("LOAD_TEST_WORKER_TOKEN is not set, anyone reaching the worker can run load tests on it"))
+	}
+
+	port := viper.GetInt("PORT")
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: helpers.NewLoadTestWorker(viper.GetString("LOAD_TEST_WORKER_TOKEN")),
+	}
+
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, os.Interrupt)
+
+	go func() {
+		log.Info("Meshery load test worker listening on: ", port)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(ErrListenAndServe(err))
+			os.Exit(1)
+		}
+	}()
+	<-c
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Error(ErrShutdown(err))
+	}
+	log.Info("Shutting down Meshery load test worker")
+}
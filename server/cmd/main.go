@@ -2,6 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
 	"net/http"
 	"net/url"
 	"os"
@@ -72,6 +75,7 @@ func main() {
 
 	viper.SetDefault("PORT", 8080)
 	viper.SetDefault("ADAPTER_URLS", "")
+	viper.SetDefault("LOAD_TEST_WORKERS", "")
 	viper.SetDefault("BUILD", version)
 	viper.SetDefault("OS", "meshery")
 	viper.SetDefault("COMMITSHA", commitsha)
@@ -80,6 +84,11 @@ func main() {
 
 	viper.SetDefault("SKIP_DOWNLOAD_CONTENT", false)
 	viper.SetDefault("SKIP_COMP_GEN", false)
+	viper.SetDefault("AUDIT_LOG_RETENTION", 90*24*time.Hour)
+	viper.SetDefault("ADAPTER_TRUSTED_CIDRS", models.DefaultAdapterTrustedCIDRs)
+	viper.SetDefault("ADAPTER_TRUST_NETWORK", false)
+	viper.SetDefault("COMPONENT_GENERATION_RATE_LIMIT", 10)
+	viper.SetDefault("ALLOW_LOCAL_GIT_REPOS", false)
 	store.Initialize()
 
 	// Register local OAM traits and workloads
@@ -118,6 +127,10 @@ func main() {
 		viper.SetDefault("KUBECONFIG_FOLDER", path.Join(home, ".kube"))
 	}
 	log.Info("Using kubeconfig at: ", viper.GetString("KUBECONFIG_FOLDER"))
+	viper.SetDefault("KUBECONFIG_ENCRYPTION_KEYRING", path.Join(viper.GetString("USER_DATA_FOLDER"), "kubeconfig-keyring.json"))
+	viper.SetDefault("AUDIT_LOG_KEY_FILE", path.Join(viper.GetString("USER_DATA_FOLDER"), "audit-log.key"))
+	viper.SetDefault("AUDIT_LOG_HEAD_FILE", path.Join(viper.GetString("USER_DATA_FOLDER"), "audit-log.head"))
+	viper.SetDefault("ADAPTER_REGISTRATION_TOKEN_FILE", path.Join(viper.GetString("USER_DATA_FOLDER"), "adapter-registration.token"))
 
 	if viper.GetBool("DEBUG") {
 		logrus.SetLevel(logrus.DebugLevel)
@@ -164,10 +177,21 @@ func main() {
 		&models.MesheryPattern{},
 		&models.MesheryFilter{},
 		&models.PatternResource{},
+		&models.PatternDeployment{},
+		&models.PatternDeploymentService{},
 		&models.MesheryApplication{},
 		&models.UserPreference{},
 		&models.PerformanceTestConfig{},
 		&models.SmiResultWithID{},
+		&models.Schedule{},
+		&models.ScheduleRun{},
+		&models.PatternSyncSource{},
+		&models.PatternSync{},
+		&models.PatternSyncFile{},
+		&models.LocalUser{},
+		&models.LocalSession{},
+		&models.APIToken{},
+		&models.AuditEvent{},
 		models.K8sContext{},
 	)
 	if err != nil {
@@ -175,6 +199,14 @@ func main() {
 		os.Exit(1)
 	}
 
+	// the credentials of the kubernetes contexts are encrypted at rest
+	kms, err := models.NewLocalKMS(viper.GetString("KUBECONFIG_ENCRYPTION_KEY"), viper.GetStringSlice("KUBECONFIG_ENCRYPTION_PREVIOUS_KEYS"), viper.GetString("KUBECONFIG_ENCRYPTION_KEYRING"))
+	if err != nil {
+		log.Error(ErrInitializingKubeconfigEncryption(err))
+		os.Exit(1)
+	}
+	models.SetK8sContextKMS(kms)
+
 	lProv := &models.DefaultLocalProvider{
 		ProviderBaseURL:                 DefaultProviderURL,
 		MapPreferencePersister:          preferencePersister,
@@ -186,10 +218,35 @@ func main() {
 		MesheryFilterPersister:          &models.MesheryFilterPersister{DB: dbHandler},
 		MesheryApplicationPersister:     &models.MesheryApplicationPersister{DB: dbHandler},
 		MesheryPatternResourcePersister: &models.PatternResourcePersister{DB: dbHandler},
+		PatternDeploymentPersister:      &models.PatternDeploymentPersister{DB: dbHandler},
 		MesheryK8sContextPersister:      &models.MesheryK8sContextPersister{DB: dbHandler},
+		SchedulePersister:               &models.SchedulePersister{DB: dbHandler},
+		PatternSyncPersister:            &models.PatternSyncPersister{DB: dbHandler},
 		GenericPersister:                dbHandler,
+		MultiUser:                       viper.GetBool("LOCAL_PROVIDER_MULTI_USER"),
+		LocalUserPersister:              &models.LocalUserPersister{DB: dbHandler},
+		SessionDuration:                 viper.GetDuration("LOCAL_PROVIDER_SESSION_DURATION"),
+	}
+	if viper.GetString("LOCAL_PROVIDER_OIDC_ISSUER") != "" {
+		lProv.OIDC = &models.LocalOIDCConfig{
+			Issuer:       viper.GetString("LOCAL_PROVIDER_OIDC_ISSUER"),
+			ClientID:     viper.GetString("LOCAL_PROVIDER_OIDC_CLIENT_ID"),
+			ClientSecret: viper.GetString("LOCAL_PROVIDER_OIDC_CLIENT_SECRET"),
+			RedirectURL:  viper.GetString("LOCAL_PROVIDER_OIDC_REDIRECT_URL"),
+			DefaultRole:  models.UserRole(viper.GetString("LOCAL_PROVIDER_OIDC_DEFAULT_ROLE")),
+		}
 	}
 	lProv.Initialize()
+	if err := lProv.InitializeUsers(viper.GetString("MESHERY_ADMIN_USERNAME"), viper.GetString("MESHERY_ADMIN_PASSWORD")); err != nil {
+		log.Error(ErrInitializingLocalUsers(err))
+	}
+	// contexts stored in clear by previous versions are encrypted, and those of a rotation
+	// which was interrupted are rewrapped
+	if n, err := lProv.MesheryK8sContextPersister.SealMesheryK8sContexts(kms); err != nil {
+		log.Error(ErrInitializingKubeconfigEncryption(err))
+	} else if n > 0 {
+		log.Info("Encrypted the credentials of kubernetes contexts: ", n)
+	}
 	lProv.SeedContent(log)
 	provs[lProv.Name()] = lProv
 
@@ -219,6 +276,50 @@ func main() {
 		provs[cp.Name()] = cp
 	}
 
+	meshMetricQueries, err := models.LoadMeshMetricQueries(viper.GetString("MESH_METRICS_CONFIG"))
+	if err != nil {
+		log.Error(err)
+	}
+
+	// adapters authenticate with a client certificate when Meshery serves TLS and is given a client CA
+	tlsConfig, err := adapterTLSConfig(viper.GetString("ADAPTER_CLIENT_CA"), viper.GetString("SERVER_TLS_CERT"))
+	if err != nil {
+		log.Error(ErrInitializingAdapterAuth(err))
+		os.Exit(1)
+	}
+	// without a token nor a client CA, the registration token is read from its file, where it is
+	// generated on the first start and shared with the bundled adapters
+	registrationToken := viper.GetString("ADAPTER_REGISTRATION_TOKEN")
+	trustNetwork := viper.GetBool("ADAPTER_TRUST_NETWORK")
+	if registrationToken == "" && tlsConfig == nil && !trustNetwork {
+		registrationToken, err = models.LoadAdapterRegistrationToken(viper.GetString("ADAPTER_REGISTRATION_TOKEN_FILE"))
+		if err != nil {
+			log.Error(ErrInitializingAdapterAuth(err))
+			os.Exit(1)
+		}
+		log.Info("Adapters register with the token in ", viper.GetString("ADAPTER_REGISTRATION_TOKEN_FILE"))
+	}
+	adapterAuth, err := models.NewAdapterAuth(
+		registrationToken,
+		viper.GetStringSlice("ADAPTER_TRUSTED_CIDRS"),
+		tlsConfig != nil,
+		viper.GetStringSlice("ADAPTER_TLS_IDENTITIES"),
+		trustNetwork,
+	)
+	if err != nil {
+		log.Error(ErrInitializingAdapterAuth(err))
+		os.Exit(1)
+	}
+	if !adapterAuth.RequiresIdentity() {
+		log.Warn(fmt.Errorf("ADAPTER_TRUST_NETWORK is enabled, adapters are trusted by their network alone"))
+	}
+
+	auditKey, err := models.LoadAuditKey(viper.GetString("AUDIT_LOG_KEY"), viper.GetString("AUDIT_LOG_KEY_FILE"))
+	if err != nil {
+		log.Error(ErrInitializingAuditLog(err))
+		os.Exit(1)
+	}
+
 	hc := &models.HandlerConfig{
 		Providers:              provs,
 		ProviderCookieName:     "meshery-provider",
@@ -231,6 +332,16 @@ func main() {
 
 		KubeConfigFolder: viper.GetString("KUBECONFIG_FOLDER"),
 
+		LoadTestWorkers:     viper.GetStringSlice("LOAD_TEST_WORKERS"),
+		LoadTestWorkerToken: viper.GetString("LOAD_TEST_WORKER_TOKEN"),
+		LoadTestRuns:        models.NewLoadTestRegistry(),
+		MeshMetricQueries:   meshMetricQueries,
+		APITokenPersister:   &models.APITokenPersister{DB: dbHandler},
+		AuditPersister:      &models.AuditPersister{DB: dbHandler, Key: auditKey, HeadFile: viper.GetString("AUDIT_LOG_HEAD_FILE")},
+		AdapterAuth:         adapterAuth,
+
+		ComponentGenerationLimiter: models.NewRateLimiter(viper.GetInt("COMPONENT_GENERATION_RATE_LIMIT")),
+
 		GrafanaClient:         models.NewGrafanaClient(),
 		GrafanaClientForQuery: models.NewGrafanaClientWithHTTPClient(&http.Client{Timeout: time.Second}),
 
@@ -250,6 +361,17 @@ func main() {
 
 	h := handlers.NewHandlerInstance(hc, meshsyncCh, log, brokerConn, k8sComponentsRegistrationHelper, mctrlHelper, dbHandler, events.NewEventStreamer())
 
+	lProv.PerformanceScheduler = models.NewPerformanceScheduler(log, lProv, lProv.SchedulePersister, lProv.PerformanceProfilesPersister, h.RunScheduledLoadTest)
+	lProv.PerformanceScheduler.Start(ctx)
+
+	lProv.PatternSyncWorker = models.NewPatternSyncWorker(log, lProv, lProv.PatternSyncPersister, lProv.MesheryPatternPersister, h.DeploySyncedPatterns)
+	lProv.PatternSyncWorker.Start(ctx)
+
+	// the audit log is kept forever when the retention is 0
+	if retention := viper.GetDuration("AUDIT_LOG_RETENTION"); retention > 0 {
+		hc.AuditPersister.StartRetention(ctx, log, retention)
+	}
+
 	b := broadcast.NewBroadcaster(100)
 	defer b.Close()
 
@@ -271,7 +393,11 @@ func main() {
 
 	go func() {
 		log.Info("Meshery Server listening on: ", port)
-		if err := r.Run(); err != nil {
+		run := r.Run
+		if certFile := viper.GetString("SERVER_TLS_CERT"); certFile != "" {
+			run = func() error { return r.RunTLS(certFile, viper.GetString("SERVER_TLS_KEY"), tlsConfig) }
+		}
+		if err := run(); err != nil {
 			log.Error(ErrListenAndServe(err))
 			os.Exit(1)
 		}
@@ -291,3 +417,29 @@ func main() {
 
 	log.Info("Shutting down Meshery Server...")
 }
+
+// adapterTLSConfig returns the TLS config verifying the client certificates of the adapters
+// against the CA, it returns nil if no CA is given
+func adapterTLSConfig(caFile, certFile string) (*tls.Config, error) {
+	if caFile == "" {
+		return nil, nil
+	}
+	if certFile == "" {
+		return nil, fmt.Errorf("ADAPTER_CLIENT_CA requires Meshery to serve TLS with SERVER_TLS_CERT and SERVER_TLS_KEY")
+	}
+	ca, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no PEM certificate found in %s", caFile)
+	}
+
+	// the browsers and the other clients authenticate with their session as usual
+	return &tls.Config{
+		ClientAuth: tls.VerifyClientCertIfGiven,
+		ClientCAs:  pool,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
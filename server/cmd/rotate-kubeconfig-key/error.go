@@ -0,0 +1,11 @@
+package main
+
+import "github.com/layer5io/meshkit/errors"
+
+const (
+	ErrRotateKeyCode = "2294"
+)
+
+func ErrRotateKey(err error) error {
+	return errors.New(ErrRotateKeyCode, errors.Fatal, []string{"Error occurred while rotating the key of the kubeconfig credentials"}, []string{err.Error()}, []string{"The keyring file is not writable", "The key some contexts were encrypted with is missing", "The Meshery database is not reachable"}, []string{"Run the rotation with the USER_DATA_FOLDER and the keys of Meshery Server", "Pass the previous keys through KUBECONFIG_ENCRYPTION_PREVIOUS_KEYS"})
+}
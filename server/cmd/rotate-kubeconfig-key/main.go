@@ -0,0 +1,64 @@
+// The kubeconfig key rotation wraps the data keys of the kubernetes contexts stored by
+// Meshery with a new key. With a keyring file the new key is generated and the previous
+// keys are retired once every context is rewrapped. With KUBECONFIG_ENCRYPTION_KEY the
+// new key is passed through it and the previous one through KUBECONFIG_ENCRYPTION_PREVIOUS_KEYS.
+package main
+
+import (
+	"os"
+	"path"
+
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+func main() {
+	log, err := logger.New("meshery-rotate-kubeconfig-key", logger.Options{
+		Format: logger.SyslogLogFormat,
+	})
+	if err != nil {
+		logrus.Error(err)
+		os.Exit(1)
+	}
+
+	viper.AutomaticEnv()
+	if viper.GetString("USER_DATA_FOLDER") == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			log.Error(ErrRotateKey(err))
+			os.Exit(1)
+		}
+		viper.SetDefault("USER_DATA_FOLDER", path.Join(home, ".meshery", "config"))
+	}
+	viper.SetDefault("KUBECONFIG_ENCRYPTION_KEYRING", path.Join(viper.GetString("USER_DATA_FOLDER"), "kubeconfig-keyring.json"))
+
+	key := viper.GetString("KUBECONFIG_ENCRYPTION_KEY")
+	kms, err := models.NewLocalKMS(key, viper.GetStringSlice("KUBECONFIG_ENCRYPTION_PREVIOUS_KEYS"), viper.GetString("KUBECONFIG_ENCRYPTION_KEYRING"))
+	if err != nil {
+		log.Error(ErrRotateKey(err))
+		os.Exit(1)
+	}
+	if key == "" {
+		if err := kms.RotateKey(); err != nil {
+			log.Error(ErrRotateKey(err))
+			os.Exit(1)
+		}
+	}
+	log.Info("Wrapping the data keys of the kubernetes contexts with the key ", kms.PrimaryKeyID())
+
+	persister := &models.MesheryK8sContextPersister{DB: models.GetNewDBInstance()}
+	n, err := persister.SealMesheryK8sContexts(kms)
+	if err != nil {
+		// the previous keys are kept, running the rotation again resumes it
+		log.Error(ErrRotateKey(err))
+		os.Exit(1)
+	}
+	if err := kms.RetirePreviousKeys(); err != nil {
+		log.Error(ErrRotateKey(err))
+		os.Exit(1)
+	}
+
+	log.Info("Rotated the key of kubernetes contexts: ", n)
+}
@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// apiTokenResponse is the response of the creation of an API token, the secret
+// is only returned once
+type apiTokenResponse struct {
+	Token  *models.APIToken `json:"token"`
+	Secret string           `json:"secret"`
+}
+
+// swagger:route GET /api/user/tokens UserAPI idGetAPITokens
+// Handle GET request for the API tokens of the user
+//
+// Returns the API tokens created by the user under the current provider, the secrets of the tokens are never returned.
+// Admins of the local provider get the tokens of every user with ?all=true
+// responses:
+// 	200: apiTokensResponseWrapper
+
+// GetAPITokensHandler returns the API tokens of the user
+func (h *Handler) GetAPITokensHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
+	if !h.apiTokensEnabled(w) {
+		return
+	}
+
+	userID := user.UserID
+	if req.URL.Query().Get("all") == "true" && user.Role == string(models.RoleAdmin) {
+		userID = ""
+	}
+
+	tokens, err := h.config.APITokenPersister.GetAPITokens(provider.Name(), userID)
+	if err != nil {
+		h.log.Error(ErrAPITokens(err))
+		http.Error(w, ErrAPITokens(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(tokens); err != nil {
+		h.log.Error(ErrEncoding(err, "API tokens"))
+		http.Error(w, ErrEncoding(err, "API tokens").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/user/tokens UserAPI idPostAPIToken
+// Handle POST request to create an API token
+//
+// Creates an API token bound to the user or to a named service account, with an expiry, optional scopes
+// (read-only, pattern:deploy, perf:run) and an optional allow-list of kubernetes contexts.
+// The secret of the token is only returned in this response, it is sent as a bearer token
+// responses:
+// 	201: apiTokenResponseWrapper
+
+// SaveAPITokenHandler creates an API token
+func (h *Handler) SaveAPITokenHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
+	if !h.apiTokensEnabled(w) {
+		return
+	}
+
+	tokenReq := models.APITokenRequest{}
+	if err := json.NewDecoder(req.Body).Decode(&tokenReq); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, status, err := h.newAPIToken(req, &tokenReq, user, provider)
+	if err != nil {
+		h.log.Error(ErrAPITokens(err))
+		http.Error(w, ErrAPITokens(err).Error(), status)
+		return
+	}
+
+	// the remote provider authenticates the requests made with the token with the
+	// session of the user, sealed with the token
+	providerToken := ""
+	if provider.GetProviderType() == models.RemoteProviderType {
+		providerToken, err = provider.GetProviderToken(req)
+		if err != nil {
+			h.log.Error(ErrAPITokens(err))
+			http.Error(w, ErrAPITokens(err).Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	secret, err := h.config.APITokenPersister.CreateAPIToken(token, providerToken)
+	if err != nil {
+		h.log.Error(ErrAPITokens(err))
+		http.Error(w, ErrAPITokens(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(&apiTokenResponse{Token: token, Secret: secret}); err != nil {
+		h.log.Error(ErrEncoding(err, "API token"))
+	}
+}
+
+// swagger:route DELETE /api/user/tokens/{id} UserAPI idDeleteAPIToken
+// Handle DELETE request to revoke an API token
+//
+// Revokes an API token of the user, admins of the local provider can revoke the tokens of every user
+// responses:
+// 	200: noContentWrapper
+
+// DeleteAPITokenHandler revokes an API token
+func (h *Handler) DeleteAPITokenHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, user *models.User, provider models.Provider) {
+	if !h.apiTokensEnabled(w) {
+		return
+	}
+
+	id, err := uuid.FromString(mux.Vars(req)["id"])
+	if err != nil {
+		h.log.Error(ErrAPITokens(err))
+		http.Error(w, ErrAPITokens(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, err := h.config.APITokenPersister.GetAPIToken(id)
+	if err != nil || token.Provider != provider.Name() || (token.UserID != user.UserID && user.Role != string(models.RoleAdmin)) {
+		err := ErrAPITokens(fmt.Errorf("the token %s does not exist", id))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err := h.config.APITokenPersister.DeleteAPIToken(id); err != nil {
+		h.log.Error(ErrAPITokens(err))
+		http.Error(w, ErrAPITokens(err).Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// newAPIToken returns the token the user requested, along with the status of the response
+// if the request is not allowed
+func (h *Handler) newAPIToken(req *http.Request, tokenReq *models.APITokenRequest, user *models.User, provider models.Provider) (*models.APIToken, int, error) {
+	// a leaked token should not be able to outlive its revocation
+	if models.APITokenFromContext(req.Context()) != nil {
+		return nil, http.StatusForbidden, fmt.Errorf("API tokens can't create API tokens")
+	}
+	if err := tokenReq.Validate(); err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	token := &models.APIToken{
+		Name:     strings.TrimSpace(tokenReq.Name),
+		Provider: provider.Name(),
+		UserID:   user.UserID,
+		Contexts: tokenReq.Contexts,
+	}
+	for _, s := range tokenReq.Scopes {
+		token.Scopes = append(token.Scopes, string(s))
+	}
+
+	if tokenReq.ServiceAccount != "" {
+		// roles are only known to the local provider, the service accounts of the other
+		// providers act with the permissions of the user who created them
+		if user.Role != "" && user.Role != string(models.RoleAdmin) {
+			return nil, http.StatusForbidden, fmt.Errorf("only admins can create the tokens of service accounts")
+		}
+		token.ServiceAccount = strings.TrimSpace(tokenReq.ServiceAccount)
+		token.Role = tokenReq.Role
+		if token.Role == "" {
+			token.Role = models.UserRole(user.Role)
+		}
+		if user.Role != "" && !models.UserRole(user.Role).Allows(token.Role) {
+			return nil, http.StatusBadRequest, fmt.Errorf("the %s role exceeds the role of the user", token.Role)
+		}
+	}
+
+	if len(token.Contexts) > 0 {
+		providerToken, _ := req.Context().Value(models.TokenCtxKey).(string)
+		for _, id := range token.Contexts {
+			if _, err := provider.GetK8sContext(providerToken, id); err != nil {
+				return nil, http.StatusBadRequest, fmt.Errorf("unknown kubernetes context %s", id)
+			}
+		}
+	}
+
+	expiry, _ := tokenReq.Expiry()
+	token.ExpiresAt = time.Now().Add(expiry)
+
+	return token, http.StatusOK, nil
+}
+
+// apiTokensEnabled responds with an error if the API tokens are not persisted
+func (h *Handler) apiTokensEnabled(w http.ResponseWriter) bool {
+	if h.config.APITokenPersister != nil {
+		return true
+	}
+
+	err := ErrAPITokens(fmt.Errorf("API tokens are not enabled"))
+	h.log.Error(err)
+	http.Error(w, err.Error(), http.StatusNotImplemented)
+	return false
+}
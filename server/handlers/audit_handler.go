@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/system/audit SystemAPI idGetAuditEvents
+// Handle GET request for the audit log
+//
+// Returns the mutating API calls and GraphQL mutations recorded in the audit log, the latest first.
+// The events are filtered by the user, provider, kind, route, outcome, context, pattern, adapter, from
+// and to query parameters, from and to being dates or RFC 3339 times. With format=jsonl the matching
+// events are exported as JSON Lines, the oldest first
+// responses:
+// 	200: auditEventsResponseWrapper
+
+// GetAuditEventsHandler returns or exports the events of the audit log
+func (h *Handler) GetAuditEventsHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	if !h.auditLogEnabled(w) {
+		return
+	}
+
+	filter, err := auditFilter(req)
+	if err != nil {
+		h.log.Error(ErrAuditLog(err))
+		http.Error(w, ErrAuditLog(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	q := req.URL.Query()
+	if q.Get("format") == "jsonl" {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="audit_%s.jsonl"`, time.Now().UTC().Format("20060102T150405Z")))
+		if err := h.config.AuditPersister.ExportAuditEvents(w, filter); err != nil {
+			// the status is already sent, the export is truncated
+			h.log.Error(ErrAuditLog(err))
+		}
+		return
+	}
+
+	page, _ := strconv.ParseUint(q.Get("page"), 10, 32)
+	pageSize, _ := strconv.ParseUint(q.Get("pageSize"), 10, 32)
+	if pageSize == 0 {
+		pageSize = 25
+	}
+	events, err := h.config.AuditPersister.GetAuditEvents(filter, page, pageSize)
+	if err != nil {
+		h.log.Error(ErrAuditLog(err))
+		http.Error(w, ErrAuditLog(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(events); err != nil {
+		h.log.Error(ErrEncoding(err, "audit events"))
+		http.Error(w, ErrEncoding(err, "audit events").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/system/audit/verify SystemAPI idVerifyAuditLog
+// Handle GET request to verify the audit log
+//
+// Checks the hash chain of the audit log and returns the first event which was modified or
+// whose previous event was removed, the events deleted by the retention are not reported
+// responses:
+// 	200: auditVerificationResponseWrapper
+
+// VerifyAuditLogHandler verifies the hash chain of the audit log
+func (h *Handler) VerifyAuditLogHandler(w http.ResponseWriter, _ *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
+	if !h.auditLogEnabled(w) {
+		return
+	}
+
+	result, err := h.config.AuditPersister.VerifyAuditChain()
+	if err != nil {
+		h.log.Error(ErrAuditLog(err))
+		http.Error(w, ErrAuditLog(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		h.log.Error(ErrEncoding(err, "audit log verification"))
+		http.Error(w, ErrEncoding(err, "audit log verification").Error(), http.StatusInternalServerError)
+	}
+}
+
+func (h *Handler) auditLogEnabled(w http.ResponseWriter) bool {
+	if h.config.AuditPersister == nil {
+		http.Error(w, "the audit log is not enabled", http.StatusNotImplemented)
+		return false
+	}
+
+	return true
+}
+
+// auditFilter reads the filter of the audit log from the query of the request
+func auditFilter(req *http.Request) (models.AuditFilter, error) {
+	q := req.URL.Query()
+	from, err := parseExportTime(q.Get("from"), false)
+	if err != nil {
+		return models.AuditFilter{}, err
+	}
+	to, err := parseExportTime(q.Get("to"), true)
+	if err != nil {
+		return models.AuditFilter{}, err
+	}
+
+	return models.AuditFilter{
+		UserID:     q.Get("user"),
+		Provider:   q.Get("provider"),
+		Kind:       q.Get("kind"),
+		Route:      q.Get("route"),
+		Outcome:    q.Get("outcome"),
+		K8sContext: q.Get("context"),
+		PatternID:  q.Get("pattern"),
+		AdapterID:  q.Get("adapter"),
+		From:       from,
+		To:         to,
+	}, nil
+}
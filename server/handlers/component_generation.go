@@ -3,7 +3,9 @@ package handlers
 import (
 	"encoding/json"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/layer5io/meshery/server/models"
 	meshkitmodels "github.com/layer5io/meshkit/models"
@@ -28,14 +30,15 @@ type componentGenerationResponseDataItem struct {
 // swagger:route POST /api/meshmodel/component/generate MeshmodelComponentGenerate idPostMeshModelComponentGenerate
 // Handle POST request for component generation
 //
-// Generates Meshery Components for the given payload
+// Generates Meshery Components for the given payload. Every package counts against the rate limit
+// of the user, set by COMPONENT_GENERATION_RATE_LIMIT, over which the response is 429
 // responses:
 // 	200:
 
 // request body should be json
 // request body should be of format - {data: [{name: string, register: boolean}]}
 // response format - {data: [{name: string, components: [component], errors: [string] }]}
-func (h *Handler) ComponentGenerationHandler(rw http.ResponseWriter, r *http.Request) {
+func (h *Handler) ComponentGenerationHandler(rw http.ResponseWriter, r *http.Request, _ *models.Preference, user *models.User, _ models.Provider) {
 	// Parse the request body
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
@@ -51,6 +54,16 @@ func (h *Handler) ComponentGenerationHandler(rw http.ResponseWriter, r *http.Req
 		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
 		return
 	}
+	// Every package is fetched from ArtifactHub, so each one counts against the rate limit
+	if ok, retryAfter := h.config.ComponentGenerationLimiter.Allow(user.UserID, len(pld.Data)); !ok {
+		err := ErrComponentGenerationRateLimit(len(pld.Data), h.config.ComponentGenerationLimiter.Burst())
+		h.log.Error(err)
+		if retryAfter > 0 {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		}
+		http.Error(rw, err.Error(), http.StatusTooManyRequests)
+		return
+	}
 	// Generate Components
 	response := make([]componentGenerationResponseDataItem, 0)
 	for _, gpi := range pld.Data {
@@ -93,12 +93,15 @@ func (mc *ContentModifier) isPatternSupported(ctx context.Context, patternfile s
 		ctx,
 		mc.provider,
 		patternFile,
+		nil,
 		mc.prefObj,
 		mc.userID,
 		false,
 		true,
+		false,
 		true,
 		nil,
+		nil,
 	)
 	if err != nil {
 		return err.Error(), false
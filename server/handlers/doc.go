@@ -53,11 +53,143 @@ type mesheryPatternResponseWrapper struct {
 	Body models.MesheryPattern
 }
 
+// Returns the recorded pattern deployments
+// swagger:response patternDeploymentsResponseWrapper
+type patternDeploymentsResponseWrapper struct {
+	// in: body
+	Body models.PatternDeploymentsAPIResponse
+}
+
+// Returns a single pattern deployment
+// swagger:response patternDeploymentResponseWrapper
+type patternDeploymentResponseWrapper struct {
+	// in: body
+	Body models.PatternDeployment
+}
+
+// Returns the drift of a pattern deployment
+// swagger:response patternDriftResponseWrapper
+type patternDriftResponseWrapper struct {
+	// in: body
+	Body models.PatternDriftReport
+}
+
+// Returns the pattern sync sources
+// swagger:response patternSyncSourcesResponseWrapper
+type patternSyncSourcesResponseWrapper struct {
+	// in: body
+	Body models.PatternSyncSourcesAPIResponse
+}
+
+// Returns a single pattern sync source
+// swagger:response patternSyncSourceResponseWrapper
+type patternSyncSourceResponseWrapper struct {
+	// in: body
+	Body models.PatternSyncSource
+}
+
+// Parameter to select the format of the exported pattern
+// swagger:parameters idGetMesheryPatternExport
+type patternExportParamsWrapper struct {
+	// Format of the export: manifest, helm or kustomize
+	// in: query
+	Format string `json:"format"`
+}
+
+// Parameters to select the performance results to compare
+// swagger:parameters idComparePerformanceResults
+type performanceComparisonParamsWrapper struct {
+	// Result the candidate is compared against, defaults to the pinned baseline of the profile
+	// in: query
+	Base string `json:"base"`
+	// Result which is compared against the base
+	// in: query
+	// required: true
+	Candidate string `json:"candidate"`
+	// Relative increase in percent of the mean or the p99 latency which is a regression,
+	// defaults to the regression threshold of the profile
+	// in: query
+	RegressionThreshold float64 `json:"regression_threshold"`
+}
+
+// Returns the comparison of two performance results
+// swagger:response performanceComparisonResponseWrapper
+type performanceComparisonResponseWrapper struct {
+	// in: body
+	Body models.PerformanceComparison
+}
+
+// swagger:parameters idPostMeshOverheadBenchmark
+type meshOverheadBenchmarkRequestWrapper struct {
+	// in: body
+	Body *models.MeshOverheadBenchmark
+}
+
+// swagger:parameters idPostUserLogin
+type localLoginRequestWrapper struct {
+	// in: body
+	Body *models.LocalUserRequest
+}
+
+// swagger:response localLoginResponseWrapper
+type localLoginResponseWrapper struct {
+	// in: body
+	Body *localLoginResponse
+}
+
+// swagger:parameters idPostLocalUser idPutLocalUser
+type localUserRequestWrapper struct {
+	// in: body
+	Body *models.LocalUserRequest
+}
+
+// swagger:response localUserResponseWrapper
+type localUserResponseWrapper struct {
+	// in: body
+	Body *models.LocalUser
+}
+
+// swagger:response localUsersResponseWrapper
+type localUsersResponseWrapper struct {
+	// in: body
+	Body []models.LocalUser
+}
+
+// swagger:parameters idPostAPIToken
+type apiTokenRequestWrapper struct {
+	// in: body
+	Body *models.APITokenRequest
+}
+
+// swagger:response apiTokenResponseWrapper
+type apiTokenResponseWrapper struct {
+	// in: body
+	Body *apiTokenResponse
+}
+
+// swagger:response apiTokensResponseWrapper
+type apiTokensResponseWrapper struct {
+	// in: body
+	Body []models.APIToken
+}
+
+// swagger:response auditEventsResponseWrapper
+type auditEventsResponseWrapper struct {
+	// in: body
+	Body *models.AuditEventPage
+}
+
+// swagger:response auditVerificationResponseWrapper
+type auditVerificationResponseWrapper struct {
+	// in: body
+	Body *models.AuditChainVerification
+}
+
 // swagger:response noContentWrapper
 type noContentWrapper struct {
 }
 
-// swagger:parameters idGetMesheryPattern idDeleteMesheryPattern idGetSinglePerformanceProfile idDeletePerformanceProfile idGETProfileResults idDeleteSchedules idGetSingleSchedule idDeleteMesheryApplicationFile idGetMesheryApplication idDeleteMesheryFilter idGetMesheryFilter
+// swagger:parameters idGetMesheryPattern idGetMesheryPatternExport idDeleteMesheryPattern idGetPatternDeployment idGetPatternDrift idPostReconcilePatternDeployment idGetPatternSyncSource idDeletePatternSyncSource idGetSinglePerformanceProfile idDeletePerformanceProfile idGETProfileResults idComparePerformanceResults idPostMeshOverheadBenchmark idPutLocalUser idDeleteLocalUser idDeleteAPIToken idDeleteSchedules idGetSingleSchedule idDeleteMesheryApplicationFile idGetMesheryApplication idDeleteMesheryFilter idGetMesheryFilter
 type IDParameterWrapper struct {
 	// id for a specific
 	// in: path
@@ -373,3 +505,24 @@ type filterFilesResponseWrapper struct {
 	// in: body
 	Body *models.MesheryFilter
 }
+
+// Returns the running load tests
+// swagger:response loadTestRunsResponseWrapper
+type loadTestRunsResponseWrapper struct {
+	// in: body
+	Body []models.LoadTestRun
+}
+
+// Returns the cancelled load test
+// swagger:response loadTestRunResponseWrapper
+type loadTestRunResponseWrapper struct {
+	// in: body
+	Body models.LoadTestRun
+}
+
+// Returns the exported performance results
+// swagger:response performanceExportResponseWrapper
+type performanceExportResponseWrapper struct {
+	// in: body
+	Body []byte
+}
@@ -107,6 +107,27 @@ const (
 	ErrClonePatternCode                 = "2246"
 	ErrCloneFilterCode                  = "2247"
 	ErrGenerateComponentsCode           = "2254"
+	ErrDryRunPatternCode                = "2257"
+	ErrSavePatternDeploymentCode        = "2258"
+	ErrGetPatternDeploymentCode         = "2259"
+	ErrDetectPatternDriftCode           = "2261"
+	ErrReconcilePatternCode             = "2262"
+	ErrSavePatternSyncSourceCode        = "2265"
+	ErrGetPatternSyncSourceCode         = "2266"
+	ErrDeletePatternSyncSourceCode      = "2267"
+	ErrExportPatternCode                = "2268"
+	ErrComparePerformanceResultsCode    = "2269"
+	ErrLoadTestWorkersCode              = "2271"
+	ErrLoadTestRunNotFoundCode          = "2276"
+	ErrExportPerformanceResultsCode     = "2278"
+	ErrMeshOverheadBenchmarkCode        = "2280"
+	ErrLocalUsersCode                   = "2284"
+	ErrLoginCode                        = "2285"
+	ErrForbiddenCode                    = "2286"
+	ErrAPITokensCode                    = "2289"
+	ErrAPITokenForbiddenCode            = "2290"
+	ErrAuditLogCode                     = "2295"
+	ErrComponentGenerationRateLimitCode = "2299"
 )
 
 var (
@@ -463,3 +484,87 @@ func ErrClonePattern(err error) error {
 func ErrCloneFilter(err error) error {
 	return errors.New(ErrCloneFilterCode, errors.Alert, []string{"Error failed to clone filter"}, []string{err.Error()}, []string{"Failed to clone Filter with the given ID"}, []string{"Check if the Filter ID is correct and the Filter is public"})
 }
+
+func ErrDryRunPattern(err error) error {
+	return errors.New(ErrDryRunPatternCode, errors.Alert, []string{"Error failed to perform a dry run of the pattern"}, []string{err.Error()}, []string{"Pattern file is invalid", "Live objects could not be fetched from the selected kubernetes contexts"}, []string{"Verify the pattern file with ?verify=true", "Make sure that the selected kubernetes contexts are reachable"})
+}
+
+func ErrSavePatternDeployment(err error) error {
+	return errors.New(ErrSavePatternDeploymentCode, errors.Alert, []string{"Error failed to record the pattern deployment"}, []string{err.Error()}, []string{"The provider does not support persisting pattern deployments", "Database could be unreachable"}, []string{"Make sure that the selected provider supports pattern deployments"})
+}
+
+func ErrGetPatternDeployment(err error) error {
+	return errors.New(ErrGetPatternDeploymentCode, errors.Alert, []string{"Error failed to fetch the pattern deployments"}, []string{err.Error()}, []string{"The provider does not support persisting pattern deployments", "Pattern deployment with the given ID does not exist"}, []string{"Make sure that the selected provider supports pattern deployments", "Verify the pattern deployment ID"})
+}
+
+func ErrDetectPatternDrift(err error) error {
+	return errors.New(ErrDetectPatternDriftCode, errors.Alert, []string{"Error failed to detect the drift of the pattern deployment"}, []string{err.Error()}, []string{"Pattern deployment was not recorded along with its pattern file", "MeshSync data could not be read from the database"}, []string{"Deploy the pattern again to record its pattern file", "Make sure that MeshSync is running in the selected kubernetes contexts"})
+}
+
+func ErrReconcilePattern(err error) error {
+	return errors.New(ErrReconcilePatternCode, errors.Alert, []string{"Error failed to reconcile the pattern deployment"}, []string{err.Error()}, []string{"Pattern deployment was not recorded along with its pattern file", "Pattern could not be deployed again to the selected kubernetes contexts"}, []string{"Deploy the pattern again to record its pattern file", "Make sure that the selected kubernetes contexts are reachable"})
+}
+
+func ErrSavePatternSyncSource(err error) error {
+	return errors.New(ErrSavePatternSyncSourceCode, errors.Alert, []string{"Error failed to save the pattern sync source"}, []string{err.Error()}, []string{"The provider does not support pattern sync sources", "Repository URL, path glob or poll interval could be invalid"}, []string{"Make sure that the selected provider supports pattern sync sources", "Verify the repository URL, path glob and poll interval of the sync source"})
+}
+
+func ErrGetPatternSyncSource(err error) error {
+	return errors.New(ErrGetPatternSyncSourceCode, errors.Alert, []string{"Error failed to fetch the pattern sync sources"}, []string{err.Error()}, []string{"The provider does not support pattern sync sources", "Pattern sync source with the given ID does not exist"}, []string{"Make sure that the selected provider supports pattern sync sources", "Verify the pattern sync source ID"})
+}
+
+func ErrDeletePatternSyncSource(err error) error {
+	return errors.New(ErrDeletePatternSyncSourceCode, errors.Alert, []string{"Error failed to delete the pattern sync source"}, []string{err.Error()}, []string{"The provider does not support pattern sync sources", "Pattern sync source with the given ID does not exist"}, []string{"Make sure that the selected provider supports pattern sync sources", "Verify the pattern sync source ID"})
+}
+
+func ErrExportPattern(err error) error {
+	return errors.New(ErrExportPatternCode, errors.Alert, []string{"Error failed to export the pattern"}, []string{err.Error()}, []string{"Pattern has services which are managed by adapters", "Export format is not supported"}, []string{"Export patterns made of kubernetes components only", "Use one of the manifest, helm or kustomize formats"})
+}
+
+func ErrComparePerformanceResults(err error) error {
+	return errors.New(ErrComparePerformanceResultsCode, errors.Alert, []string{"Error failed to compare the performance results"}, []string{err.Error()}, []string{"Base or candidate result is not given", "Results do not belong to the performance profile", "Results have no latency histogram"}, []string{"Pass both the base and candidate results or pin a baseline for the profile", "Compare results of the same performance profile"})
+}
+
+func ErrLoadTestWorkers(requested, available int) error {
+	return errors.New(ErrLoadTestWorkersCode, errors.Alert, []string{"Error not enough load test workers"}, []string{fmt.Sprintf("%d load test workers were requested but %d are configured", requested, available)}, []string{"Fewer workers are set in LOAD_TEST_WORKERS than the load test is fanned out to"}, []string{"Add the urls of more load test workers to LOAD_TEST_WORKERS", "Lower the number of workers of the load test"})
+}
+
+func ErrLoadTestRunNotFound(testID string) error {
+	return errors.New(ErrLoadTestRunNotFoundCode, errors.Alert, []string{"Error load test not running"}, []string{"No load test with the uuid " + testID + " is running"}, []string{"The load test is already over", "The load test was started by another user"}, []string{"Check the uuid of the load test among the running load tests"})
+}
+
+func ErrExportPerformanceResults(err error) error {
+	return errors.New(ErrExportPerformanceResultsCode, errors.Alert, []string{"Error failed to export the performance results"}, []string{err.Error()}, []string{"Export format is not supported", "Range of the exported results is not a valid date or time"}, []string{"Export the results as csv, jsonl, hdr or junit", "Pass dates like 2006-01-02 or RFC 3339 times as the range of the results"})
+}
+
+func ErrMeshOverheadBenchmark(err error) error {
+	return errors.New(ErrMeshOverheadBenchmarkCode, errors.Alert, []string{"Error failed to benchmark the overhead of the service mesh"}, []string{err.Error()}, []string{"Neither a pattern nor a pattern file is given", "Sidecar injection of the service mesh is not supported", "No endpoint of the performance profile has the {namespace} placeholder"}, []string{"Pass the id of a saved pattern or a pattern file", "Benchmark Istio, Linkerd or Kuma", "Reach the workload through endpoints like http://productpage.{namespace}:9080"})
+}
+
+func ErrLocalUsers(err error) error {
+	return errors.New(ErrLocalUsersCode, errors.Alert, []string{"Error failed to manage the users of the local provider"}, []string{err.Error()}, []string{"The local provider is not in multi-user mode", "The user name is already taken or the role is invalid", "The password is too short", "The last admin can't be deleted or demoted"}, []string{"Set LOCAL_PROVIDER_MULTI_USER to true", "Use the viewer, operator or admin role", "Use a password of at least 8 characters", "Make another user admin first"})
+}
+
+func ErrLogin(err error) error {
+	return errors.New(ErrLoginCode, errors.Alert, []string{"Error failed to log in"}, []string{err.Error()}, []string{"The user name or the password is wrong", "The local provider is not in multi-user mode"}, []string{"Check the credentials", "Set LOCAL_PROVIDER_MULTI_USER to true"})
+}
+
+func ErrForbidden(role, required string) error {
+	return errors.New(ErrForbiddenCode, errors.Alert, []string{"The ", role, " role is not allowed to perform this operation"}, []string{"The operation requires the " + required + " role"}, []string{"The role of the user is too low for the operation"}, []string{"Ask an admin of Meshery to grant the " + required + " role"})
+}
+
+func ErrAPITokens(err error) error {
+	return errors.New(ErrAPITokensCode, errors.Alert, []string{"Error failed to manage the API tokens"}, []string{err.Error()}, []string{"The name, a scope, the role or the expiry of the token is invalid", "A kubernetes context of the allow-list does not exist", "Only admins can create tokens of service accounts", "API tokens can't create API tokens"}, []string{"Use the read-only, pattern:deploy or perf:run scopes and an expiry of at most 8760h", "Use the ids of the contexts listed by /api/system/kubernetes/contexts", "Log in to create tokens"})
+}
+
+func ErrAPITokenForbidden(err error) error {
+	return errors.New(ErrAPITokenForbiddenCode, errors.Alert, []string{"The API token is not allowed to perform this operation"}, []string{err.Error()}, []string{"The scopes of the token don't cover the operation", "The kubernetes context is not in the allow-list of the token"}, []string{"Create a token with the required scope or context"})
+}
+
+func ErrAuditLog(err error) error {
+	return errors.New(ErrAuditLogCode, errors.Alert, []string{"Error failed to record or read the audit log"}, []string{err.Error()}, []string{"The database of Meshery is not reachable", "A filter of the audit log is invalid"}, []string{"Check the logs of Meshery and the database", "Use RFC 3339 or YYYY-MM-DD for the from and to filters"})
+}
+
+func ErrComponentGenerationRateLimit(packages, burst int) error {
+	return errors.New(ErrComponentGenerationRateLimitCode, errors.Alert, []string{"Too many component generation requests"}, []string{fmt.Sprintf("The generation of %d packages is over the rate limit of %d packages a minute", packages, burst)}, []string{"Components are generated too often", "The request holds more packages than the rate limit"}, []string{"Retry after the delay given by the Retry-After header", "Split the packages across several requests or raise COMPONENT_GENERATION_RATE_LIMIT"})
+}
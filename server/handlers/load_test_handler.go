@@ -25,6 +25,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// meshMetricsTimeout bounds the time spent collecting the metrics of the service mesh once a load test is over
+const meshMetricsTimeout = 30 * time.Second
+
 // LoadTestUsingSMPHandler runs the load test with the given parameters and SMP
 func (h *Handler) LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
 	// if req.Method != http.MethodPost && req.Method != http.MethodGet {
@@ -129,7 +132,25 @@ func (h *Handler) LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Reque
 	}
 	loadTestOptions.AllowInitialErrors = true
 
-	h.loadTestHelperHandler(w, req, profileID, testName, meshName, "", prefObj, loadTestOptions, provider)
+	// every endpoint of every client is driven when there is more than one
+	if endpoints := models.SMPClientEndpoints(perfTest.Config.Clients); len(endpoints) > 1 {
+		if err := endpoints.Validate(); err != nil {
+			h.log.Error(ErrRequestBody(err))
+			http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+			return
+		}
+		loadTestOptions.Endpoints = endpoints
+	}
+
+	workers, _ := strconv.Atoi(req.URL.Query().Get("workers"))
+	loadTestOptions.Workers, err = h.loadTestWorkers(workers)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	h.loadTestHelperHandler(w, req, profileID, testName, meshName, req.URL.Query().Get("uuid"), prefObj, loadTestOptions, provider)
 }
 
 func (h *Handler) jsonToMap(headersString string) *map[string]string {
@@ -194,22 +215,6 @@ func (h *Handler) LoadTestHandler(w http.ResponseWriter, req *http.Request, pref
 	// getting profile id from URL
 	profileID := mux.Vars(req)["id"]
 
-	headersString := q.Get("headers")
-	cookiesString := q.Get("cookies")
-	contentType := q.Get("contentType")
-	bodyString := q.Get("reqBody")
-
-	headers := h.jsonToMap(headersString)
-	cookies := h.jsonToMap(cookiesString)
-	body = []byte(bodyString)
-	h.log.Debug("Headers : ", headers)
-
-	loadTestOptions := &models.LoadTestOptions{}
-	loadTestOptions.Headers = headers
-	loadTestOptions.Cookies = cookies
-	loadTestOptions.Body = body
-	loadTestOptions.ContentType = contentType
-
 	tt, _ := strconv.Atoi(q.Get("t"))
 	if tt < 1 {
 		tt = 1
@@ -224,54 +229,47 @@ func (h *Handler) LoadTestHandler(w http.ResponseWriter, req *http.Request, pref
 	default:
 		dur = "s"
 	}
-	loadTestOptions.Duration, err = time.ParseDuration(fmt.Sprintf("%d%s", tt, dur))
+	qps, _ := strconv.ParseFloat(q.Get("qps"), 64)
+	threads, _ := strconv.Atoi(q.Get("c"))
+	payloadSize, _ := strconv.Atoi(q.Get("payloadSize"))
+	workers, _ := strconv.Atoi(q.Get("workers"))
+	disableConnectionReuse, _ := strconv.ParseBool(q.Get("disableConnectionReuse"))
+
+	loadTestOptions, err := h.buildLoadTestOptions(loadTestParams{
+		name:                   testName,
+		url:                    q.Get("url"),
+		qps:                    qps,
+		threads:                threads,
+		duration:               fmt.Sprintf("%d%s", tt, dur),
+		headers:                q.Get("headers"),
+		cookies:                q.Get("cookies"),
+		body:                   q.Get("reqBody"),
+		contentType:            q.Get("contentType"),
+		loadGenerator:          q.Get("loadGenerator"),
+		payloadSize:            payloadSize,
+		disableConnectionReuse: disableConnectionReuse,
+		workers:                workers,
+	})
 	if err != nil {
-		obj := "load test duration"
-		h.log.Error(ErrParseBool(err, obj))
-		http.Error(w, ErrParseBool(err, obj).Error(), http.StatusForbidden)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-
-	cc, _ := strconv.Atoi(q.Get("c"))
-	if cc < 1 {
-		cc = 1
-	}
-	loadTestOptions.HTTPNumThreads = cc
-
-	loadTestURL := q.Get("url")
-	ltURL, err := url.Parse(loadTestURL)
-	if err != nil || !ltURL.IsAbs() {
-		obj := "the provided load test url"
-		h.log.Error(ErrParseBool(err, obj))
-		http.Error(w, ErrParseBool(err, obj).Error(), http.StatusBadRequest)
-		return
-	}
-	loadTestOptions.URL = loadTestURL
-	loadTestOptions.Name = testName
-	loadTestOptions.AllowInitialErrors = true
-
-	qps, _ := strconv.ParseFloat(q.Get("qps"), 64)
-	if qps < 0 {
-		qps = 0
-	}
-	loadTestOptions.HTTPQPS = qps
-
-	loadGenerator := q.Get("loadGenerator")
-
-	switch loadGenerator {
-	case models.Wrk2LG.Name():
-		loadTestOptions.LoadGenerator = models.Wrk2LG
-	case models.NighthawkLG.Name():
-		loadTestOptions.LoadGenerator = models.NighthawkLG
-	default:
-		loadTestOptions.LoadGenerator = models.FortioLG
-	}
 	h.log.Info("perf test with config: ", loadTestOptions)
 	h.loadTestHelperHandler(w, req, profileID, testName, meshName, testUUID, prefObj, loadTestOptions, provider)
 }
 
 func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request, profileID, testName, meshName, testUUID string,
 	prefObj *models.Preference, loadTestOptions *models.LoadTestOptions, provider models.Provider) {
+	h.streamLoadTestResponses(w, req, func(respChan chan *models.LoadTestResponse) {
+		ctx := context.Background()
+		h.executeLoadTest(ctx, req, profileID, testName, meshName, testUUID, prefObj, provider, loadTestOptions, respChan)
+	})
+}
+
+// streamLoadTestResponses streams the responses sent by run as server sent events, the
+// stream ends once run returns or the client goes away
+func (h *Handler) streamLoadTestResponses(w http.ResponseWriter, req *http.Request, run func(respChan chan *models.LoadTestResponse)) {
 	log := logrus.WithField("file", "load_test_handler")
 
 	flusher, ok := w.(http.Flusher)
@@ -316,8 +314,7 @@ func (h *Handler) loadTestHelperHandler(w http.ResponseWriter, req *http.Request
 		h.log.Debug("response channel closed")
 	}()
 	go func() {
-		ctx := context.Background()
-		h.executeLoadTest(ctx, req, profileID, testName, meshName, testUUID, prefObj, provider, loadTestOptions, respChan)
+		run(respChan)
 		close(respChan)
 	}()
 	select {
@@ -335,17 +332,109 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 		Status:  models.LoadTestInfo,
 		Message: "Initiating load test . . . ",
 	}
+
+	// the options of the test default to the ones of the performance profile
+	if profile := h.getPerformanceProfile(req, provider, profileID); profile != nil {
+		if loadTestOptions.SLO == nil && profile.SLO != nil && !profile.SLO.IsEmpty() {
+			loadTestOptions.SLO = profile.SLO
+		}
+		if loadTestOptions.BaselineResult == nil {
+			loadTestOptions.BaselineResult = profile.BaselineResult
+		}
+		if loadTestOptions.RegressionThreshold == 0 {
+			loadTestOptions.RegressionThreshold = profile.RegressionThreshold
+		}
+		if len(loadTestOptions.Stages) == 0 {
+			loadTestOptions.Stages = profile.Stages
+		}
+		// a test of one of the endpoints of the profile drives all of them
+		if len(loadTestOptions.Endpoints) == 0 && isProfileEndpoint(profile, loadTestOptions.URL) {
+			loadTestOptions.Endpoints = profile.GetLoadTestEndpoints()
+		}
+		if loadTestOptions.PayloadSize == 0 {
+			loadTestOptions.PayloadSize = profile.PayloadSize
+		}
+		if !loadTestOptions.DisableConnectionReuse {
+			loadTestOptions.DisableConnectionReuse = profile.DisableConnectionReuse
+		}
+		if len(loadTestOptions.Workers) == 0 {
+			workers, err := h.loadTestWorkers(profile.Workers)
+			if err != nil {
+				h.log.Error(err)
+				respChan <- &models.LoadTestResponse{
+					Status:  models.LoadTestError,
+					Message: err.Error(),
+				}
+				return
+			}
+			loadTestOptions.Workers = workers
+		}
+	}
+	if len(loadTestOptions.Workers) > 0 {
+		loadTestOptions.WorkerToken = h.config.LoadTestWorkerToken
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: fmt.Sprintf("Fanning the load test out to %d workers", len(loadTestOptions.Workers)),
+		}
+	}
+	if len(loadTestOptions.Endpoints) > 0 {
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: fmt.Sprintf("Driving %d endpoints at once", len(loadTestOptions.Endpoints)),
+		}
+	}
+	if len(loadTestOptions.Stages) > 0 {
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: fmt.Sprintf("Running %d stages of the load test over %s", len(loadTestOptions.Stages), loadTestOptions.Stages.TotalDuration()),
+		}
+	}
+
+	// the test is tracked by its uuid so that it can be monitored and cancelled while it runs
+	runID := testUUID
+	if runID == "" {
+		id, _ := uuid.NewV4()
+		runID = id.String()
+	}
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	run := &models.LoadTestRun{
+		ID:                 runID,
+		Name:               testName,
+		PerformanceProfile: profileID,
+		StartedAt:          time.Now(),
+	}
+	if user, ok := req.Context().Value(models.UserCtxKey).(*models.User); ok && user != nil {
+		run.UserID = user.UserID
+	}
+	if err := h.config.LoadTestRuns.Register(run, cancel); err != nil {
+		h.log.Error(err)
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestError,
+			Message: err.Error(),
+		}
+		return
+	}
+	loadTestOptions.Ctx = runCtx
+	loadTestOptions.Progress = models.NewLoadTestProgressRecorder()
+	stopProgress := h.reportLoadTestProgress(runID, loadTestOptions.Progress, respChan)
+
 	var (
 		resultsMap map[string]interface{}
 		resultInst *periodic.RunnerResults
 		err        error
 	)
-	if loadTestOptions.LoadGenerator == models.Wrk2LG {
-		resultsMap, resultInst, err = helpers.WRK2LoadTest(loadTestOptions)
-	} else if loadTestOptions.LoadGenerator == models.NighthawkLG {
-		resultsMap, resultInst, err = helpers.NighthawkLoadTest(loadTestOptions)
-	} else {
-		resultsMap, resultInst, err = helpers.FortioLoadTest(loadTestOptions)
+	resultsMap, resultInst, err = helpers.LoadTest(loadTestOptions)
+	stopProgress()
+	h.config.LoadTestRuns.Deregister(runID)
+	// the partial results of a cancelled test are not persisted
+	if runCtx.Err() != nil {
+		h.log.Info("load test ", runID, " cancelled")
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestError,
+			Message: "load test cancelled",
+		}
+		return
 	}
 	if err != nil {
 		h.log.Error(ErrLoadTest(err, "unable to perform"))
@@ -362,6 +451,58 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 	}
 
 	resultsMap["load-generator"] = loadTestOptions.LoadGenerator
+	if loadTestOptions.ServiceMesh != nil {
+		resultsMap[models.ServiceMeshKey] = loadTestOptions.ServiceMesh
+	}
+
+	if queries := h.config.MeshMetricQueries.ForMesh(meshName); len(queries) > 0 && prefObj.Prometheus != nil && prefObj.Prometheus.PrometheusURL != "" && h.config.PrometheusClient != nil {
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: "Collecting the metrics of " + meshName + " during the load test",
+		}
+		metrics := h.collectMeshMetrics(prefObj.Prometheus.PrometheusURL, queries, resultInst)
+		resultsMap[models.MeshMetricsKey] = metrics
+		if len(metrics.Errors) > 0 {
+			respChan <- &models.LoadTestResponse{
+				Status:  models.LoadTestInfo,
+				Message: fmt.Sprintf("Unable to collect %d of the %d metrics of %s", len(metrics.Errors), len(queries), meshName),
+			}
+		}
+	}
+
+	slo, baseline := loadTestOptions.SLO, loadTestOptions.BaselineResult
+	if slo != nil {
+		verdict := slo.Evaluate(resultsMap)
+		resultsMap[models.PerformanceSLOVerdictKey] = verdict
+
+		msg := "All the SLO thresholds were met"
+		if verdict.Status == models.PerformanceVerdictFail {
+			breaches := make([]string, 0, len(verdict.Breaches))
+			for _, b := range verdict.Breaches {
+				breaches = append(breaches, b.Message)
+			}
+			msg = "SLO breached: " + strings.Join(breaches, ", ")
+		}
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: msg,
+		}
+	}
+
+	if baseline != nil {
+		if comparison := h.compareWithBaseline(req, provider, *baseline, loadTestOptions.RegressionThreshold, resultsMap); comparison != nil {
+			resultsMap[models.PerformanceComparisonKey] = comparison
+
+			msg := "No regression against the baseline " + baseline.String()
+			if comparison.Regressed {
+				msg = "Regression against the baseline " + baseline.String() + ": " + strings.Join(comparison.Reasons, ", ")
+			}
+			respChan <- &models.LoadTestResponse{
+				Status:  models.LoadTestInfo,
+				Message: msg,
+			}
+		}
+	}
 
 	mk8sContexts, ok := req.Context().Value(models.KubeClustersKey).([]models.K8sContext)
 	if !ok || len(mk8sContexts) == 0 {
@@ -492,6 +633,327 @@ func (h *Handler) executeLoadTest(ctx context.Context, req *http.Request, profil
 	wg.Wait()
 }
 
+// RunScheduledLoadTest runs the given performance profile through the same path as
+// LoadTestHandler, as the owner of the schedule. It is invoked by the performance
+// scheduler of the local provider
+func (h *Handler) RunScheduledLoadTest(ctx context.Context, provider models.Provider, schedule *models.Schedule, profile *models.PerformanceProfile) ([]string, error) {
+	if profile.ID == nil {
+		return nil, ErrInvalidRequestObject("performance profile id")
+	}
+	profileID := profile.ID.String()
+
+	loadTestOptions, err := h.loadTestOptionsFromProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _ := ctx.Value(models.TokenCtxKey).(string)
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		return nil, err
+	}
+	k8scontexts := []models.K8sContext{}
+	for _, c := range contexts {
+		if c != nil && schedule.AllowsContext(c.ID) {
+			k8scontexts = append(k8scontexts, *c)
+		}
+	}
+	if len(k8scontexts) == 0 {
+		return nil, ErrInvalidK8SConfig
+	}
+
+	ctx = context.WithValue(ctx, models.KubeClustersKey, k8scontexts)
+	ctx = context.WithValue(ctx, models.ScheduledRunUserCtxKey, schedule.UserID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/api/user/performance/profiles/"+profileID+"/run", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := provider.GetUserDetails(req)
+	if err != nil {
+		return nil, err
+	}
+	prefObj, err := provider.ReadFromPersister(user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	respChan := make(chan *models.LoadTestResponse, 100)
+	go func() {
+		h.executeLoadTest(ctx, req, profileID, profile.Name, profile.ServiceMesh, "", prefObj, provider, loadTestOptions, respChan)
+		close(respChan)
+	}()
+
+	resultIDs := []string{}
+	errMsgs := []string{}
+	for resp := range respChan {
+		switch resp.Status {
+		case models.LoadTestSuccess:
+			if resp.Result != nil {
+				resultIDs = append(resultIDs, resp.Result.ID.String())
+				if verdict := resp.Result.GetVerdict(); verdict != nil && verdict.Status == models.PerformanceVerdictFail {
+					errMsgs = append(errMsgs, "SLO breached by result "+resp.Result.ID.String())
+				}
+			}
+		case models.LoadTestError:
+			errMsgs = append(errMsgs, resp.Message)
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return resultIDs, ErrLoadTest(errors.New(strings.Join(errMsgs, ", ")), "scheduled run")
+	}
+	return resultIDs, nil
+}
+
+// collectMeshMetrics queries Prometheus for the metrics of the service mesh over the window of the load test
+func (h *Handler) collectMeshMetrics(promURL string, queries []models.MeshMetricQuery, result *periodic.RunnerResults) *models.MeshMetrics {
+	ctx, cancel := context.WithTimeout(context.Background(), meshMetricsTimeout)
+	defer cancel()
+
+	return h.config.PrometheusClient.QueryMeshMetrics(ctx, promURL, queries, result.StartTime, result.StartTime.Add(result.ActualDuration))
+}
+
+// reportLoadTestProgress sends a snapshot of the progress of the running load test on
+// the response channel and to its subscribers every models.LoadTestSnapshotInterval,
+// the reporting is over once stop returns
+func (h *Handler) reportLoadTestProgress(testID string, progress *models.LoadTestProgressRecorder, respChan chan *models.LoadTestResponse) (stop func()) {
+	ticker := time.NewTicker(models.LoadTestSnapshotInterval)
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-ticker.C:
+				snapshot := progress.Snapshot(testID)
+				h.config.LoadTestRuns.Publish(snapshot)
+				respChan <- &models.LoadTestResponse{
+					Status:   models.LoadTestProgress,
+					Message:  fmt.Sprintf("%d requests in %.0fs, %.1f QPS, p99 latency %.2fms", snapshot.Requests, snapshot.Elapsed, snapshot.QPS, snapshot.LatencyP99),
+					Snapshot: snapshot,
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		ticker.Stop()
+		close(done)
+		<-stopped
+	}
+}
+
+// getPerformanceProfile returns the performance profile with the given id, nil
+// if it can't be fetched
+func (h *Handler) getPerformanceProfile(req *http.Request, provider models.Provider, profileID string) *models.PerformanceProfile {
+	if profileID == "" {
+		return nil
+	}
+
+	resp, err := provider.GetPerformanceProfile(req, profileID)
+	if err != nil {
+		h.log.Debug("unable to fetch the performance profile: ", err)
+		return nil
+	}
+
+	profile := &models.PerformanceProfile{}
+	if err := json.Unmarshal(resp, profile); err != nil {
+		h.log.Error(ErrUnmarshal(err, "performance profile"))
+		return nil
+	}
+
+	return profile
+}
+
+// loadTestWorkers returns the urls of the first n load test workers of the server
+func (h *Handler) loadTestWorkers(n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	if n > len(h.config.LoadTestWorkers) {
+		return nil, ErrLoadTestWorkers(n, len(h.config.LoadTestWorkers))
+	}
+
+	return h.config.LoadTestWorkers[:n], nil
+}
+
+// isProfileEndpoint checks if the url is one of the endpoints of the profile
+func isProfileEndpoint(profile *models.PerformanceProfile, u string) bool {
+	if u == "" {
+		return true
+	}
+	for _, e := range profile.Endpoints {
+		if e == u {
+			return true
+		}
+	}
+	for _, e := range profile.Scenario {
+		if e.URL == u {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compareWithBaseline compares the runner results with the pinned baseline result,
+// nil if the baseline can't be fetched or compared
+func (h *Handler) compareWithBaseline(req *http.Request, provider models.Provider, baseline uuid.UUID, threshold float64, resultsMap map[string]interface{}) *models.PerformanceComparison {
+	token, _ := provider.GetProviderToken(req)
+	base, err := provider.GetResult(token, baseline)
+	if err != nil || base == nil {
+		h.log.Debug("unable to fetch the baseline result: ", err)
+		return nil
+	}
+
+	// The runner results are compared the way they are read back once persisted
+	b, err := json.Marshal(resultsMap)
+	if err != nil {
+		h.log.Error(ErrMarshal(err, "runner results"))
+		return nil
+	}
+	candidate := map[string]interface{}{}
+	if err := json.Unmarshal(b, &candidate); err != nil {
+		h.log.Error(ErrUnmarshal(err, "runner results"))
+		return nil
+	}
+
+	comparison, err := models.ComparePerformanceResults(base.Result, candidate, threshold)
+	if err != nil {
+		h.log.Error(ErrComparePerformanceResults(err))
+		return nil
+	}
+	comparison.BaseResultID = &baseline
+
+	return comparison
+}
+
+// loadTestParams are the parameters of a load test, as passed to LoadTestHandler or
+// stored in a performance profile
+type loadTestParams struct {
+	name                   string
+	url                    string
+	qps                    float64
+	threads                int
+	duration               string
+	headers                string
+	cookies                string
+	body                   string
+	contentType            string
+	loadGenerator          string
+	payloadSize            int
+	disableConnectionReuse bool
+	workers                int
+}
+
+// buildLoadTestOptions validates the parameters and builds the load test options out
+// of them, the parameters which are out of range are clamped to their defaults
+func (h *Handler) buildLoadTestOptions(params loadTestParams) (*models.LoadTestOptions, error) {
+	ltURL, err := url.Parse(params.url)
+	if err != nil {
+		obj := "the provided load test url"
+		return nil, ErrParseBool(err, obj)
+	}
+	if !ltURL.IsAbs() {
+		return nil, ErrInvalidLTURL(ltURL.String())
+	}
+
+	loadTestOptions := &models.LoadTestOptions{
+		Name:                   params.name,
+		URL:                    ltURL.String(),
+		HTTPQPS:                params.qps,
+		HTTPNumThreads:         params.threads,
+		Headers:                h.jsonToMap(params.headers),
+		Cookies:                h.jsonToMap(params.cookies),
+		Body:                   []byte(params.body),
+		ContentType:            params.contentType,
+		AllowInitialErrors:     true,
+		PayloadSize:            params.payloadSize,
+		DisableConnectionReuse: params.disableConnectionReuse,
+	}
+	loadTestOptions.Workers, err = h.loadTestWorkers(params.workers)
+	if err != nil {
+		return nil, err
+	}
+
+	loadTestOptions.Duration, err = time.ParseDuration(params.duration)
+	if err != nil {
+		return nil, ErrParseDuration
+	}
+	if loadTestOptions.Duration.Seconds() <= 0 {
+		loadTestOptions.Duration = time.Second
+	}
+	if loadTestOptions.HTTPNumThreads < 1 {
+		loadTestOptions.HTTPNumThreads = 1
+	}
+	if loadTestOptions.HTTPQPS < 0 {
+		loadTestOptions.HTTPQPS = 0
+	}
+	if loadTestOptions.PayloadSize < 0 {
+		loadTestOptions.PayloadSize = 0
+	}
+
+	switch params.loadGenerator {
+	case models.Wrk2LG.Name():
+		loadTestOptions.LoadGenerator = models.Wrk2LG
+	case models.NighthawkLG.Name():
+		loadTestOptions.LoadGenerator = models.NighthawkLG
+	default:
+		loadTestOptions.LoadGenerator = models.FortioLG
+	}
+
+	return loadTestOptions, nil
+}
+
+// loadTestOptionsFromProfile builds the load test options out of the parameters
+// stored in the performance profile
+func (h *Handler) loadTestOptionsFromProfile(profile *models.PerformanceProfile) (*models.LoadTestOptions, error) {
+	endpoints := []string(profile.Endpoints)
+	for _, e := range profile.Scenario {
+		endpoints = append(endpoints, e.URL)
+	}
+	if len(endpoints) == 0 {
+		return nil, models.ErrTestEndpoint
+	}
+
+	loadGenerator := ""
+	if len(profile.LoadGenerators) > 0 {
+		loadGenerator = profile.LoadGenerators[0]
+	}
+
+	loadTestOptions, err := h.buildLoadTestOptions(loadTestParams{
+		name:                   profile.Name,
+		url:                    endpoints[0],
+		qps:                    float64(profile.QPS),
+		threads:                profile.ConcurrentRequest,
+		duration:               profile.Duration,
+		headers:                profile.RequestHeaders,
+		cookies:                profile.RequestCookies,
+		body:                   profile.RequestBody,
+		contentType:            profile.ContentType,
+		loadGenerator:          loadGenerator,
+		payloadSize:            profile.PayloadSize,
+		disableConnectionReuse: profile.DisableConnectionReuse,
+		workers:                profile.Workers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.SLO != nil && !profile.SLO.IsEmpty() {
+		loadTestOptions.SLO = profile.SLO
+	}
+	loadTestOptions.BaselineResult = profile.BaselineResult
+	loadTestOptions.RegressionThreshold = profile.RegressionThreshold
+	loadTestOptions.Stages = profile.Stages
+	loadTestOptions.Endpoints = profile.GetLoadTestEndpoints()
+
+	return loadTestOptions, nil
+}
+
 // CollectStaticMetrics is used for collecting static metrics from prometheus and submitting it to Remote Provider
 func (h *Handler) CollectStaticMetrics(config *models.SubmitMetricsConfig) error {
 	h.log.Debug("initiating collecting prometheus static board metrics for test id: ", config.TestUUID)
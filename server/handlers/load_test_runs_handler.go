@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/perf/run PerformanceAPI idGetLoadTestRuns
+// Handle GET requests for the running load tests
+//
+// Returns the load tests of the user which are running along with their latest progress
+// responses:
+// 	200: loadTestRunsResponseWrapper
+
+// GetLoadTestRunsHandler returns the load tests of the user which are running
+func (h *Handler) GetLoadTestRunsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	runs := []models.LoadTestRun{}
+	for _, run := range h.config.LoadTestRuns.List() {
+		if isLoadTestRunOwner(run, user) {
+			runs = append(runs, run)
+		}
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(runs); err != nil {
+		obj := "running load tests"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(rw, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/perf/run/{uuid} PerformanceAPI idCancelLoadTestRun
+// Handle DELETE requests for running load tests
+//
+// Cancels the running load test with the given test uuid, its results are not persisted
+// responses:
+// 	200: loadTestRunResponseWrapper
+
+// CancelLoadTestHandler cancels the running load test with the given test uuid
+func (h *Handler) CancelLoadTestHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	testID := mux.Vars(r)["uuid"]
+
+	// the load tests of other users are reported as not found
+	run, ok := h.config.LoadTestRuns.Get(testID)
+	if !ok || !isLoadTestRunOwner(run, user) || !h.config.LoadTestRuns.Cancel(testID) {
+		h.log.Error(ErrLoadTestRunNotFound(testID))
+		http.Error(rw, ErrLoadTestRunNotFound(testID).Error(), http.StatusNotFound)
+		return
+	}
+	h.log.Info("cancelled the load test ", testID)
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(run); err != nil {
+		obj := "cancelled load test"
+		h.log.Error(ErrMarshal(err, obj))
+		http.Error(rw, ErrMarshal(err, obj).Error(), http.StatusInternalServerError)
+	}
+}
+
+// isLoadTestRunOwner tells if the load test was started by the user, load tests started
+// by the performance scheduler are not owned by any user
+func isLoadTestRunOwner(run models.LoadTestRun, user *models.User) bool {
+	return run.UserID == "" || (user != nil && run.UserID == user.UserID)
+}
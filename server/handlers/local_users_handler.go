@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// localLoginResponse is the response of a successful login of a local user
+type localLoginResponse struct {
+	User  *models.User `json:"user"`
+	Token string       `json:"token"`
+}
+
+// multiUserProvider returns the local provider if it is in multi-user mode
+func multiUserProvider(provider models.Provider) (*models.DefaultLocalProvider, error) {
+	lp, ok := provider.(*models.DefaultLocalProvider)
+	if !ok || !lp.MultiUser {
+		return nil, fmt.Errorf("users are only managed by the local provider in multi-user mode")
+	}
+
+	return lp, nil
+}
+
+// swagger:route POST /api/user/login UserAPI idPostUserLogin
+// Handle POST request for the login of a local user
+//
+// Checks the credentials of a user of the local provider in multi-user mode and issues a session.
+// Credentials posted by the login form redirect to the page the user came from, JSON ones
+// get the user and the session token to use as a bearer token
+// responses:
+// 	200: localLoginResponseWrapper
+
+// LocalLoginHandler logs in a user of the local provider with their password
+func (h *Handler) LocalLoginHandler(w http.ResponseWriter, req *http.Request, provider models.Provider) {
+	lp, err := multiUserProvider(provider)
+	if err != nil {
+		h.log.Error(ErrLogin(err))
+		http.Error(w, ErrLogin(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	fromForm := !strings.HasPrefix(req.Header.Get("Content-Type"), "application/json")
+	credentials := models.LocalUserRequest{}
+	if fromForm {
+		credentials.UserName, credentials.Password = req.PostFormValue("user_name"), req.PostFormValue("password")
+	} else if err := json.NewDecoder(req.Body).Decode(&credentials); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	user, token, err := lp.LoginWithPassword(w, credentials.UserName, credentials.Password)
+	if err != nil {
+		h.log.Error(ErrLogin(err))
+		if fromForm {
+			http.Redirect(w, req, "/user/login?error=1&return_to="+url.QueryEscape(req.PostFormValue("return_to")), http.StatusFound)
+			return
+		}
+		http.Error(w, ErrLogin(err).Error(), http.StatusUnauthorized)
+		return
+	}
+	models.AuditEventFromContext(req.Context()).SetActor(user, provider, nil)
+
+	if fromForm {
+		http.Redirect(w, req, models.SafeReturnURL(req.PostFormValue("return_to")), http.StatusFound)
+		return
+	}
+	if err := json.NewEncoder(w).Encode(&localLoginResponse{User: user, Token: token}); err != nil {
+		h.log.Error(ErrEncoding(err, "user"))
+		http.Error(w, ErrEncoding(err, "user").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route GET /api/users UserAPI idGetLocalUsers
+// Handle GET request for the users of the local provider
+//
+// Returns the users of the local provider in multi-user mode, only admins can manage the users
+// responses:
+// 	200: localUsersResponseWrapper
+
+// GetLocalUsersHandler returns the users of the local provider
+func (h *Handler) GetLocalUsersHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
+	lp, err := multiUserProvider(provider)
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	users, err := lp.LocalUserPersister.GetUsers()
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(users); err != nil {
+		h.log.Error(ErrEncoding(err, "users"))
+		http.Error(w, ErrEncoding(err, "users").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/users UserAPI idPostLocalUser
+// Handle POST request to create a user of the local provider
+//
+// Creates a user of the local provider in multi-user mode, a role and a password of at least 8 characters are required
+// responses:
+// 	201: localUserResponseWrapper
+
+// SaveLocalUserHandler creates a user of the local provider
+func (h *Handler) SaveLocalUserHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
+	lp, err := multiUserProvider(provider)
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	userReq := models.LocalUserRequest{}
+	if err := json.NewDecoder(req.Body).Decode(&userReq); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if userReq.Password == "" {
+		err := ErrLocalUsers(fmt.Errorf("the password is required"))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if _, err := lp.LocalUserPersister.GetUserByName(userReq.UserName); err == nil {
+		err := ErrLocalUsers(fmt.Errorf("the user name %s is already taken", userReq.UserName))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+
+	user := &models.LocalUser{}
+	if err := userReq.Apply(user); err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := lp.LocalUserPersister.SaveUser(user); err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		h.log.Error(ErrEncoding(err, "user"))
+	}
+}
+
+// swagger:route PUT /api/users/{id} UserAPI idPutLocalUser
+// Handle PUT request to update a user of the local provider
+//
+// Updates the names, the role or the password of a user of the local provider, the last admin can't be demoted
+// responses:
+// 	200: localUserResponseWrapper
+
+// UpdateLocalUserHandler updates a user of the local provider
+func (h *Handler) UpdateLocalUserHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
+	lp, user, ok := h.localUser(w, req, provider)
+	if !ok {
+		return
+	}
+
+	userReq := models.LocalUserRequest{}
+	if err := json.NewDecoder(req.Body).Decode(&userReq); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if userReq.UserName != "" && userReq.UserName != user.UserName {
+		if _, err := lp.LocalUserPersister.GetUserByName(userReq.UserName); err == nil {
+			err := ErrLocalUsers(fmt.Errorf("the user name %s is already taken", userReq.UserName))
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+	}
+	if userReq.Role != "" && userReq.Role != models.RoleAdmin && !h.keepsAnAdmin(w, lp, user) {
+		return
+	}
+	userName := user.UserName
+
+	if err := userReq.Apply(user); err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := lp.LocalUserPersister.SaveUser(user); err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	// the tokens of the user would act as whoever takes the name next
+	if user.UserName != userName {
+		h.revokeAPITokens(provider, userName)
+	}
+
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		h.log.Error(ErrEncoding(err, "user"))
+		http.Error(w, ErrEncoding(err, "user").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route DELETE /api/users/{id} UserAPI idDeleteLocalUser
+// Handle DELETE request to delete a user of the local provider
+//
+// Deletes a user of the local provider along with their sessions and API tokens, the last admin can't be deleted
+// responses:
+// 	200: noContentWrapper
+
+// DeleteLocalUserHandler deletes a user of the local provider
+func (h *Handler) DeleteLocalUserHandler(w http.ResponseWriter, req *http.Request, _ *models.Preference, _ *models.User, provider models.Provider) {
+	lp, user, ok := h.localUser(w, req, provider)
+	if !ok || !h.keepsAnAdmin(w, lp, user) {
+		return
+	}
+
+	if err := lp.LocalUserPersister.DeleteUser(*user.ID); err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	h.revokeAPITokens(provider, user.UserName)
+}
+
+// revokeAPITokens revokes the API tokens created by the user
+func (h *Handler) revokeAPITokens(provider models.Provider, userName string) {
+	if h.config.APITokenPersister == nil {
+		return
+	}
+	if err := h.config.APITokenPersister.DeleteUserAPITokens(provider.Name(), userName); err != nil {
+		h.log.Error(ErrAPITokens(err))
+	}
+}
+
+// localUser returns the user with the id of the route
+func (h *Handler) localUser(w http.ResponseWriter, req *http.Request, provider models.Provider) (*models.DefaultLocalProvider, *models.LocalUser, bool) {
+	lp, err := multiUserProvider(provider)
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	id, err := uuid.FromString(mux.Vars(req)["id"])
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	user, err := lp.LocalUserPersister.GetUser(id)
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusNotFound)
+		return nil, nil, false
+	}
+
+	return lp, user, true
+}
+
+// keepsAnAdmin checks that another admin is left once the user stops being one
+func (h *Handler) keepsAnAdmin(w http.ResponseWriter, lp *models.DefaultLocalProvider, user *models.LocalUser) bool {
+	if user.Role != models.RoleAdmin {
+		return true
+	}
+
+	admins, err := lp.LocalUserPersister.CountUsers(models.RoleAdmin)
+	if err != nil {
+		h.log.Error(ErrLocalUsers(err))
+		http.Error(w, ErrLocalUsers(err).Error(), http.StatusInternalServerError)
+		return false
+	}
+	if admins <= 1 {
+		err := ErrLocalUsers(fmt.Errorf("%s is the last admin", user.UserName))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
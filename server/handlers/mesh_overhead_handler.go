@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// meshOverheadPollInterval is the interval at which the endpoints of the variants are polled until they respond
+const meshOverheadPollInterval = 5 * time.Second
+
+// swagger:route POST /api/user/performance/profiles/{id}/overhead PerformanceAPI idPostMeshOverheadBenchmark
+// Handle POST request to benchmark the overhead of a service mesh
+//
+// Deploys the workload pattern twice in isolated namespaces, once with the sidecars of the mesh
+// injected and once without, runs the load of the performance profile against both and streams
+// the comparison of their results. The endpoints of the profile reach the workload through the
+// {namespace} placeholder. Both namespaces are torn down afterwards.
+// responses:
+// 	200:
+
+// MeshOverheadBenchmarkHandler benchmarks the overhead of a service mesh on the workload of a pattern
+func (h *Handler) MeshOverheadBenchmarkHandler(w http.ResponseWriter, req *http.Request, prefObj *models.Preference, user *models.User, provider models.Provider) {
+	profileID := mux.Vars(req)["id"]
+
+	benchmark := &models.MeshOverheadBenchmark{}
+	if err := json.NewDecoder(req.Body).Decode(benchmark); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(w, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	profile := h.getPerformanceProfile(req, provider, profileID)
+	if profile == nil {
+		err := ErrMeshOverheadBenchmark(fmt.Errorf("performance profile %s not found", profileID))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if benchmark.Mesh == "" {
+		benchmark.Mesh = profile.ServiceMesh
+	}
+	if err := benchmark.Validate(); err != nil {
+		h.log.Error(ErrMeshOverheadBenchmark(err))
+		http.Error(w, ErrMeshOverheadBenchmark(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if _, err := models.MeshOverheadProfile(profile, ""); err != nil {
+		h.log.Error(ErrMeshOverheadBenchmark(err))
+		http.Error(w, ErrMeshOverheadBenchmark(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	pattern, err := h.meshOverheadPattern(req, provider, benchmark)
+	if err != nil {
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// the benchmark goes on and cleans up after itself even if the client goes away
+	ctx := context.WithValue(context.Background(), models.TokenCtxKey, req.Context().Value(models.TokenCtxKey))
+	ctx = context.WithValue(ctx, models.KubeClustersKey, req.Context().Value(models.KubeClustersKey))
+
+	h.streamLoadTestResponses(w, req, func(respChan chan *models.LoadTestResponse) {
+		h.runMeshOverheadBenchmark(ctx, req, profile, benchmark, pattern, prefObj, user, provider, respChan)
+	})
+}
+
+// meshOverheadPattern returns the workload pattern of the benchmark
+func (h *Handler) meshOverheadPattern(req *http.Request, provider models.Provider, benchmark *models.MeshOverheadBenchmark) (core.Pattern, error) {
+	patternFile := benchmark.PatternFile
+	if benchmark.PatternID != "" {
+		resp, err := provider.GetMesheryPattern(req, benchmark.PatternID)
+		if err != nil {
+			return core.Pattern{}, ErrGetPattern(err)
+		}
+
+		mesheryPattern := models.MesheryPattern{}
+		if err := json.Unmarshal(resp, &mesheryPattern); err != nil {
+			return core.Pattern{}, ErrDecodePattern(err)
+		}
+		patternFile = mesheryPattern.PatternFile
+	}
+
+	pattern, err := core.NewPatternFile([]byte(patternFile))
+	if err != nil {
+		return core.Pattern{}, ErrParsePattern(err)
+	}
+	if len(pattern.Services) == 0 {
+		return core.Pattern{}, ErrMeshOverheadBenchmark(fmt.Errorf("the pattern has no services"))
+	}
+
+	return pattern, nil
+}
+
+// runMeshOverheadBenchmark deploys both variants of the workload, load tests them one after
+// the other and sends the report once both namespaces are torn down
+func (h *Handler) runMeshOverheadBenchmark(
+	ctx context.Context,
+	req *http.Request,
+	profile *models.PerformanceProfile,
+	benchmark *models.MeshOverheadBenchmark,
+	pattern core.Pattern,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+	respChan chan *models.LoadTestResponse,
+) {
+	id, _ := uuid.NewV4()
+	report := &models.MeshOverheadReport{
+		ID:          id,
+		ProfileID:   profile.ID,
+		Mesh:        benchmark.Mesh,
+		PatternName: pattern.Name,
+	}
+	deployed := []core.Pattern{}
+	err := func() error {
+		for _, variant := range models.MeshOverheadVariants {
+			namespace := models.MeshOverheadNamespace(id, variant)
+			report.Variants = append(report.Variants, &models.MeshOverheadVariantResult{Variant: variant, Namespace: namespace})
+
+			labels, annotations := models.SidecarInjectionMetadata(benchmark.Mesh, variant)
+			isolated := pattern.Isolated(namespace, labels, annotations)
+			deployed = append(deployed, isolated)
+
+			respChan <- &models.LoadTestResponse{
+				Status:  models.LoadTestInfo,
+				Message: fmt.Sprintf("Deploying the workload %s in the namespace %s", variant, namespace),
+			}
+			if _, err := _processPattern(ctx, provider, isolated, nil, prefObj, user.UserID, false, false, false, true, h.EventsBuffer, nil); err != nil {
+				return fmt.Errorf("unable to deploy the workload %s: %s", variant, err)
+			}
+		}
+
+		profiles := map[models.MeshOverheadVariant]*models.PerformanceProfile{}
+		for _, v := range report.Variants {
+			p, err := models.MeshOverheadProfile(profile, v.Namespace)
+			if err != nil {
+				return err
+			}
+			profiles[v.Variant] = p
+
+			readyCtx, cancel := context.WithTimeout(ctx, benchmark.GetReadyTimeout())
+			err = waitForMeshOverheadEndpoints(readyCtx, p)
+			cancel()
+			if err != nil {
+				return fmt.Errorf("the workload %s is not ready: %s", v.Variant, err)
+			}
+		}
+
+		for _, v := range report.Variants {
+			if err := h.loadTestMeshOverheadVariant(ctx, req, profiles[v.Variant], benchmark.Mesh, v, prefObj, provider, respChan); err != nil {
+				return err
+			}
+		}
+
+		return report.Compare()
+	}()
+
+	// both namespaces are torn down whatever the outcome, the report lists the failed teardowns
+	for i := len(deployed) - 1; i >= 0; i-- {
+		namespace := report.Variants[i].Namespace
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestInfo,
+			Message: "Tearing down the namespace " + namespace,
+		}
+		if _, err := _processPattern(ctx, provider, deployed[i], nil, prefObj, user.UserID, true, false, false, true, h.EventsBuffer, nil); err != nil {
+			h.log.Error(ErrMeshOverheadBenchmark(err))
+			report.Errors = append(report.Errors, fmt.Sprintf("unable to tear down the namespace %s: %s", namespace, err))
+		}
+	}
+
+	if err != nil {
+		h.log.Error(ErrMeshOverheadBenchmark(err))
+		respChan <- &models.LoadTestResponse{
+			Status:  models.LoadTestError,
+			Message: err.Error(),
+		}
+		return
+	}
+
+	msg := fmt.Sprintf("Sidecars of %s changed the mean latency by %.2fms", benchmark.Mesh, report.Latency.MeanLatency.Delta)
+	if report.CPUOverhead != nil && report.MemoryOverhead != nil {
+		msg += fmt.Sprintf(", the CPU used by %.3f cores and the memory used by %.0f bytes", report.CPUOverhead.Delta, report.MemoryOverhead.Delta)
+	}
+	respChan <- &models.LoadTestResponse{
+		Status:   models.LoadTestSuccess,
+		Message:  msg,
+		Overhead: report,
+	}
+}
+
+// loadTestMeshOverheadVariant runs the load test of the variant through the same path as
+// LoadTestHandler, its responses are forwarded prefixed with the variant
+func (h *Handler) loadTestMeshOverheadVariant(
+	ctx context.Context,
+	req *http.Request,
+	profile *models.PerformanceProfile,
+	mesh string,
+	v *models.MeshOverheadVariantResult,
+	prefObj *models.Preference,
+	provider models.Provider,
+	respChan chan *models.LoadTestResponse,
+) error {
+	loadTestOptions, err := h.loadTestOptionsFromProfile(profile)
+	if err != nil {
+		return err
+	}
+	loadTestOptions.ServiceMesh = models.MeshOverheadServiceMesh(mesh, v.Variant, v.Namespace)
+	testName := fmt.Sprintf("%s (%s)", profile.Name, v.Variant)
+
+	profileID := ""
+	if profile.ID != nil {
+		profileID = profile.ID.String()
+	}
+
+	v.Start = time.Now()
+	runChan := make(chan *models.LoadTestResponse, 100)
+	go func() {
+		h.executeLoadTest(ctx, req, profileID, testName, mesh, "", prefObj, provider, loadTestOptions, runChan)
+		close(runChan)
+	}()
+
+	errMsgs := []string{}
+	for resp := range runChan {
+		switch resp.Status {
+		case models.LoadTestSuccess:
+			if resp.Result != nil {
+				v.SetResult(resp.Result)
+			}
+		case models.LoadTestError:
+			errMsgs = append(errMsgs, resp.Message)
+		}
+		if resp.Message != "" {
+			resp.Message = string(v.Variant) + ": " + resp.Message
+		}
+		respChan <- resp
+	}
+	v.End = time.Now()
+
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("load test of the workload %s failed: %s", v.Variant, strings.Join(errMsgs, ", "))
+	}
+	if v.ResultID == nil {
+		return fmt.Errorf("load test of the workload %s has no result", v.Variant)
+	}
+
+	if prefObj.Prometheus != nil && prefObj.Prometheus.PrometheusURL != "" && h.config.PrometheusClient != nil {
+		metricsCtx, cancel := context.WithTimeout(context.Background(), meshMetricsTimeout)
+		defer cancel()
+		v.SetResources(h.config.PrometheusClient.QueryMeshMetrics(metricsCtx, prefObj.Prometheus.PrometheusURL, models.NamespaceResourceQueries(v.Namespace), v.Start, v.End))
+	}
+
+	return nil
+}
+
+// waitForMeshOverheadEndpoints waits until every endpoint of the profile responds, HTTP
+// endpoints are ready once they stop answering with server errors, TCP ones once they
+// accept connections and UDP ones right away
+func waitForMeshOverheadEndpoints(ctx context.Context, profile *models.PerformanceProfile) error {
+	endpoints := []string(profile.Endpoints)
+	for _, e := range profile.Scenario {
+		endpoints = append(endpoints, e.URL)
+	}
+
+	client := &http.Client{Timeout: meshOverheadPollInterval}
+	for _, endpoint := range endpoints {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return err
+		}
+
+		for {
+			var ready bool
+			switch u.Scheme {
+			case "udp":
+				ready = true
+			case "tcp":
+				if conn, err := net.DialTimeout("tcp", u.Host, meshOverheadPollInterval); err == nil {
+					_ = conn.Close()
+					ready = true
+				}
+			default:
+				if resp, err := client.Get(endpoint); err == nil {
+					_ = resp.Body.Close()
+					ready = resp.StatusCode < http.StatusInternalServerError
+				}
+			}
+			if ready {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%s did not respond: %s", endpoint, ctx.Err())
+			case <-time.After(meshOverheadPollInterval):
+			}
+		}
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -15,7 +16,6 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/models"
 	"github.com/layer5io/meshery/server/models/pattern/core"
-	"github.com/layer5io/meshkit/utils/kubernetes"
 	"github.com/layer5io/meshkit/utils/kubernetes/kompose"
 	"github.com/layer5io/meshkit/utils/walker"
 	"gopkg.in/yaml.v2"
@@ -26,8 +26,11 @@ import (
 type MesheryApplicationRequestBody struct {
 	URL             string                     `json:"url,omitempty"`
 	Path            string                     `json:"path,omitempty"`
+	Ref             string                     `json:"ref,omitempty"`
 	Save            bool                       `json:"save,omitempty"`
 	ApplicationData *models.MesheryApplication `json:"application_data,omitempty"`
+	// Values files and --set overrides the helm chart is rendered with
+	models.HelmChartValues
 }
 
 // swagger:route POST /api/application/deploy ApplicationsAPI idPostDeployApplicationFile
@@ -133,7 +136,7 @@ func (h *Handler) handleApplicationPOST(
 	format := r.URL.Query().Get("output")
 	var mesheryApplication *models.MesheryApplication
 	// If Content is not empty then assume it's a local upload
-	//Note: The Application data will not be present in case of helm charts as we do not support local helm upload.
+	// Helm charts and kustomize sources are uploaded as gzipped tarballs in the source content
 	if parsedBody.ApplicationData != nil {
 		// Assign a location if no location is specified
 		if parsedBody.ApplicationData.Location == nil {
@@ -147,9 +150,38 @@ func (h *Handler) handleApplicationPOST(
 
 		mesheryApplication = parsedBody.ApplicationData
 
-		bytApplication := []byte(mesheryApplication.ApplicationFile)
-		mesheryApplication.SourceContent = bytApplication
-		if sourcetype == string(models.DockerCompose) || sourcetype == string(models.K8sManifest) {
+		if sourcetype == string(models.HelmChart) {
+			sourceContent, response, err := helmApplicationFile(mesheryApplication.SourceContent, parsedBody.HelmChartValues)
+			if err != nil {
+				obj := "convert"
+				h.log.Error(ErrApplicationFailure(err, obj))
+				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
+				return
+			}
+			mesheryApplication.ApplicationFile = response
+			mesheryApplication.SourceContent = sourceContent
+			mesheryApplication.Type = sql.NullString{
+				String: string(models.HelmChart),
+				Valid:  true,
+			}
+		} else if sourcetype == string(models.Kustomize) {
+			// The kustomize source is uploaded as a gzipped tarball, the path is the overlay to render
+			response, err := kustomizeApplicationFile(mesheryApplication.SourceContent, parsedBody.Path)
+			if err != nil {
+				obj := "convert"
+				h.log.Error(ErrApplicationFailure(err, obj))
+				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
+				return
+			}
+			mesheryApplication.ApplicationFile = response
+			mesheryApplication.Location["path"] = parsedBody.Path
+			mesheryApplication.Type = sql.NullString{
+				String: string(models.Kustomize),
+				Valid:  true,
+			}
+		} else if sourcetype == string(models.DockerCompose) || sourcetype == string(models.K8sManifest) {
+			bytApplication := []byte(mesheryApplication.ApplicationFile)
+			mesheryApplication.SourceContent = bytApplication
 			var k8sres string
 			if sourcetype == string(models.DockerCompose) {
 				k8sres, err = kompose.Convert(bytApplication) // convert the docker compose file into kubernetes manifest
@@ -197,57 +229,82 @@ func (h *Handler) handleApplicationPOST(
 	if parsedBody.URL != "" {
 		if sourcetype == string(models.HelmChart) {
 			helmSourceResp, err := http.Get(parsedBody.URL)
-			defer func() {
-				_ = helmSourceResp.Body.Close()
-			}()
 			if err != nil {
 				obj := "import"
 				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
 				return
 			}
+			defer func() {
+				_ = helmSourceResp.Body.Close()
+			}()
 			sourceContent, err := io.ReadAll(helmSourceResp.Body)
 			if err != nil {
 				http.Error(rw, "error read body", http.StatusInternalServerError)
 				return
 			}
 
-			resp, err := kubernetes.ConvertHelmChartToK8sManifest(kubernetes.ApplyHelmChartConfig{
-				URL: parsedBody.URL,
-			})
+			sourceContent, response, err := helmApplicationFile(sourceContent, parsedBody.HelmChartValues)
 			if err != nil {
-				obj := "import"
+				obj := "convert"
 				h.log.Error(ErrApplicationFailure(err, obj))
-				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
+				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError) // sending a 500 when we cannot convert the file into kuberentes manifest
 				return
 			}
-			result := string(resp)
-			pattern, err := core.NewPatternFileFromK8sManifest(result, false)
+			url := strings.Split(parsedBody.URL, "/")
+			mesheryApplication = &models.MesheryApplication{
+				Name:            strings.TrimSuffix(url[len(url)-1], ".tgz"),
+				ApplicationFile: response,
+				Type: sql.NullString{
+					String: string(models.HelmChart),
+					Valid:  true,
+				},
+				Location: map[string]interface{}{
+					"type":   "http",
+					"host":   parsedBody.URL,
+					"path":   "",
+					"branch": "",
+				},
+				SourceContent: sourceContent,
+			}
+		} else if sourcetype == string(models.Kustomize) {
+			sourceContent, err := models.FetchKustomizeSource(parsedBody.URL, parsedBody.Ref)
 			if err != nil {
-				obj := "convert"
+				obj := "import"
 				h.log.Error(ErrApplicationFailure(err, obj))
-				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError) // sending a 500 when we cannot convert the file into kuberentes manifest
+				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
 				return
 			}
-			response, err := yaml.Marshal(pattern)
+
+			response, err := kustomizeApplicationFile(sourceContent, parsedBody.Path)
 			if err != nil {
 				obj := "convert"
 				h.log.Error(ErrApplicationFailure(err, obj))
-				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError) // sending a 500 when we cannot convert the file into kuberentes manifest
+				http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
 				return
 			}
-			url := strings.Split(parsedBody.URL, "/")
+
+			locationType := "git"
+			name := strings.TrimSuffix(path.Base(strings.Split(parsedBody.URL, "?")[0]), ".git")
+			if models.IsTarballURL(parsedBody.URL) {
+				locationType = "http"
+				name = strings.TrimSuffix(strings.TrimSuffix(name, ".tgz"), ".tar.gz")
+			}
+			if overlay := path.Base(path.Clean("/" + parsedBody.Path)); overlay != "/" {
+				name = name + "-" + overlay
+			}
+
 			mesheryApplication = &models.MesheryApplication{
-				Name:            strings.TrimSuffix(url[len(url)-1], ".tgz"),
-				ApplicationFile: string(response),
+				Name:            name,
+				ApplicationFile: response,
 				Type: sql.NullString{
-					String: string(models.HelmChart),
+					String: string(models.Kustomize),
 					Valid:  true,
 				},
 				Location: map[string]interface{}{
-					"type":   "http",
+					"type":   locationType,
 					"host":   parsedBody.URL,
-					"path":   "",
-					"branch": "",
+					"path":   parsedBody.Path,
+					"branch": parsedBody.Ref,
 				},
 				SourceContent: sourceContent,
 			}
@@ -376,6 +433,36 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 		String: sourcetype,
 		Valid:  true,
 	}
+
+	// The helm chart is rendered again when its values change
+	var sourceContent []byte
+	if sourcetype == string(models.HelmChart) && !parsedBody.HelmChartValues.IsEmpty() {
+		if mesheryApplication.ID == nil {
+			obj := "update"
+			h.log.Error(ErrApplicationFailure(fmt.Errorf("application id is required to change the values of the helm chart"), obj))
+			http.Error(rw, ErrApplicationFailure(fmt.Errorf("application id is required to change the values of the helm chart"), obj).Error(), http.StatusBadRequest)
+			return
+		}
+
+		chart, err := provider.GetApplicationSourceContent(r, mesheryApplication.ID.String())
+		if err != nil {
+			obj := "download"
+			h.log.Error(ErrApplicationSourceContent(err, obj))
+			http.Error(rw, ErrApplicationSourceContent(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var response string
+		sourceContent, response, err = helmApplicationFile(chart, parsedBody.HelmChartValues)
+		if err != nil {
+			obj := "convert"
+			h.log.Error(ErrApplicationFailure(err, obj))
+			http.Error(rw, ErrApplicationFailure(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+		mesheryApplication.ApplicationFile = response
+	}
+
 	resp, err := provider.SaveMesheryApplication(token, mesheryApplication)
 	if err != nil {
 		obj := "save"
@@ -384,6 +471,16 @@ func (h *Handler) handleApplicationUpdate(rw http.ResponseWriter,
 		return
 	}
 
+	if sourceContent != nil {
+		err = provider.SaveApplicationSourceContent(token, mesheryApplication.ID.String(), sourceContent)
+		if err != nil {
+			obj := "upload"
+			h.log.Error(ErrApplicationSourceContent(err, obj))
+			http.Error(rw, ErrApplicationSourceContent(err, obj).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	go h.config.ConfigurationChannel.PublishApplications()
 
 	h.formatApplicationOutput(rw, resp, format)
@@ -523,7 +620,7 @@ func (h *Handler) GetMesheryApplicationSourceHandler(
 	var mimeType string
 	sourcetype := mux.Vars(r)["sourcetype"]
 
-	if models.ApplicationType(sourcetype) == models.HelmChart { //serve the content in a tgz file
+	if models.ApplicationType(sourcetype) == models.HelmChart || models.ApplicationType(sourcetype) == models.Kustomize { //serve the content in a tgz file
 		mimeType = "application/x-tar"
 	} else { // serve the content in yaml file
 		mimeType = "application/x-yaml"
@@ -627,6 +724,65 @@ func githubRepoApplicationScan(
 	return result, err
 }
 
+// helmApplicationFile renders the helm chart archive and converts it into a pattern file. The given
+// values are stored in the returned archive, the values stored before are used if none are given.
+func helmApplicationFile(chart []byte, values models.HelmChartValues) ([]byte, string, error) {
+	if len(chart) == 0 {
+		return nil, "", fmt.Errorf("helm chart is empty, expected a .tgz archive")
+	}
+
+	var err error
+	if values.IsEmpty() {
+		values, err = models.GetHelmValues(chart)
+	} else {
+		chart, err = models.AttachHelmValues(chart, values)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	k8sres, err := models.RenderHelmChart(chart, values)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pattern, err := core.NewPatternFileFromK8sManifest(k8sres, false)
+	if err != nil {
+		return nil, "", err
+	}
+
+	response, err := yaml.Marshal(pattern)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return chart, string(response), nil
+}
+
+// kustomizeApplicationFile renders the overlay of the kustomize source and converts it into a pattern file
+func kustomizeApplicationFile(tarball []byte, overlay string) (string, error) {
+	if len(tarball) == 0 {
+		return "", fmt.Errorf("kustomize source is empty, expected a gzipped tarball")
+	}
+
+	k8sres, err := models.RenderKustomization(tarball, overlay)
+	if err != nil {
+		return "", err
+	}
+
+	pattern, err := core.NewPatternFileFromK8sManifest(k8sres, false)
+	if err != nil {
+		return "", err
+	}
+
+	response, err := yaml.Marshal(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return string(response), nil
+}
+
 func genericHTTPApplicationFile(fileURL, sourceType string) ([]models.MesheryApplication, error) {
 	resp, err := http.Get(fileURL)
 	if err != nil {
@@ -2,10 +2,15 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/gorilla/mux"
 	"github.com/layer5io/meshery/server/models"
 	"github.com/sirupsen/logrus"
 )
@@ -52,6 +57,16 @@ func (h *Handler) AuthMiddleware(next http.Handler) http.Handler {
 			http.Redirect(w, req, "/provider", http.StatusFound)
 			return
 		}
+		// API tokens are accepted as bearer tokens whatever the provider
+		if secret := models.BearerAPIToken(req); secret != "" {
+			reqWithToken, err := h.withAPIToken(req, provider, secret)
+			if err != nil {
+				h.log.Error(err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			req = reqWithToken
+		}
 		// logrus.Debugf("provider %s", provider)
 		isValid := h.validateAuth(provider, req)
 		// logrus.Debugf("validate auth: %t", isValid)
@@ -66,8 +81,30 @@ func (h *Handler) AuthMiddleware(next http.Handler) http.Handler {
 				provider.HandleUnAuthenticated(w, req)
 				return
 			}
-			// Local Provider
-			h.LoginHandler(w, req, provider, true)
+			// Local Provider in multi-user mode
+			if strings.HasPrefix(req.URL.Path, "/api/") {
+				http.Error(w, "unauthenticated, log in at /api/user/login", http.StatusUnauthorized)
+				return
+			}
+			http.Redirect(w, req, "/user/login?return_to="+url.QueryEscape(req.URL.RequestURI()), http.StatusFound)
+			return
+		}
+		// the roles of the users of the local provider are enforced before the request
+		// reaches the handler, the routes without session injection included
+		if provider.GetProviderType() == models.LocalProviderType {
+			user, err := provider.GetUserDetails(req)
+			if err != nil {
+				h.log.Error(err)
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			models.AuditEventFromContext(req.Context()).SetActor(user, provider, models.APITokenFromContext(req.Context()))
+			if !h.authorize(w, req, user) {
+				return
+			}
+		}
+		if !h.authorizeAPIToken(w, req) {
+			return
 		}
 		next.ServeHTTP(w, req)
 	}
@@ -132,6 +169,18 @@ func (h *Handler) KubernetesMiddleware(next func(http.ResponseWriter, *http.Requ
 			}
 		}
 
+		// API tokens restricted to kubernetes contexts only see the contexts of their allow-list
+		apiToken := models.APITokenFromContext(ctx)
+		if apiToken != nil && len(apiToken.Contexts) > 0 {
+			allowed := []*models.K8sContext{}
+			for _, c := range contexts {
+				if c != nil && apiToken.AllowsContext(c.ID) {
+					allowed = append(allowed, c)
+				}
+			}
+			contexts = allowed
+		}
+
 		// register kubernetes components
 		h.K8sCompRegHelper.UpdateContexts(contexts).RegisterComponents(contexts, RegisterK8sComponents, h.EventsBuffer)
 
@@ -154,6 +203,12 @@ func (h *Handler) KubernetesMiddleware(next func(http.ResponseWriter, *http.Requ
 			}
 		} else {
 			for _, kctxID := range k8sContextIDs {
+				if apiToken != nil && !apiToken.AllowsContext(kctxID) {
+					err := ErrAPITokenForbidden(fmt.Errorf("the kubernetes context %s is not in the allow-list of the token", kctxID))
+					logrus.Error(err)
+					http.Error(w, err.Error(), http.StatusForbidden)
+					return
+				}
 				kctx, err := provider.GetK8sContext(token, kctxID)
 				if err != nil {
 					logrus.Warn("invalid context ID found")
@@ -168,6 +223,9 @@ func (h *Handler) KubernetesMiddleware(next func(http.ResponseWriter, *http.Requ
 			}
 		}
 
+		for _, k8scontext := range k8scontexts {
+			models.AuditEventFromContext(ctx).AddK8sContexts(k8scontext.ID)
+		}
 		ctx = context.WithValue(ctx, models.KubeClustersKey, k8scontexts)
 		ctx = context.WithValue(ctx, models.AllKubeClusterKey, allk8scontexts)
 		req1 := req.WithContext(ctx)
@@ -193,7 +251,17 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 			return
 		}
 
-		user, _ := provider.GetUserDetails(req)
+		user, err := provider.GetUserDetails(req)
+		if err != nil || user == nil {
+			logrus.Errorf("Error: unable to get user details: %v", err)
+			http.Error(w, "unable to get user details", http.StatusUnauthorized)
+			return
+		}
+		models.AuditEventFromContext(req.Context()).SetActor(user, provider, models.APITokenFromContext(req.Context()))
+		if !h.authorize(w, req, user) {
+			return
+		}
+
 		prefObj, err := provider.ReadFromPersister(user.UserID)
 		if err != nil {
 			logrus.Warn("unable to read session from the session persister, starting with a new one")
@@ -209,6 +277,138 @@ func (h *Handler) SessionInjectorMiddleware(next func(http.ResponseWriter, *http
 	})
 }
 
+// AdapterAuthMiddleware authenticates the adapters which register their capabilities, by
+// their network and their registration token or client certificate
+func (h *Handler) AdapterAuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if h.config.AdapterAuth == nil {
+			err := models.ErrAdapterAuth(fmt.Errorf("the registration of adapters is not configured"))
+			h.log.Error(err)
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		identity, status, err := h.config.AdapterAuth.Authenticate(req)
+		if err != nil {
+			h.log.Error(err)
+			http.Error(w, err.Error(), status)
+			return
+		}
+		h.log.Debug("adapter authenticated as ", identity)
+		models.AuditEventFromContext(req.Context()).SetAdapter(identity)
+
+		next.ServeHTTP(w, req)
+	})
+}
+
+// auditMaxBodySize is the largest body of the audited requests
+const auditMaxBodySize = 32 << 20
+
+// AuditMiddleware records the mutating API calls in the audit log along with their
+// outcome. The audit event travels in the context of the request so that the next
+// middlewares record who made the call and what it targets
+func (h *Handler) AuditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		route := routePath(req)
+		// GraphQL mutations are recorded by their resolvers
+		if h.config.AuditPersister == nil || !isMutatingRequest(req) || route == "/api/system/graphql/query" {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		body := []byte{}
+		if req.Body != nil {
+			var err error
+			body, err = io.ReadAll(http.MaxBytesReader(w, req.Body, auditMaxBodySize))
+			if err != nil {
+				h.log.Error(ErrRequestBody(err))
+				http.Error(w, ErrRequestBody(err).Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		event := &models.AuditEvent{
+			Kind:       models.AuditKindHTTP,
+			Method:     req.Method,
+			Route:      route,
+			Path:       req.URL.Path,
+			RemoteAddr: req.RemoteAddr,
+		}
+		// the credentials sent to these routes are left out of the hash
+		if isCredentialRoute(route) {
+			event.RequestHash = h.config.AuditPersister.HashRequest([]byte(req.Method), []byte(req.URL.RequestURI()))
+		} else {
+			event.RequestHash = h.config.AuditPersister.HashRequest([]byte(req.Method), []byte(req.URL.RequestURI()), body)
+		}
+		addAuditTargets(event, req, route, body)
+
+		rec := &auditResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, req.WithContext(context.WithValue(req.Context(), models.AuditEventCtxKey, event)))
+
+		event.SetStatus(rec.status)
+		// the calls of unauthenticated callers are summed up, so that they can't fill the log
+		var err error
+		if event.Authenticated() {
+			err = h.config.AuditPersister.AppendAuditEvent(event)
+		} else {
+			err = h.config.AuditPersister.AppendAnonymousAuditEvent(event)
+		}
+		if err != nil {
+			h.log.Error(ErrAuditLog(err))
+		}
+	})
+}
+
+// isCredentialRoute returns true if the body of the requests of the route holds passwords
+func isCredentialRoute(route string) bool {
+	switch route {
+	case "/api/user/login", "/api/users", "/api/users/{id}":
+		return true
+	default:
+		return false
+	}
+}
+
+// addAuditTargets records the patterns, the kubernetes contexts and the adapters the
+// request names in its route, its query or its form. The contexts selected through
+// the contexts query parameter are recorded by the KubernetesMiddleware
+func addAuditTargets(event *models.AuditEvent, req *http.Request, route string, body []byte) {
+	id := mux.Vars(req)["id"]
+	switch route {
+	case "/api/pattern/{id}", "/api/pattern/clone/{id}":
+		event.AddPatterns(id)
+	case "/api/system/kubernetes/contexts/{id}":
+		event.AddK8sContexts(id)
+	}
+
+	q := req.URL.Query()
+	event.AddPatterns(q.Get("patternID"))
+	event.AddAdapters(q.Get("adapter"))
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			event.AddAdapters(form.Get("adapter"), form.Get("meshLocationURL"))
+		}
+	}
+}
+
+// auditResponseWriter records the status of the response
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *auditResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *auditResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 // GraphqlSessionInjectorMiddleware - is a middleware which injects user and session object
 func (h *Handler) GraphqlMiddleware(next http.Handler) func(http.ResponseWriter, *http.Request, *models.Preference, *models.User, models.Provider) {
 	return func(w http.ResponseWriter, req *http.Request, pref *models.Preference, user *models.User, prov models.Provider) {
@@ -1,7 +1,19 @@
 package handlers
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
 	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
 )
 
 func TestAuthMiddleWare(t *testing.T) {
@@ -15,3 +27,94 @@ func TestAuthMiddleWare(t *testing.T) {
 	//	t.Errorf("AuthMiddleWare() failed with error: %s", err)
 	//}
 }
+
+func TestAuditMiddleware(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.AuditEvent{}); err != nil {
+		t.Fatal(err)
+	}
+	ap := &models.AuditPersister{DB: &db}
+	h := &Handler{log: log, config: &models.HandlerConfig{AuditPersister: ap}}
+
+	status := http.StatusOK
+	handler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		models.AuditEventFromContext(req.Context()).SetActor(&models.User{UserID: "alice"}, nil, nil)
+		w.WriteHeader(status)
+	})
+	router := mux.NewRouter()
+	router.Use(h.AuditMiddleware)
+	for _, path := range []string{"/api/pattern/{id}", "/api/system/adapter/manage", "/api/perf/profile"} {
+		router.Handle(path, handler)
+	}
+
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/pattern/p1", nil))
+	status = http.StatusForbidden
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodDelete, "/api/pattern/p1", nil))
+	status = http.StatusOK
+	form := httptest.NewRequest(http.MethodPost, "/api/system/adapter/manage", strings.NewReader(url.Values{"meshLocationURL": {"localhost:10000"}}.Encode()))
+	form.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	router.ServeHTTP(httptest.NewRecorder(), form)
+	// load tests are started by GET requests
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/api/perf/profile?name=test", nil))
+
+	page, err := ap.GetAuditEvents(models.AuditFilter{}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.TotalCount != 3 {
+		t.Fatalf("%d audit events, want 3", page.TotalCount)
+	}
+	perf, adapter, pattern := page.Events[0], page.Events[1], page.Events[2]
+	if pattern.Route != "/api/pattern/{id}" || pattern.Outcome != models.AuditDenied || pattern.UserID != "alice" || len(pattern.PatternIDs) != 1 || pattern.PatternIDs[0] != "p1" {
+		t.Errorf("pattern deletion %+v", pattern)
+	}
+	if adapter.Outcome != models.AuditSuccess || len(adapter.AdapterIDs) != 1 || adapter.AdapterIDs[0] != "localhost:10000" {
+		t.Errorf("adapter deployment %+v", adapter)
+	}
+	if perf.Route != "/api/perf/profile" || perf.RequestHash == "" {
+		t.Errorf("load test %+v", perf)
+	}
+
+	// the handlers still read the body of the request
+	router.Handle("/api/echo", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_ = req.ParseForm()
+		if req.Form.Get("adapter") != "localhost:10000" {
+			t.Errorf("form %v", req.Form)
+		}
+	}))
+	echo := httptest.NewRequest(http.MethodPost, "/api/echo", strings.NewReader("adapter=localhost:10000"))
+	echo.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	router.ServeHTTP(httptest.NewRecorder(), echo.WithContext(context.Background()))
+
+	// the calls of unauthenticated callers are summed up in a single event
+	router.Handle("/api/anonymous", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	for i := 0; i < 100; i++ {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/api/anonymous", nil))
+	}
+	anonymous, err := ap.GetAuditEvents(models.AuditFilter{Route: models.AnonymousAuditRoute}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anonymous.TotalCount != 1 {
+		t.Errorf("%d events of unauthenticated calls, want 1", anonymous.TotalCount)
+	}
+
+	large := httptest.NewRecorder()
+	router.ServeHTTP(large, httptest.NewRequest(http.MethodPost, "/api/echo", bytes.NewReader(make([]byte, auditMaxBodySize+1))))
+	if large.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("status of a request over the size limit %d", large.Code)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -16,7 +18,9 @@ import (
 	"github.com/layer5io/meshery/server/models"
 	"github.com/layer5io/meshery/server/models/pattern/core"
 	"github.com/layer5io/meshery/server/models/pattern/patterns"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
 	"github.com/layer5io/meshery/server/models/pattern/stages"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
 	"github.com/layer5io/meshkit/utils/events"
 	"github.com/sirupsen/logrus"
 )
@@ -35,6 +39,15 @@ const (
 // Handle POST request for Pattern Deploy
 //
 // Deploy an attached pattern with the request
+//
+// With ?atomic=true the services which were already deployed are rolled back if any service fails.
+// The kubernetes objects they updated are restored as they were and only the created ones are deleted.
+//
+// The inputs of the pattern are supplied with ?set=<input>=<value>, which can be repeated.
+// Missing or invalid inputs reject the pattern before anything is deployed.
+//
+// With ?dryRun=diff nothing is deployed, instead the objects that the deploy would apply are
+// compared with the live objects in each selected context and a per object diff is returned
 // responses:
 // 	200:
 
@@ -83,18 +96,81 @@ func (h *Handler) PatternFileHandler(
 		return
 	}
 
+	inputs, err := parsePatternInputs(r.URL.Query()["set"])
+	if err != nil {
+		h.log.Error(ErrPatternFile(err))
+		http.Error(rw, ErrPatternFile(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if r.URL.Query().Get("dryRun") == "diff" {
+		diffs, err := _dryRunPattern(
+			r.Context(),
+			provider,
+			patternFile,
+			inputs,
+			isDel,
+		)
+		if err != nil {
+			h.log.Error(ErrDryRunPattern(err))
+			http.Error(rw, ErrDryRunPattern(err).Error(), http.StatusInternalServerError)
+			return
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(rw).Encode(diffs); err != nil {
+			h.log.Error(ErrEncoding(err, "pattern diff"))
+			http.Error(rw, ErrEncoding(err, "pattern diff").Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	// The token is needed to process the pattern and to record its deployment
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		err := ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	verify := r.URL.Query().Get("verify") == "true"
+
+	// Deployments are recorded, verifications are not
+	var deployment *models.PatternDeployment
+	if !verify {
+		startedAt := time.Now()
+		deployment = &models.PatternDeployment{
+			PatternName: patternFile.Name,
+			VersionHash: models.PatternVersionHash(body),
+			UserID:      user.UserID,
+			IsDelete:    isDel,
+			StartedAt:   &startedAt,
+		}
+		if patternID, err := uuid.FromString(r.URL.Query().Get("patternID")); err == nil {
+			deployment.PatternID = &patternID
+		}
+	}
+
 	msg, err := _processPattern(
 		r.Context(),
 		provider,
 		patternFile,
+		inputs,
 		prefObj,
 		user.UserID,
 		isDel,
-		r.URL.Query().Get("verify") == "true",
+		verify,
+		r.URL.Query().Get("atomic") == "true",
 		false,
 		h.EventsBuffer,
+		deployment,
 	)
 
+	if deployment != nil {
+		h.savePatternDeployment(token, provider, deployment, err)
+	}
+
 	if err != nil {
 		h.log.Error(ErrCompConfigPairs(err))
 		http.Error(rw, ErrCompConfigPairs(err).Error(), http.StatusInternalServerError)
@@ -372,12 +448,15 @@ func _processPattern(
 	ctx context.Context,
 	provider models.Provider,
 	pattern core.Pattern,
+	inputs map[string]interface{},
 	prefObj *models.Preference,
 	userID string,
 	isDelete bool,
 	verify bool,
+	atomic bool,
 	skipPrintLogs bool,
 	eb *events.EventStreamer,
+	deployment *models.PatternDeployment,
 ) (string, error) {
 	// Get the token from the context
 	token, ok := ctx.Value(models.TokenCtxKey).(string)
@@ -385,6 +464,12 @@ func _processPattern(
 		return "", ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
 	}
 
+	// Deploys always resolve the inputs, hence a missing required input rejects the
+	// pattern instead of leaving its reference in the objects sent to the clusters
+	if inputs == nil && !verify {
+		inputs = map[string]interface{}{}
+	}
+
 	// // Get the kubehandler from the context
 	k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
 	if !ok || len(k8scontexts) == 0 {
@@ -410,11 +495,18 @@ func _processPattern(
 		}
 		configs = append(configs, string(cfg))
 	}
+	if deployment != nil {
+		for _, k8scontext := range k8scontexts {
+			deployment.ContextIDs = append(deployment.ContextIDs, k8scontext.ID)
+			deployment.ContextNames = append(deployment.ContextNames, k8scontext.Name)
+		}
+	}
 	internal := func(mk8scontext []models.K8sContext) (string, error) {
 		sip := &serviceInfoProvider{
 			token:      token,
 			provider:   provider,
 			opIsDelete: isDelete,
+			opIsAtomic: atomic,
 		}
 		sap := &serviceActionProvider{
 			token:    token,
@@ -422,6 +514,7 @@ func _processPattern(
 			prefObj:  prefObj,
 			// kubeClient:    kubeClient,
 			opIsDelete: isDelete,
+			opIsAtomic: atomic,
 			userID:     userID,
 			// kubeconfig:    kubecfg,
 			// kubecontext:   mk8scontext,
@@ -447,21 +540,43 @@ func _processPattern(
 
 		chain.
 			Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
+				rolledBack := false
 				data.Lock.Lock()
 				for k, v := range data.Other {
-					if strings.HasSuffix(k, stages.ProvisionSuffixKey) {
+					if strings.HasSuffix(k, stages.ProvisionSuffixKey) || strings.HasSuffix(k, stages.RollbackSuffixKey) {
 						msg, ok := v.(string)
 						if ok {
 							sap.accumulatedMsgs = append(sap.accumulatedMsgs, msg)
 						}
 					}
+					if strings.HasSuffix(k, stages.RollbackSuffixKey) {
+						rolledBack = true
+					}
 				}
 				data.Lock.Unlock()
 
+				if deployment != nil {
+					recordPatternDeployment(deployment, data, err)
+				}
+
+				// Report the failure along with the result of the rollback
+				if rolledBack && eb != nil {
+					id, _ := uuid.NewV4()
+					eb.Publish(&meshes.EventsResponse{
+						Component:     "core",
+						ComponentName: "Meshery",
+						EventType:     meshes.EventType_ERROR,
+						Summary:       "Pattern deploy failed and was rolled back: " + pattern.Name,
+						Details:       err.Error(),
+						OperationId:   id.String(),
+					})
+				}
+
 				sap.err = err
 			}).
 			Process(&stages.Data{
 				Pattern: &pattern,
+				Inputs:  inputs,
 				Other:   map[string]interface{}{},
 			})
 
@@ -514,10 +629,154 @@ func _processPattern(
 	// }
 }
 
+// _dryRunPattern runs the pattern through the stages up to validation and
+// diffs the objects which would be provisioned against the live objects
+// in each of the selected kubernetes contexts. Nothing is provisioned.
+func _dryRunPattern(
+	ctx context.Context,
+	provider models.Provider,
+	pattern core.Pattern,
+	inputs map[string]interface{},
+	isDelete bool,
+) (map[string][]k8s.ObjectDiff, error) {
+	token, ok := ctx.Value(models.TokenCtxKey).(string)
+	if !ok {
+		return nil, ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
+	}
+
+	k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
+	if !ok || len(k8scontexts) == 0 {
+		return nil, ErrInvalidKubeHandler(fmt.Errorf("failed to find k8s handler"), "_dryRunPattern couldn't find a valid k8s handler")
+	}
+
+	var clusters []patterns.Cluster
+	for _, k8scontext := range k8scontexts {
+		cfg, err := k8scontext.GenerateKubeConfig()
+		if err != nil {
+			return nil, ErrInvalidKubeConfig(fmt.Errorf("failed to find k8s config"), "_dryRunPattern couldn't find a valid k8s config")
+		}
+		clusters = append(clusters, patterns.Cluster{Name: k8scontext.Name, Kubeconfig: string(cfg)})
+	}
+
+	sip := &serviceInfoProvider{
+		token:      token,
+		provider:   provider,
+		opIsDelete: isDelete,
+	}
+	sap := &serviceActionProvider{
+		token:         token,
+		provider:      provider,
+		opIsDelete:    isDelete,
+		skipPrintLogs: true,
+		clusters:      clusters,
+	}
+
+	var diffs map[string][]k8s.ObjectDiff
+	stages.CreateChain().
+		Add(stages.Import(sip, sap)).
+		Add(stages.ServiceIdentifier(sip, sap)).
+		Add(stages.Filler(true)).
+		Add(stages.Validator(sip, sap)).
+		Add(stages.DryRun(sip, sap)).
+		Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
+			diffs = stages.GetDryRunDiffs(data)
+			sap.err = err
+		}).
+		Process(&stages.Data{
+			Pattern: &pattern,
+			Inputs:  inputs,
+			Other:   map[string]interface{}{},
+		})
+
+	return diffs, sap.err
+}
+
+// savePatternDeployment records the outcome of the processed pattern
+func (h *Handler) savePatternDeployment(token string, provider models.Provider, deployment *models.PatternDeployment, err error) {
+	// The chain stops before reaching the last stage if the pattern is invalid
+	if deployment.Status == "" && err != nil {
+		deployment.Status = models.PatternDeploymentFailed
+		deployment.Message = err.Error()
+	}
+
+	finishedAt := time.Now()
+	deployment.FinishedAt = &finishedAt
+	if _, perr := provider.SavePatternDeployment(token, deployment); perr != nil {
+		// Non critical, the pattern itself was processed
+		h.log.Warn(ErrSavePatternDeployment(perr))
+	}
+}
+
+// parsePatternInputs parses the <input>=<value> pairs passed to the deploy,
+// the values are converted to the declared type of the input by the filler
+func parsePatternInputs(pairs []string) (map[string]interface{}, error) {
+	inputs := map[string]interface{}{}
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid pattern input %q, expected <input>=<value>", pair)
+		}
+
+		inputs[kv[0]] = kv[1]
+	}
+
+	return inputs, nil
+}
+
+// recordPatternDeployment fills in the outcome of the deployment and of each of
+// its services from the metadata left behind by the stages
+func recordPatternDeployment(deployment *models.PatternDeployment, data *stages.Data, err error) {
+	deployment.Status = models.PatternDeploymentSucceeded
+	if err != nil {
+		deployment.Status = models.PatternDeploymentFailed
+		deployment.Message = err.Error()
+	}
+
+	// The inputs are already substituted, hence they are left out so
+	// that the recorded pattern can be deployed again as it is
+	deployed := *data.Pattern
+	deployed.Inputs = nil
+	if byt, err := deployed.ToYAML(); err == nil {
+		deployment.PatternFile = string(byt)
+	}
+
+	names := make([]string, 0, len(data.Pattern.Services))
+	for name := range data.Pattern.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	deployment.Services = []models.PatternDeploymentService{}
+	for _, name := range names {
+		svc := models.PatternDeploymentService{
+			Name:   name,
+			Type:   data.Pattern.Services[name].Type,
+			Status: models.PatternServiceSkipped,
+		}
+
+		if msg, ok := data.Other[name+stages.ProvisionSuffixKey]; ok {
+			svc.Status = models.PatternServiceProvisioned
+			svc.Message, _ = msg.(string)
+		}
+		if msg, ok := data.Other[name+stages.FailedSuffixKey]; ok {
+			svc.Status = models.PatternServiceFailed
+			svc.Message, _ = msg.(string)
+		}
+		if msg, ok := data.Other[name+stages.RollbackSuffixKey]; ok {
+			svc.Status = models.PatternServiceRolledBack
+			svc.Message, _ = msg.(string)
+			deployment.Status = models.PatternDeploymentRolledBack
+		}
+
+		deployment.Services = append(deployment.Services, svc)
+	}
+}
+
 type serviceInfoProvider struct {
 	provider   models.Provider
 	token      string
 	opIsDelete bool
+	opIsAtomic bool
 }
 
 func (sip *serviceInfoProvider) GetMesheryPatternResource(name, namespace, typ, oamType string) (*uuid.UUID, error) {
@@ -547,6 +806,10 @@ func (sip *serviceInfoProvider) IsDelete() bool {
 	return sip.opIsDelete
 }
 
+func (sip *serviceInfoProvider) IsAtomic() bool {
+	return sip.opIsAtomic
+}
+
 type serviceActionProvider struct {
 	token    string
 	provider models.Provider
@@ -555,12 +818,26 @@ type serviceActionProvider struct {
 	kubeconfigs []string
 	opIsDelete  bool
 	userID      string
+	// opIsAtomic snapshots the objects before they are provisioned so that
+	// a rollback can restore them
+	opIsAtomic bool
 	// kubeconfig  []byte
 	// kubecontext     *models.K8sContext
 	skipPrintLogs   bool
 	accumulatedMsgs []string
 	err             error
 	eventbuffer     *events.EventStreamer
+
+	// clusters are the clusters against which a dry run is performed
+	clusters []patterns.Cluster
+	// liveObjects are the objects observed by MeshSync, if set the dry
+	// run is performed against them instead of the clusters
+	liveObjects []patterns.LiveObject
+
+	// snapshots holds the objects of the provisioned components as they
+	// were before, by component name
+	snapshots   map[string]*patterns.Snapshot
+	snapshotsMx sync.Mutex
 }
 
 func (sap *serviceActionProvider) Terminate(err error) {
@@ -592,6 +869,19 @@ func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string,
 
 		// Local call
 		if strings.HasPrefix(adapter, string(noneLocal)) {
+			if sap.opIsAtomic && patterns.CanSnapshot(ccp.Component) {
+				snapshot, err := patterns.SnapshotOAM(sap.kubeconfigs, ccp.Component)
+				if err != nil {
+					return "", err
+				}
+				sap.snapshotsMx.Lock()
+				if sap.snapshots == nil {
+					sap.snapshots = map[string]*patterns.Snapshot{}
+				}
+				sap.snapshots[ccp.Component.Name] = snapshot
+				sap.snapshotsMx.Unlock()
+			}
+
 			resp, err := patterns.ProcessOAM(
 				sap.kubeconfigs,
 				[]string{string(jsonComp)},
@@ -643,6 +933,49 @@ func (sap *serviceActionProvider) Provision(ccp stages.CompConfigPair) (string,
 	return "", nil
 }
 
+// Rollback restores the objects of the given CompConfigPair to their snapshot taken before
+// they were provisioned, the objects which didn't exist before are deleted. The components
+// which can't be snapshotted, like those of the adapters, are left as provisioned
+func (sap *serviceActionProvider) Rollback(ccp stages.CompConfigPair) (string, error) {
+	sap.snapshotsMx.Lock()
+	snapshot, ok := sap.snapshots[ccp.Component.Name]
+	sap.snapshotsMx.Unlock()
+	if !ok {
+		return "", fmt.Errorf("the previous state of %s of type %s can't be restored, it was left as provisioned", ccp.Component.Name, ccp.Component.Spec.Type)
+	}
+
+	return patterns.RestoreOAM(snapshot)
+}
+
+func (sap *serviceActionProvider) DryRun(ccp stages.CompConfigPair) ([]k8s.ObjectDiff, error) {
+	for adapter := range ccp.Hosts {
+		// Objects of the local components can be rendered by the server
+		if strings.HasPrefix(adapter, string(noneLocal)) {
+			if sap.liveObjects != nil {
+				return patterns.DriftOAM(sap.clusters, sap.liveObjects, []v1alpha1.Component{ccp.Component}), nil
+			}
+			return patterns.DryRunOAM(sap.clusters, []v1alpha1.Component{ccp.Component}, sap.opIsDelete), nil
+		}
+
+		// Adapters render the objects themselves and don't expose them
+		// before applying, hence the diff can't be computed for them
+		res := []k8s.ObjectDiff{}
+		for _, cluster := range sap.clusters {
+			res = append(res, k8s.ObjectDiff{
+				Context:   cluster.Name,
+				Kind:      ccp.Component.Spec.Type,
+				Name:      ccp.Component.Name,
+				Namespace: ccp.Component.Namespace,
+				Operation: k8s.DiffOperationUnknown,
+				Error:     fmt.Sprintf("dry run is not supported by the adapter %s", adapter),
+			})
+		}
+		return res, nil
+	}
+
+	return []k8s.ObjectDiff{}, nil
+}
+
 func (sap *serviceActionProvider) Persist(name string, svc core.Service, isUpdate bool) error {
 	if !sap.opIsDelete {
 		if isUpdate {
@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/server/internal/store"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func TestRecordPatternDeployment(t *testing.T) {
+	pattern := &core.Pattern{
+		Name: "sample",
+		Services: map[string]*core.Service{
+			"db":    {Type: "StatefulSet.K8s"},
+			"web":   {Type: "Deployment.K8s"},
+			"cache": {Type: "Deployment.K8s"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		other      map[string]interface{}
+		err        error
+		wantStatus models.PatternDeploymentStatus
+		wantSvcs   map[string]models.PatternDeploymentServiceStatus
+	}{
+		{
+			name: "all services provisioned",
+			other: map[string]interface{}{
+				"db" + stages.ProvisionSuffixKey:    "deployed db",
+				"web" + stages.ProvisionSuffixKey:   "deployed web",
+				"cache" + stages.ProvisionSuffixKey: "deployed cache",
+			},
+			wantStatus: models.PatternDeploymentSucceeded,
+			wantSvcs: map[string]models.PatternDeploymentServiceStatus{
+				"db":    models.PatternServiceProvisioned,
+				"web":   models.PatternServiceProvisioned,
+				"cache": models.PatternServiceProvisioned,
+			},
+		},
+		{
+			name: "failed service with a skipped dependent",
+			other: map[string]interface{}{
+				"cache" + stages.ProvisionSuffixKey: "deployed cache",
+				"db" + stages.FailedSuffixKey:       "quota exceeded",
+			},
+			err:        fmt.Errorf("quota exceeded"),
+			wantStatus: models.PatternDeploymentFailed,
+			wantSvcs: map[string]models.PatternDeploymentServiceStatus{
+				"db":    models.PatternServiceFailed,
+				"web":   models.PatternServiceSkipped,
+				"cache": models.PatternServiceProvisioned,
+			},
+		},
+		{
+			name: "rolled back",
+			other: map[string]interface{}{
+				"cache" + stages.RollbackSuffixKey: "deleted cache",
+				"db" + stages.FailedSuffixKey:      "quota exceeded",
+			},
+			err:        fmt.Errorf("quota exceeded\nrollback succeeded"),
+			wantStatus: models.PatternDeploymentRolledBack,
+			wantSvcs: map[string]models.PatternDeploymentServiceStatus{
+				"db":    models.PatternServiceFailed,
+				"web":   models.PatternServiceSkipped,
+				"cache": models.PatternServiceRolledBack,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := &models.PatternDeployment{}
+			recordPatternDeployment(deployment, &stages.Data{Pattern: pattern, Other: tt.other}, tt.err)
+
+			if deployment.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", deployment.Status, tt.wantStatus)
+			}
+			if len(deployment.Services) != len(tt.wantSvcs) {
+				t.Fatalf("got %d services, want %d", len(deployment.Services), len(tt.wantSvcs))
+			}
+			for _, svc := range deployment.Services {
+				if svc.Status != tt.wantSvcs[svc.Name] {
+					t.Errorf("service %s status = %s, want %s", svc.Name, svc.Status, tt.wantSvcs[svc.Name])
+				}
+			}
+		})
+	}
+}
+
+func TestServiceActionProviderRollback(t *testing.T) {
+	sap := &serviceActionProvider{opIsAtomic: true}
+	ccp := stages.CompConfigPair{
+		Hosts: map[string]bool{string(noneLocal): true},
+	}
+	ccp.Component.Name = "istio"
+	ccp.Component.Spec.Type = "IstioMesh"
+
+	// the components without snapshot are left as provisioned instead of being deleted
+	if _, err := sap.Rollback(ccp); err == nil {
+		t.Error("component without snapshot is rolled back")
+	}
+}
+
+func TestProcessPatternInputs(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.PatternResource{}); err != nil {
+		t.Fatal(err)
+	}
+	provider := &models.DefaultLocalProvider{MesheryPatternResourcePersister: &models.PatternResourcePersister{DB: &db}}
+
+	pattern := core.Pattern{
+		Name:   "parameterized",
+		Inputs: map[string]*core.PatternInput{"replicas": {Type: core.InputTypeInteger, Required: true}},
+		Services: map[string]*core.Service{
+			"web": {Type: "Deployment", Settings: map[string]interface{}{"replicas": "$(#ref.inputs.replicas)"}},
+		},
+	}
+	ctx := context.WithValue(context.Background(), models.TokenCtxKey, "")
+	ctx = context.WithValue(ctx, models.KubeClustersKey, []models.K8sContext{{ID: "test", Name: "test"}})
+
+	// A deploy without inputs is rejected instead of deploying the reference to the input
+	_, err = _processPattern(ctx, provider, pattern, nil, nil, "alice", false, false, false, true, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "missing required input") {
+		t.Errorf("_processPattern() error = %v, want the missing required input to be reported", err)
+	}
+	_, err = _processPattern(ctx, provider, pattern, nil, nil, "alice", true, false, false, true, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "missing required input") {
+		t.Errorf("_processPattern() of a delete error = %v, want the missing required input to be reported", err)
+	}
+
+	// Verifying the pattern doesn't need the inputs
+	store.Initialize()
+	_, err = _processPattern(ctx, provider, pattern, nil, nil, "alice", false, true, false, true, nil, nil)
+	if err != nil && strings.Contains(err.Error(), "missing required input") {
+		t.Errorf("_processPattern() of a verify error = %v, want the inputs not to be required", err)
+	}
+}
+
+func TestPatternFileHandlerWithoutToken(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{log: log}
+
+	body := "name: sample\nservices:\n  web:\n    type: Deployment\n"
+	req := httptest.NewRequest(http.MethodPost, "/api/pattern/deploy", strings.NewReader(body))
+	rw := httptest.NewRecorder()
+	h.PatternFileHandler(rw, req, nil, &models.User{UserID: "alice"}, &models.DefaultLocalProvider{})
+
+	if rw.Code != http.StatusUnauthorized {
+		t.Errorf("PatternFileHandler() without a token = %d, want %d", rw.Code, http.StatusUnauthorized)
+	}
+}
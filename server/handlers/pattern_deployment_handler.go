@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/pattern/deployments PatternsAPI idGetPatternDeployments
+// Handle GET request for pattern deployments
+//
+// Returns the recorded deployments of the patterns, use ?pattern_id= to only list
+// the deployments of a single pattern
+// responses:
+// 	200: patternDeploymentsResponseWrapper
+
+// GetPatternDeploymentsHandler returns the recorded deployments of the patterns
+func (h *Handler) GetPatternDeploymentsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	q := r.URL.Query()
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.GetPatternDeployments(tokenString, q.Get("page"), q.Get("page_size"), q.Get("order"), q.Get("pattern_id"))
+	if err != nil {
+		h.log.Error(ErrGetPatternDeployment(err))
+		http.Error(rw, ErrGetPatternDeployment(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// swagger:route GET /api/pattern/deployments/{id} PatternsAPI idGetPatternDeployment
+// Handle GET request for a pattern deployment
+//
+// Returns the pattern deployment with the given id along with the outcome of each of its services
+// responses:
+// 	200: patternDeploymentResponseWrapper
+
+// GetPatternDeploymentHandler returns the pattern deployment with the given id
+func (h *Handler) GetPatternDeploymentHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	deploymentID := mux.Vars(r)["id"]
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.GetPatternDeployment(tokenString, deploymentID)
+	if err != nil {
+		h.log.Error(ErrGetPatternDeployment(err))
+		http.Error(rw, ErrGetPatternDeployment(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
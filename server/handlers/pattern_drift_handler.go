@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+)
+
+// swagger:route GET /api/pattern/deployments/{id}/drift PatternsAPI idGetPatternDrift
+// Handle GET request for the drift of a pattern deployment
+//
+// Compares the pattern deployment with the objects observed by MeshSync in the selected
+// kubernetes contexts. Services whose objects were deleted or modified since the deploy are
+// flagged along with the labelled objects which have no matching pattern resource
+// responses:
+// 	200: patternDriftResponseWrapper
+
+// GetPatternDriftHandler returns the drift of the pattern deployment with the given id
+func (h *Handler) GetPatternDriftHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	report, err := DetectPatternDrift(r.Context(), provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrDetectPatternDrift(err))
+		http.Error(rw, ErrDetectPatternDrift(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(report); err != nil {
+		h.log.Error(ErrEncoding(err, "pattern drift"))
+		http.Error(rw, ErrEncoding(err, "pattern drift").Error(), http.StatusInternalServerError)
+	}
+}
+
+// swagger:route POST /api/pattern/deployments/{id}/reconcile PatternsAPI idPostReconcilePatternDeployment
+// Handle POST request for reconciling a pattern deployment
+//
+// Deploys the pattern of the pattern deployment again, which brings back the deleted and
+// modified objects of its services. The reconcile is recorded as a new pattern deployment
+// responses:
+// 	200:
+
+// ReconcilePatternDeploymentHandler deploys the pattern of the pattern deployment with the given id again
+func (h *Handler) ReconcilePatternDeploymentHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token := r.Context().Value(models.TokenCtxKey).(string)
+
+	previous, pattern, err := getDeployedPattern(token, provider, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrReconcilePattern(err))
+		http.Error(rw, ErrReconcilePattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	startedAt := time.Now()
+	deployment := &models.PatternDeployment{
+		PatternID:   previous.PatternID,
+		PatternName: previous.PatternName,
+		VersionHash: previous.VersionHash,
+		UserID:      user.UserID,
+		StartedAt:   &startedAt,
+	}
+
+	msg, err := _processPattern(
+		r.Context(),
+		provider,
+		pattern,
+		nil,
+		prefObj,
+		user.UserID,
+		false,
+		false,
+		false,
+		false,
+		h.EventsBuffer,
+		deployment,
+	)
+	h.savePatternDeployment(token, provider, deployment, err)
+
+	if err != nil {
+		h.log.Error(ErrReconcilePattern(err))
+		http.Error(rw, ErrReconcilePattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	fmt.Fprintf(rw, "%s", msg)
+}
+
+// DetectPatternDrift compares the pattern deployment with the given id with the
+// objects observed by MeshSync in the kubernetes contexts of the deployment
+func DetectPatternDrift(ctx context.Context, provider models.Provider, deploymentID string) (*models.PatternDriftReport, error) {
+	token, ok := ctx.Value(models.TokenCtxKey).(string)
+	if !ok {
+		return nil, ErrRetrieveUserToken(fmt.Errorf("token not found in the context"))
+	}
+
+	deployment, pattern, err := getDeployedPattern(token, provider, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext)
+	if !ok || len(k8scontexts) == 0 {
+		return nil, ErrInvalidKubeHandler(fmt.Errorf("failed to find k8s handler"), "DetectPatternDrift couldn't find a valid k8s handler")
+	}
+
+	deployedTo := map[string]bool{}
+	for _, id := range deployment.ContextIDs {
+		deployedTo[id] = true
+	}
+
+	var clusters []patterns.Cluster
+	var clusterIDs []string
+	clusterNames := map[string]string{}
+	for _, k8scontext := range k8scontexts {
+		if !deployedTo[k8scontext.ID] || k8scontext.KubernetesServerID == nil {
+			continue
+		}
+
+		clusters = append(clusters, patterns.Cluster{Name: k8scontext.Name})
+		clusterIDs = append(clusterIDs, k8scontext.KubernetesServerID.String())
+		clusterNames[k8scontext.KubernetesServerID.String()] = k8scontext.Name
+	}
+	if len(clusters) == 0 {
+		return nil, fmt.Errorf("none of the kubernetes contexts to which the pattern was deployed are selected")
+	}
+
+	objects, err := models.GetPatternLabelledObjects(provider.GetGenericPersister(), clusterIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	liveObjects := []patterns.LiveObject{}
+	for _, obj := range objects {
+		liveObjects = append(liveObjects, patterns.LiveObject{
+			Cluster: clusterNames[obj.ClusterID],
+			Object:  models.MeshSyncObjectToMap(obj),
+		})
+	}
+
+	sip := &serviceInfoProvider{
+		token:    token,
+		provider: provider,
+	}
+	sap := &serviceActionProvider{
+		token:         token,
+		provider:      provider,
+		skipPrintLogs: true,
+		clusters:      clusters,
+		liveObjects:   liveObjects,
+	}
+
+	var diffs map[string][]k8s.ObjectDiff
+	resourceIDs := map[string]string{}
+	stages.CreateChain().
+		Add(stages.Import(sip, sap)).
+		Add(stages.ServiceIdentifier(sip, sap)).
+		Add(stages.Filler(true)).
+		Add(stages.Validator(sip, sap)).
+		Add(stages.DryRun(sip, sap)).
+		Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
+			diffs = stages.GetDryRunDiffs(data)
+			for name, svc := range data.Pattern.Services {
+				if svc.ID != nil {
+					resourceIDs[name] = svc.ID.String()
+				}
+			}
+			sap.err = err
+		}).
+		Process(&stages.Data{
+			Pattern: &pattern,
+			Other:   map[string]interface{}{},
+		})
+	if sap.err != nil {
+		return nil, sap.err
+	}
+
+	checkedAt := time.Now()
+	report := &models.PatternDriftReport{
+		DeploymentID: deployment.ID,
+		PatternID:    deployment.PatternID,
+		PatternName:  deployment.PatternName,
+		Status:       models.PatternInSync,
+		CheckedAt:    &checkedAt,
+		Services:     serviceDrifts(diffs, resourceIDs),
+		Orphans:      []models.OrphanedObject{},
+	}
+
+	// Labelled objects of the other patterns are fine as long as their pattern resource exists
+	known := map[string]bool{}
+	for _, id := range resourceIDs {
+		known[id] = true
+	}
+	for _, obj := range objects {
+		id := models.GetPatternResourceLabel(obj)
+		if _, ok := known[id]; !ok {
+			res, err := provider.GetMesheryPatternResource(token, id)
+			known[id] = err == nil && res != nil && !res.Deleted
+		}
+		if known[id] {
+			continue
+		}
+
+		orphan := models.OrphanedObject{
+			ResourceID: id,
+			Context:    clusterNames[obj.ClusterID],
+			APIVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+		}
+		if obj.ObjectMeta != nil {
+			orphan.Name = obj.ObjectMeta.Name
+			orphan.Namespace = obj.ObjectMeta.Namespace
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+
+	for _, svc := range report.Services {
+		if svc.Status == models.ServiceDeleted || svc.Status == models.ServiceModified {
+			report.Status = models.PatternDrifted
+		}
+	}
+	if len(report.Orphans) > 0 {
+		report.Status = models.PatternDrifted
+	}
+
+	return report, nil
+}
+
+// getDeployedPattern returns the pattern deployment with the given id along with the pattern it deployed
+func getDeployedPattern(token string, provider models.Provider, deploymentID string) (*models.PatternDeployment, core.Pattern, error) {
+	resp, err := provider.GetPatternDeployment(token, deploymentID)
+	if err != nil {
+		return nil, core.Pattern{}, err
+	}
+
+	deployment := &models.PatternDeployment{}
+	if err := json.Unmarshal(resp, deployment); err != nil {
+		return nil, core.Pattern{}, ErrUnmarshal(err, "pattern deployment")
+	}
+
+	if deployment.IsDelete {
+		return nil, core.Pattern{}, fmt.Errorf("pattern deployment %s deleted the pattern", deploymentID)
+	}
+	if deployment.PatternFile == "" {
+		return nil, core.Pattern{}, fmt.Errorf("pattern deployment %s was recorded without its pattern file", deploymentID)
+	}
+
+	pattern, err := core.NewPatternFile([]byte(deployment.PatternFile))
+	if err != nil {
+		return nil, core.Pattern{}, ErrParsePattern(err)
+	}
+
+	return deployment, pattern, nil
+}
+
+// serviceDrifts converts the diffs of the services against the live objects into their drift
+func serviceDrifts(diffs map[string][]k8s.ObjectDiff, resourceIDs map[string]string) []models.ServiceDrift {
+	names := make([]string, 0, len(diffs))
+	for name := range diffs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := []models.ServiceDrift{}
+	for _, name := range names {
+		for _, diff := range diffs[name] {
+			drift := models.ServiceDrift{
+				Service:    name,
+				ResourceID: resourceIDs[name],
+				Context:    diff.Context,
+				APIVersion: diff.APIVersion,
+				Kind:       diff.Kind,
+				Name:       diff.Name,
+				Namespace:  diff.Namespace,
+				Message:    diff.Error,
+			}
+
+			switch diff.Operation {
+			case k8s.DiffOperationCreate:
+				drift.Status = models.ServiceDeleted
+			case k8s.DiffOperationUpdate:
+				drift.Status = models.ServiceModified
+			case k8s.DiffOperationUnchanged:
+				drift.Status = models.ServiceInSync
+			default:
+				drift.Status = models.ServiceUnknown
+			}
+
+			for _, f := range diff.Fields {
+				drift.Fields = append(drift.Fields, models.FieldDrift{
+					Path:    f.Path,
+					Live:    f.Live,
+					Desired: f.Desired,
+				})
+			}
+
+			res = append(res, drift)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/stages"
+)
+
+// swagger:route GET /api/pattern/{id}/export PatternsAPI idGetMesheryPatternExport
+// Handle GET request for exporting a pattern
+//
+// Renders the components of the pattern along with their settings into plain kubernetes objects
+// and packages them as a manifest, a helm chart or a kustomize base which can be applied without
+// Meshery. Patterns with services managed by adapters can't be exported
+// responses:
+// 	200:
+
+// GetMesheryPatternExportHandler exports the pattern with the given id in the requested format
+func (h *Handler) GetMesheryPatternExportHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	token, ok := r.Context().Value(models.TokenCtxKey).(string)
+	if !ok {
+		h.log.Error(ErrRetrieveUserToken(fmt.Errorf("token not found in the context")))
+		http.Error(rw, ErrRetrieveUserToken(fmt.Errorf("token not found in the context")).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := provider.GetMesheryPattern(r, mux.Vars(r)["id"])
+	if err != nil {
+		h.log.Error(ErrGetPattern(err))
+		http.Error(rw, ErrGetPattern(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	mesheryPattern := models.MesheryPattern{}
+	if err := json.Unmarshal(resp, &mesheryPattern); err != nil {
+		h.log.Error(ErrDecodePattern(err))
+		http.Error(rw, ErrDecodePattern(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	pattern, err := core.NewPatternFile([]byte(mesheryPattern.PatternFile))
+	if err != nil {
+		h.log.Error(ErrParsePattern(err))
+		http.Error(rw, ErrParsePattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	objects, err := exportPatternObjects(token, provider, pattern)
+	if err != nil {
+		h.log.Error(ErrExportPattern(err))
+		http.Error(rw, ErrExportPattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	name := mesheryPattern.Name
+	if name == "" {
+		name = pattern.Name
+	}
+	export, err := models.ExportPattern(name, objects, models.PatternExportFormat(r.URL.Query().Get("format")))
+	if err != nil {
+		h.log.Error(ErrExportPattern(err))
+		http.Error(rw, ErrExportPattern(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", export.ContentType)
+	rw.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.FileName))
+	if _, err := rw.Write(export.Content); err != nil {
+		h.log.Error(ErrWriteResponse)
+	}
+}
+
+// exportPatternObjects renders the services of the pattern into the kubernetes objects they deploy
+func exportPatternObjects(token string, provider models.Provider, pattern core.Pattern) ([]map[string]interface{}, error) {
+	sip := &serviceInfoProvider{
+		token:    token,
+		provider: provider,
+	}
+	sap := &serviceActionProvider{
+		token:         token,
+		provider:      provider,
+		skipPrintLogs: true,
+	}
+
+	var objects []map[string]interface{}
+	stages.CreateChain().
+		Add(stages.Import(sip, sap)).
+		Add(stages.ServiceIdentifier(sip, sap)).
+		Add(stages.Filler(true)).
+		Add(stages.Validator(sip, sap)).
+		Add(stages.Export(sip, sap)).
+		Add(func(data *stages.Data, err error, next stages.ChainStageNextFunction) {
+			objects = stages.GetExportedObjects(data)
+			sap.err = err
+		}).
+		Process(&stages.Data{
+			Pattern: &pattern,
+			Other:   map[string]interface{}{},
+		})
+	if sap.err != nil {
+		return nil, sap.err
+	}
+
+	return objects, nil
+}
@@ -0,0 +1,236 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+// swagger:route POST /api/pattern/sync PatternsAPI idPostPatternSyncSource
+// Handle POST request for pattern sync sources
+//
+// Saves a git repository from which the patterns matching the path glob are imported whenever
+// a new commit is pushed to the branch. With deploy enabled, the changed patterns are also
+// deployed to the kubernetes contexts of the sync source
+// responses:
+// 	200: patternSyncSourceResponseWrapper
+
+// SavePatternSyncSourceHandler saves the pattern sync source of the user
+func (h *Handler) SavePatternSyncSourceHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	defer func() {
+		_ = r.Body.Close()
+	}()
+
+	var source models.PatternSyncSource
+	if err := json.NewDecoder(r.Body).Decode(&source); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	source.UserID = user.UserID
+
+	// the contexts are persisted with the source, its deploys never leave the allow-list of the token
+	if err := checkTokenContexts(r, source.ContextIDs); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	if err := source.Validate(); err != nil {
+		h.log.Error(ErrSavePatternSyncSource(err))
+		http.Error(rw, ErrSavePatternSyncSource(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+	resp, err := provider.SavePatternSyncSource(tokenString, &source)
+	if err != nil {
+		h.log.Error(ErrSavePatternSyncSource(err))
+		http.Error(rw, ErrSavePatternSyncSource(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// swagger:route GET /api/pattern/sync PatternsAPI idGetPatternSyncSources
+// Handle GET request for pattern sync sources
+//
+// Returns the pattern sync sources along with the commit they were last synced at
+// responses:
+// 	200: patternSyncSourcesResponseWrapper
+
+// GetPatternSyncSourcesHandler returns the pattern sync sources of the user
+func (h *Handler) GetPatternSyncSourcesHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	q := r.URL.Query()
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.GetPatternSyncSources(tokenString, user.UserID, q.Get("page"), q.Get("page_size"), q.Get("order"))
+	if err != nil {
+		h.log.Error(ErrGetPatternSyncSource(err))
+		http.Error(rw, ErrGetPatternSyncSource(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// swagger:route GET /api/pattern/sync/{id} PatternsAPI idGetPatternSyncSource
+// Handle GET request for a pattern sync source
+//
+// Returns the pattern sync source with the given id along with its recent syncs, each sync
+// records the commit it imported, the changed pattern files and the outcome of their deploy
+// responses:
+// 	200: patternSyncSourceResponseWrapper
+
+// GetPatternSyncSourceHandler returns the pattern sync source with the given id
+func (h *Handler) GetPatternSyncSourceHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	sourceID := mux.Vars(r)["id"]
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.GetPatternSyncSource(tokenString, user.UserID, sourceID)
+	if err != nil {
+		h.log.Error(ErrGetPatternSyncSource(err))
+		http.Error(rw, ErrGetPatternSyncSource(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// swagger:route DELETE /api/pattern/sync/{id} PatternsAPI idDeletePatternSyncSource
+// Handle DELETE request for a pattern sync source
+//
+// Stops syncing the git repository, the patterns imported from it are kept
+// responses:
+// 	200: patternSyncSourceResponseWrapper
+
+// DeletePatternSyncSourceHandler deletes the pattern sync source with the given id
+func (h *Handler) DeletePatternSyncSourceHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	sourceID := mux.Vars(r)["id"]
+	tokenString := r.Context().Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.DeletePatternSyncSource(tokenString, user.UserID, sourceID)
+	if err != nil {
+		h.log.Error(ErrDeletePatternSyncSource(err))
+		http.Error(rw, ErrDeletePatternSyncSource(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	fmt.Fprint(rw, string(resp))
+}
+
+// DeploySyncedPatterns deploys the patterns imported by a sync to the kubernetes contexts
+// bound to the sync source, each deploy is recorded as a pattern deployment
+func (h *Handler) DeploySyncedPatterns(ctx context.Context, provider models.Provider, source *models.PatternSyncSource, patterns []models.MesheryPattern) (string, error) {
+	token, _ := ctx.Value(models.TokenCtxKey).(string)
+	contexts, err := provider.LoadAllK8sContext(token)
+	if err != nil {
+		return "", err
+	}
+
+	bound := map[string]bool{}
+	for _, id := range source.ContextIDs {
+		bound[id] = true
+	}
+	k8scontexts := []models.K8sContext{}
+	for _, c := range contexts {
+		if c != nil && bound[c.ID] {
+			k8scontexts = append(k8scontexts, *c)
+		}
+	}
+	if len(k8scontexts) == 0 {
+		return "", ErrInvalidK8SConfig
+	}
+
+	prefObj, err := provider.ReadFromPersister(source.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	ctx = context.WithValue(ctx, models.TokenCtxKey, token)
+	ctx = context.WithValue(ctx, models.KubeClustersKey, k8scontexts)
+
+	var results, failed []string
+	for _, p := range patterns {
+		pattern, err := core.NewPatternFile([]byte(p.PatternFile))
+		if err != nil {
+			results = append(results, fmt.Sprintf("%s: %s", p.Name, ErrParsePattern(err)))
+			failed = append(failed, p.Name)
+			continue
+		}
+
+		startedAt := time.Now()
+		deployment := &models.PatternDeployment{
+			PatternID:   p.ID,
+			PatternName: p.Name,
+			VersionHash: models.PatternVersionHash([]byte(p.PatternFile)),
+			UserID:      source.UserID,
+			StartedAt:   &startedAt,
+		}
+
+		msg, err := _processPattern(
+			ctx,
+			provider,
+			pattern,
+			nil,
+			prefObj,
+			source.UserID,
+			false,
+			false,
+			false,
+			true,
+			h.EventsBuffer,
+			deployment,
+		)
+		h.savePatternDeployment(token, provider, deployment, err)
+
+		if err != nil {
+			results = append(results, fmt.Sprintf("%s: %s", p.Name, err))
+			failed = append(failed, p.Name)
+			continue
+		}
+		results = append(results, fmt.Sprintf("%s: %s", p.Name, strings.TrimSpace(msg)))
+	}
+
+	result := strings.Join(results, "\n")
+	if len(failed) > 0 {
+		return result, fmt.Errorf("failed to deploy the patterns %s", strings.Join(failed, ", "))
+	}
+
+	return result, nil
+}
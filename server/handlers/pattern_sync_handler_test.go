@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/spf13/viper"
+)
+
+func TestSavePatternSyncSourceHandler(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&models.PatternSyncSource{}, &models.PatternSync{}, &models.PatternSyncFile{}); err != nil {
+		t.Fatal(err)
+	}
+	provider := &models.DefaultLocalProvider{PatternSyncPersister: &models.PatternSyncPersister{DB: &db}}
+	h := &Handler{log: log}
+
+	save := func(repoURL string) int {
+		body := fmt.Sprintf(`{"repo_url": %q, "branch": "master"}`, repoURL)
+		req := httptest.NewRequest(http.MethodPost, "/api/pattern/sync", strings.NewReader(body))
+		req = req.WithContext(context.WithValue(req.Context(), models.TokenCtxKey, ""))
+		rw := httptest.NewRecorder()
+		h.SavePatternSyncSourceHandler(rw, req, nil, &models.User{UserID: "alice"}, provider)
+		return rw.Code
+	}
+
+	tests := []struct {
+		name       string
+		repoURL    string
+		allowLocal bool
+		want       int
+	}{
+		{name: "https repository", repoURL: "https://github.com/layer5io/meshery.git", want: http.StatusOK},
+		{name: "ssh repository", repoURL: "git@github.com:layer5io/meshery.git", want: http.StatusOK},
+		{name: "file repository", repoURL: "file:///var/lib/meshery", want: http.StatusBadRequest},
+		{name: "local path", repoURL: "/var/lib/meshery", want: http.StatusBadRequest},
+		{name: "file repository allowed", repoURL: "file:///var/lib/meshery", allowLocal: true, want: http.StatusOK},
+		{name: "local path allowed", repoURL: "/var/lib/meshery", allowLocal: true, want: http.StatusOK},
+		{name: "http repository with local repositories allowed", repoURL: "http://github.com/layer5io/meshery.git", allowLocal: true, want: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("ALLOW_LOCAL_GIT_REPOS", tt.allowLocal)
+			defer viper.Set("ALLOW_LOCAL_GIT_REPOS", false)
+
+			if got := save(tt.repoURL); got != tt.want {
+				t.Errorf("SavePatternSyncSourceHandler() of %s = %d, want %d", tt.repoURL, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// swagger:route GET /api/user/performance/profiles/{id}/compare PerformanceAPI idComparePerformanceResults
+// Handle GET request for comparing two results of a performance profile
+//
+// Compares the candidate result with the base result, the pinned baseline of the profile is used
+// as the base if none is given. The comparison lists the latency percentile, throughput and error
+// rate deltas along with whether the change of the latency distribution is statistically significant.
+// A significant change of the latency is only a regression if the mean or the p99 latency increased
+// by more than the regression threshold of the profile, 5% unless set
+// responses:
+// 	200: performanceComparisonResponseWrapper
+
+// ComparePerformanceResultsHandler compares two results of the performance profile with the given id
+func (h *Handler) ComparePerformanceResultsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	profileID := mux.Vars(r)["id"]
+	q := r.URL.Query()
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	profile := h.getPerformanceProfile(r, provider, profileID)
+	threshold := float64(0)
+	if profile != nil {
+		threshold = profile.RegressionThreshold
+	}
+	if t := q.Get("regression_threshold"); t != "" {
+		parsed, err := strconv.ParseFloat(t, 64)
+		if err != nil || parsed < 0 {
+			err = fmt.Errorf("regression threshold should be a positive percentage")
+			h.log.Error(ErrComparePerformanceResults(err))
+			http.Error(rw, ErrComparePerformanceResults(err).Error(), http.StatusBadRequest)
+			return
+		}
+		threshold = parsed
+	}
+
+	baseID := uuid.FromStringOrNil(q.Get("base"))
+	if baseID == uuid.Nil {
+		if profile == nil || profile.BaselineResult == nil {
+			err := fmt.Errorf("base result is required as the profile has no pinned baseline")
+			h.log.Error(ErrComparePerformanceResults(err))
+			http.Error(rw, ErrComparePerformanceResults(err).Error(), http.StatusBadRequest)
+			return
+		}
+		baseID = *profile.BaselineResult
+	}
+	candidateID := uuid.FromStringOrNil(q.Get("candidate"))
+	if candidateID == uuid.Nil {
+		err := fmt.Errorf("candidate result is required")
+		h.log.Error(ErrComparePerformanceResults(err))
+		http.Error(rw, ErrComparePerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	results := []*models.MesheryResult{}
+	for _, id := range []uuid.UUID{baseID, candidateID} {
+		result, err := provider.GetResult(token, id)
+		if err != nil || result == nil {
+			if err == nil {
+				err = fmt.Errorf("result %s not found", id)
+			}
+			h.log.Error(ErrGetResult(err))
+			http.Error(rw, ErrGetResult(err).Error(), http.StatusNotFound)
+			return
+		}
+		if result.PerformanceProfile != nil && result.PerformanceProfile.String() != profileID {
+			err := fmt.Errorf("result %s does not belong to the performance profile %s", id, profileID)
+			h.log.Error(ErrComparePerformanceResults(err))
+			http.Error(rw, ErrComparePerformanceResults(err).Error(), http.StatusBadRequest)
+			return
+		}
+		results = append(results, result)
+	}
+
+	comparison, err := models.ComparePerformanceResults(results[0].Result, results[1].Result, threshold)
+	if err != nil {
+		h.log.Error(ErrComparePerformanceResults(err))
+		http.Error(rw, ErrComparePerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+	profileUUID := uuid.FromStringOrNil(profileID)
+	comparison.ProfileID = &profileUUID
+	comparison.BaseResultID = &baseID
+	comparison.CandidateResultID = &candidateID
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(comparison); err != nil {
+		h.log.Error(ErrEncoding(err, "performance comparison"))
+		http.Error(rw, ErrEncoding(err, "performance comparison").Error(), http.StatusInternalServerError)
+	}
+}
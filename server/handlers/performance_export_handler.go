@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// exportPageSize is the size of the pages of results fetched from the provider for an export
+const exportPageSize = 100
+
+// swagger:route GET /api/perf/profile/result/{id}/export PerfAPI idExportPerfResult
+// Handle GET request for exporting a performance result
+//
+// Exports the result in the format given by the format query parameter: csv, jsonl, hdr or junit
+// responses:
+// 	200: performanceExportResponseWrapper
+
+// ExportResultHandler exports the performance result with the given id
+func (h *Handler) ExportResultHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	format, err := exportFormat(r)
+	if err != nil {
+		h.log.Error(ErrExportPerformanceResults(err))
+		http.Error(rw, ErrExportPerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+	resultID := uuid.FromStringOrNil(mux.Vars(r)["id"])
+	if resultID == uuid.Nil {
+		h.log.Error(ErrQueryGet("id"))
+		http.Error(rw, "please provide a valid result id", http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	result, err := provider.GetResult(token, resultID)
+	if err != nil || result == nil {
+		if err == nil {
+			err = fmt.Errorf("result %s not found", resultID)
+		}
+		h.log.Error(ErrGetResult(err))
+		http.Error(rw, ErrGetResult(err).Error(), http.StatusNotFound)
+		return
+	}
+
+	var profile *models.PerformanceProfile
+	if result.PerformanceProfile != nil {
+		profile = h.getPerformanceProfile(r, provider, result.PerformanceProfile.String())
+	}
+	h.writePerformanceExport(rw, format, "result_"+resultID.String(), []*models.MesheryResult{result}, profile)
+}
+
+// swagger:route GET /api/user/performance/profiles/{id}/results/export PerformanceAPI idExportProfileResults
+// Handle GET request for exporting the results of a profile
+//
+// Exports the results of the profile which started between the from and to query parameters, both
+// optional dates or RFC 3339 times, in the format given by the format query parameter: csv, jsonl,
+// hdr or junit. The JUnit report checks the results against the SLO of the profile.
+// responses:
+// 	200: performanceExportResponseWrapper
+
+// ExportProfileResultsHandler exports the results of the performance profile with the given id
+func (h *Handler) ExportProfileResultsHandler(
+	rw http.ResponseWriter,
+	r *http.Request,
+	prefObj *models.Preference,
+	user *models.User,
+	provider models.Provider,
+) {
+	profileID := mux.Vars(r)["id"]
+	q := r.URL.Query()
+
+	format, err := exportFormat(r)
+	if err != nil {
+		h.log.Error(ErrExportPerformanceResults(err))
+		http.Error(rw, ErrExportPerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+	from, err := parseExportTime(q.Get("from"), false)
+	if err != nil {
+		h.log.Error(ErrExportPerformanceResults(err))
+		http.Error(rw, ErrExportPerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+	to, err := parseExportTime(q.Get("to"), true)
+	if err != nil {
+		h.log.Error(ErrExportPerformanceResults(err))
+		http.Error(rw, ErrExportPerformanceResults(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	token, err := provider.GetProviderToken(r)
+	if err != nil {
+		h.log.Error(ErrRetrieveUserToken(err))
+		http.Error(rw, ErrRetrieveUserToken(err).Error(), http.StatusInternalServerError)
+		return
+	}
+
+	results := []*models.MesheryResult{}
+	for page := 0; ; page++ {
+		bd, err := provider.FetchResults(token, strconv.Itoa(page), strconv.Itoa(exportPageSize), "", "", profileID)
+		if err != nil {
+			h.log.Error(ErrGetResult(err))
+			http.Error(rw, ErrGetResult(err).Error(), http.StatusInternalServerError)
+			return
+		}
+		resultPage := &models.MesheryResultPage{}
+		if err := json.Unmarshal(bd, resultPage); err != nil {
+			h.log.Error(ErrUnmarshal(err, "performance results"))
+			http.Error(rw, ErrUnmarshal(err, "performance results").Error(), http.StatusInternalServerError)
+			return
+		}
+
+		for _, result := range resultPage.Results {
+			if result.TestStartTime == nil ||
+				(!from.IsZero() && result.TestStartTime.Before(from)) ||
+				(!to.IsZero() && result.TestStartTime.After(to)) {
+				continue
+			}
+			results = append(results, result)
+		}
+		if len(resultPage.Results) < exportPageSize || (page+1)*exportPageSize >= resultPage.TotalCount {
+			break
+		}
+	}
+
+	h.writePerformanceExport(rw, format, "profile_"+profileID+"_results", results, h.getPerformanceProfile(r, provider, profileID))
+}
+
+// writePerformanceExport writes the results exported in the format as a file download
+func (h *Handler) writePerformanceExport(rw http.ResponseWriter, format models.PerformanceExportFormat, name string, results []*models.MesheryResult, profile *models.PerformanceProfile) {
+	rw.Header().Set("Content-Type", format.ContentType())
+	rw.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format.Extension()))
+	if err := models.ExportPerformanceResults(rw, format, results, profile); err != nil {
+		h.log.Error(ErrExportPerformanceResults(err))
+		http.Error(rw, ErrExportPerformanceResults(err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// exportFormat returns the format results are exported in, csv by default
+func exportFormat(r *http.Request) (models.PerformanceExportFormat, error) {
+	format := models.PerformanceExportFormat(strings.ToLower(r.URL.Query().Get("format")))
+	if format == "" {
+		return models.PerformanceExportCSV, nil
+	}
+	if !format.IsValid() {
+		return "", fmt.Errorf("unsupported export format %q, supported formats are %v", format, models.PerformanceExportFormats)
+	}
+
+	return format, nil
+}
+
+// parseExportTime parses a bound of the range of the exported results, given as a date or
+// a RFC 3339 time. A date bounding the end of the range includes the whole day.
+func parseExportTime(s string, end bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected a date like 2006-01-02 or a RFC 3339 time", s)
+	}
+	if end {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+
+	return t, nil
+}
@@ -37,6 +37,38 @@ func (h *Handler) SavePerformanceProfileHandler(
 		return
 	}
 
+	if parsedBody.SLO != nil {
+		if err := parsedBody.SLO.Validate(); err != nil {
+			h.log.Error(ErrRequestBody(err))
+			http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	if parsedBody.RegressionThreshold < 0 {
+		err := fmt.Errorf("the regression threshold can't be negative")
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if parsedBody.Workers < 0 || parsedBody.PayloadSize < 0 {
+		err := fmt.Errorf("the number of load test workers and the payload size can't be negative")
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := parsedBody.Scenario.Validate(); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err := parsedBody.Stages.Validate(); err != nil {
+		h.log.Error(ErrRequestBody(err))
+		http.Error(rw, ErrRequestBody(err).Error(), http.StatusBadRequest)
+		return
+	}
+
 	j, _ := json.Marshal(parsedBody)
 	h.log.Info("performance profile is ", string(j))
 
@@ -0,0 +1,237 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// routeRole is the role required to send requests with the method, or any method
+// if it is empty, to the route with the path template
+type routeRole struct {
+	method string
+	path   string
+	role   models.UserRole
+}
+
+// routeRoles are the exceptions to the default roles of the routes, viewers
+// can send GET requests and operators can send requests of any method
+var routeRoles = []routeRole{
+	// every user keeps their own preferences
+	{http.MethodPost, "/api/user/prefs", models.RoleViewer},
+	// the role required by graphql mutations is checked by the graphql server
+	{http.MethodPost, "/api/system/graphql/query", models.RoleViewer},
+
+	// load tests are started by GET requests
+	{http.MethodGet, "/api/perf/profile", models.RoleOperator},
+	{http.MethodGet, "/api/user/performance/profiles/{id}/run", models.RoleOperator},
+
+	// every user manages their own API tokens
+	{http.MethodPost, "/api/user/tokens", models.RoleViewer},
+	{http.MethodDelete, "/api/user/tokens/{id}", models.RoleViewer},
+
+	{"", "/api/users", models.RoleAdmin},
+	{"", "/api/users/{id}", models.RoleAdmin},
+	{http.MethodPost, "/api/system/kubernetes", models.RoleAdmin},
+	{http.MethodPost, "/api/system/kubernetes/contexts", models.RoleAdmin},
+	{http.MethodDelete, "/api/system/kubernetes/contexts/{id}", models.RoleAdmin},
+	{http.MethodPost, "/api/system/adapter/manage", models.RoleAdmin},
+	{http.MethodDelete, "/api/system/adapter/manage", models.RoleAdmin},
+	// the audit log holds the actions of every user
+	{"", "/api/system/audit", models.RoleAdmin},
+	{"", "/api/system/audit/verify", models.RoleAdmin},
+}
+
+// routeScopes are the routes the scoped API tokens need a scope for, scoped tokens
+// can send GET requests and graphql queries to the other routes and nothing else
+var routeScopes = []struct {
+	method string
+	path   string
+	scope  models.APITokenScope
+}{
+	{http.MethodPost, "/api/pattern/deploy", models.ScopePatternDeploy},
+	{http.MethodDelete, "/api/pattern/deploy", models.ScopePatternDeploy},
+	{http.MethodPost, "/api/pattern/deployments/{id}/reconcile", models.ScopePatternDeploy},
+
+	{http.MethodGet, "/api/perf/profile", models.ScopePerfRun},
+	{http.MethodPost, "/api/perf/profile", models.ScopePerfRun},
+	{http.MethodDelete, "/api/perf/run/{uuid}", models.ScopePerfRun},
+	{http.MethodPost, "/api/user/performance/profiles", models.ScopePerfRun},
+	{http.MethodGet, "/api/user/performance/profiles/{id}/run", models.ScopePerfRun},
+	{http.MethodPost, "/api/user/performance/profiles/{id}/overhead", models.ScopePerfRun},
+}
+
+// routePath returns the path template of the route of the request
+func routePath(req *http.Request) string {
+	if route := mux.CurrentRoute(req); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+
+	return req.URL.Path
+}
+
+// readRoutes are the routes which only read despite their method
+var readRoutes = []struct {
+	method string
+	path   string
+}{
+	{http.MethodPost, "/api/meshmodel/validate"},
+}
+
+// isReadRequest returns true if the request only reads
+func isReadRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	path := routePath(req)
+	for _, r := range readRoutes {
+		if r.method == req.Method && r.path == path {
+			return true
+		}
+	}
+	return false
+}
+
+// isMutatingRequest returns true if the request changes the state of Meshery or of
+// the clusters, which includes the load tests started by GET requests
+func isMutatingRequest(req *http.Request) bool {
+	return !isReadRequest(req) || requiredRole(req) == models.RoleOperator
+}
+
+// requiredRole returns the role required for the request on its route
+func requiredRole(req *http.Request) models.UserRole {
+	path := routePath(req)
+	for _, r := range routeRoles {
+		if r.path == path && (r.method == "" || r.method == req.Method) {
+			return r.role
+		}
+	}
+
+	if isReadRequest(req) {
+		return models.RoleViewer
+	}
+	return models.RoleOperator
+}
+
+// requiredScope returns the scope a scoped API token needs for the request, the
+// second value is false if the route needs no scope
+func requiredScope(req *http.Request) (models.APITokenScope, bool) {
+	path := routePath(req)
+	for _, r := range routeScopes {
+		if r.path == path && r.method == req.Method {
+			return r.scope, true
+		}
+	}
+
+	return "", false
+}
+
+// authorize checks that the role of the user allows the request and responds
+// with 403 otherwise. The users of remote providers have no role, their
+// provider decides what they are allowed to do
+func (h *Handler) authorize(w http.ResponseWriter, req *http.Request, user *models.User) bool {
+	if user == nil || user.Role == "" {
+		return true
+	}
+
+	required := requiredRole(req)
+	if !models.UserRole(user.Role).Allows(required) {
+		err := ErrForbidden(user.Role, string(required))
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// authorizeAPIToken checks that the scopes and the kubernetes contexts of the API token
+// the request was authenticated with allow the request and responds with 403 otherwise
+func (h *Handler) authorizeAPIToken(w http.ResponseWriter, req *http.Request) bool {
+	token := models.APITokenFromContext(req.Context())
+	if token == nil {
+		return true
+	}
+
+	path := routePath(req)
+	var err error
+	if scope, ok := requiredScope(req); ok && !token.HasScope(scope) {
+		err = fmt.Errorf("the request requires the %s scope", scope)
+	} else if !ok && token.IsScoped() && !isReadRequest(req) && path != "/api/system/graphql/query" {
+		err = fmt.Errorf("the request requires a token without scopes")
+	}
+
+	if err == nil && len(token.Contexts) > 0 {
+		switch {
+		case strings.HasPrefix(path, "/api/system/graphql"):
+			err = fmt.Errorf("tokens restricted to kubernetes contexts can't use the graphql API")
+		case path == "/api/system/kubernetes/contexts/{id}" && !token.AllowsContext(mux.Vars(req)["id"]):
+			err = fmt.Errorf("the kubernetes context %s is not in the allow-list of the token", mux.Vars(req)["id"])
+		case req.Method == http.MethodPost && (path == "/api/system/kubernetes" || path == "/api/system/kubernetes/contexts"):
+			err = fmt.Errorf("tokens restricted to kubernetes contexts can't add contexts")
+		}
+	}
+
+	if err != nil {
+		err = ErrAPITokenForbidden(err)
+		h.log.Error(err)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// checkTokenContexts checks that the API token of the request, if any, allows every kubernetes
+// context the request binds to a background run, like a schedule or a sync source
+func checkTokenContexts(req *http.Request, ids []string) error {
+	token := models.APITokenFromContext(req.Context())
+	if token == nil {
+		return nil
+	}
+	for _, id := range ids {
+		if !token.AllowsContext(id) {
+			return ErrAPITokenForbidden(fmt.Errorf("the kubernetes context %s is not in the allow-list of the token", id))
+		}
+	}
+
+	return nil
+}
+
+// withAPIToken authenticates the request with the API token, the token is added to the
+// context of the request and, for remote providers, the session token of the user is
+// injected so that the provider authenticates the request as usual
+func (h *Handler) withAPIToken(req *http.Request, provider models.Provider, secret string) (*http.Request, error) {
+	if h.config.APITokenPersister == nil {
+		return nil, models.ErrAPIToken(fmt.Errorf("API tokens are not enabled"))
+	}
+
+	token, err := h.config.APITokenPersister.GetAPITokenBySecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	if token.Provider != provider.Name() {
+		return nil, models.ErrAPIToken(fmt.Errorf("the token was issued under the %s provider", token.Provider))
+	}
+
+	req = req.WithContext(context.WithValue(req.Context(), models.APITokenCtxKey, token))
+	if provider.GetProviderType() == models.RemoteProviderType {
+		providerToken, err := token.OpenProviderToken(secret)
+		if err != nil || providerToken == "" {
+			return nil, models.ErrAPIToken(fmt.Errorf("the token holds no session of the remote provider"))
+		}
+		req.Header = req.Header.Clone()
+		req.Header.Del("Authorization")
+		models.InjectProviderToken(req, providerToken)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func TestRequiredRole(t *testing.T) {
+	var required models.UserRole
+	record := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		required = requiredRole(req)
+	})
+
+	router := mux.NewRouter()
+	for _, path := range []string{"/api/pattern/deploy", "/api/pattern/{id}", "/api/user/prefs", "/api/system/kubernetes/contexts/{id}", "/api/system/adapter/operation", "/api/system/adapter/manage", "/api/users/{id}", "/api/user/performance/profiles/{id}/run", "/api/meshmodel/validate", "/api/meshmodel/component/generate"} {
+		router.Handle(path, record)
+	}
+
+	for _, tc := range []struct {
+		method, path string
+		role         models.UserRole
+	}{
+		{http.MethodGet, "/api/pattern/1234", models.RoleViewer},
+		{http.MethodDelete, "/api/pattern/1234", models.RoleOperator},
+		{http.MethodPost, "/api/pattern/deploy", models.RoleOperator},
+		{http.MethodPost, "/api/user/prefs", models.RoleViewer},
+		{http.MethodPost, "/api/system/adapter/operation", models.RoleOperator},
+		{http.MethodDelete, "/api/system/adapter/manage", models.RoleAdmin},
+		{http.MethodGet, "/api/system/kubernetes/contexts/1234", models.RoleViewer},
+		{http.MethodDelete, "/api/system/kubernetes/contexts/1234", models.RoleAdmin},
+		{http.MethodGet, "/api/users/1234", models.RoleAdmin},
+		{http.MethodGet, "/api/user/performance/profiles/1234/run", models.RoleOperator},
+		{http.MethodPost, "/api/meshmodel/validate", models.RoleViewer},
+		{http.MethodPost, "/api/meshmodel/component/generate", models.RoleOperator},
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.method, tc.path, nil))
+		if required != tc.role {
+			t.Errorf("%s %s requires %s, want %s", tc.method, tc.path, required, tc.role)
+		}
+	}
+}
+
+func TestAuthorizeAuditLog(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{log: log}
+
+	var user *models.User
+	router := mux.NewRouter()
+	for _, path := range []string{"/api/system/audit", "/api/system/audit/verify"} {
+		router.Handle(path, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if h.authorize(w, req, user) {
+				w.WriteHeader(http.StatusOK)
+			}
+		}))
+	}
+
+	for _, tc := range []struct {
+		role   models.UserRole
+		path   string
+		status int
+	}{
+		{models.RoleViewer, "/api/system/audit", http.StatusForbidden},
+		{models.RoleOperator, "/api/system/audit?format=jsonl", http.StatusForbidden},
+		{models.RoleViewer, "/api/system/audit/verify", http.StatusForbidden},
+		{models.RoleAdmin, "/api/system/audit", http.StatusOK},
+		{models.RoleAdmin, "/api/system/audit/verify", http.StatusOK},
+	} {
+		user = &models.User{UserID: "alice", Role: string(tc.role)}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+		if rec.Code != tc.status {
+			t.Errorf("%s GET %s: status %d, want %d", tc.role, tc.path, rec.Code, tc.status)
+		}
+	}
+}
+
+func TestAuthorizeAPIToken(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{log: log}
+
+	var allowed bool
+	var token *models.APIToken
+	record := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		req = req.WithContext(context.WithValue(req.Context(), models.APITokenCtxKey, token))
+		allowed = h.authorizeAPIToken(w, req)
+	})
+
+	router := mux.NewRouter()
+	for _, path := range []string{"/api/pattern/deploy", "/api/pattern/{id}", "/api/perf/profile", "/api/system/graphql/query", "/api/system/kubernetes/contexts/{id}"} {
+		router.Handle(path, record)
+	}
+
+	deploy := &models.APIToken{Scopes: []string{string(models.ScopePatternDeploy)}, Contexts: []string{"a"}}
+	readOnly := &models.APIToken{Scopes: []string{string(models.ScopeReadOnly)}}
+	for _, tc := range []struct {
+		token        *models.APIToken
+		method, path string
+		allowed      bool
+	}{
+		{deploy, http.MethodPost, "/api/pattern/deploy", true},
+		{deploy, http.MethodGet, "/api/perf/profile", false},
+		{deploy, http.MethodDelete, "/api/pattern/1234", false},
+		{deploy, http.MethodGet, "/api/system/kubernetes/contexts/a", true},
+		{deploy, http.MethodGet, "/api/system/kubernetes/contexts/b", false},
+		{deploy, http.MethodPost, "/api/system/graphql/query", false},
+		{readOnly, http.MethodGet, "/api/pattern/1234", true},
+		{readOnly, http.MethodPost, "/api/system/graphql/query", true},
+		{readOnly, http.MethodPost, "/api/pattern/deploy", false},
+		{&models.APIToken{}, http.MethodDelete, "/api/pattern/1234", true},
+	} {
+		token = tc.token
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.method, tc.path, nil))
+		if allowed != tc.allowed {
+			t.Errorf("%s %s with scopes %v is allowed: %t, want %t", tc.method, tc.path, tc.token.Scopes, allowed, tc.allowed)
+		}
+	}
+}
+
+func TestCheckTokenContexts(t *testing.T) {
+	request := func(token *models.APIToken) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/pattern/sync", nil)
+		if token == nil {
+			return req
+		}
+		return req.WithContext(context.WithValue(req.Context(), models.APITokenCtxKey, token))
+	}
+
+	restricted := &models.APIToken{Contexts: []string{"a", "b"}}
+	for _, tc := range []struct {
+		token   *models.APIToken
+		ids     []string
+		allowed bool
+	}{
+		{nil, []string{"c"}, true},
+		{&models.APIToken{}, []string{"c"}, true},
+		{restricted, []string{"a", "b"}, true},
+		{restricted, []string{"a", "c"}, false},
+		{restricted, nil, true},
+	} {
+		if err := checkTokenContexts(request(tc.token), tc.ids); (err == nil) != tc.allowed {
+			t.Errorf("contexts %v with the token %+v allowed: %t, want %t", tc.ids, tc.token, err == nil, tc.allowed)
+		}
+	}
+}
@@ -47,6 +47,18 @@ func (h *Handler) SaveScheduleHandler(
 		return
 	}
 
+	if err := checkTokenContexts(r, parsedBody.ContextIDs); err != nil {
+		h.log.Error(err)
+		http.Error(rw, err.Error(), http.StatusForbidden)
+		return
+	}
+	// the runs of a schedule saved with an API token stay in the allow-list of the token
+	if apiToken := models.APITokenFromContext(r.Context()); apiToken != nil && len(parsedBody.ContextIDs) == 0 {
+		parsedBody.ContextIDs = apiToken.Contexts
+	}
+
+	// the scheduled runs are run as the user who created the schedule
+	parsedBody.UserID = user.UserID
 	resp, err := provider.SaveSchedule(token, parsedBody)
 	if err != nil {
 		obj := "schedule"
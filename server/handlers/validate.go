@@ -9,6 +9,7 @@ import (
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/cuecontext"
 	"cuelang.org/go/cue/errors"
+	"github.com/layer5io/meshery/server/models"
 	"github.com/layer5io/meshkit/utils"
 )
 
@@ -50,7 +51,7 @@ type jsonSchemaValidationType struct {
 // request body should be json
 // request body should be of format - {validationItems: {[id]:{schema: string, value: string, valueType: "JSON"|"YAML"|"CUE"}}}
 // response format - {[id]: {isValid: bool, error: string}}
-func (h *Handler) ValidationHandler(rw http.ResponseWriter, r *http.Request) {
+func (h *Handler) ValidationHandler(rw http.ResponseWriter, r *http.Request, _ *models.Preference, _ *models.User, _ models.Provider) {
 	// Parse the request body
 	body, err := io.ReadAll(r.Body)
 	if err != nil {
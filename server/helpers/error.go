@@ -30,6 +30,7 @@ const (
 	ErrNewKubeClientGeneratorCode          = "2199"
 	ErrRestConfigFromKubeConfigCode        = "2200"
 	ErrNewKubeClientCode                   = "2201"
+	ErrLoadTestWorkerCode                  = "2270"
 )
 
 func ErrNewDynamicClientGenerator(err error) error {
@@ -135,3 +136,7 @@ func ErrStartingNighthawkServer(err error) error {
 func ErrNewKubeClient(err error) error {
 	return errors.New(ErrNewKubeClientCode, errors.Alert, []string{"Unable to create new kube client"}, []string{err.Error()}, []string{}, []string{})
 }
+
+func ErrLoadTestWorker(err error, worker string) error {
+	return errors.New(ErrLoadTestWorkerCode, errors.Alert, []string{"Unable to run the load test on the worker ", worker}, []string{err.Error()}, []string{"The load test worker might not be reachable from the Meshery server", "The worker token might not match the one of the worker"}, []string{"Make sure the load test worker is running and reachable from the Meshery server", "Make sure LOAD_TEST_WORKER_TOKEN is the same for the Meshery server and its workers"})
+}
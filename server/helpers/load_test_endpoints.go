@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"math"
+	"net/http"
+	"sync"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/sirupsen/logrus"
+)
+
+// LoadTest runs the load test with the load generator of the options, on the load test
+// workers of the options if any
+func LoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if len(opts.Workers) > 0 {
+		return distributedLoadTest(opts, http.DefaultClient)
+	}
+
+	switch opts.LoadGenerator {
+	case models.Wrk2LG:
+		return WRK2LoadTest(opts)
+	case models.NighthawkLG:
+		return NighthawkLoadTest(opts)
+	default:
+		return FortioLoadTest(opts)
+	}
+}
+
+// endpointsLoadTest drives all the endpoints of the load test at once, run is given the
+// options of each endpoint with the load generator of the endpoint. The results of each
+// endpoint are stored under models.LoadTestEndpointsKey along with the results aggregated
+// over all of them.
+func endpointsLoadTest(opts *models.LoadTestOptions, run loadTestFunc) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if err := opts.Endpoints.Validate(); err != nil {
+		return nil, nil, ErrGeneratingLoadTest(err)
+	}
+
+	totalWeight := opts.Endpoints.TotalWeight()
+	runs := make([]map[string]interface{}, len(opts.Endpoints))
+	errs := make([]error, len(opts.Endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range opts.Endpoints {
+		wg.Add(1)
+		go func(i int, endpoint models.LoadTestEndpoint) {
+			defer wg.Done()
+			logrus.Infof("driving the endpoint %s of the load test %s", endpoint.URL, opts.Name)
+			runs[i], _, errs[i] = run(endpointOptions(opts, endpoint, endpoint.GetWeight()/totalWeight))
+		}(i, endpoint)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	endpoints := []map[string]interface{}{}
+	for i, endpoint := range opts.Endpoints {
+		r := runs[i]
+		res := map[string]interface{}{
+			// the request of the endpoint is left out as it can carry credentials
+			"Endpoint": map[string]interface{}{
+				"URL":           endpoint.URL,
+				"Method":        endpoint.Method,
+				"Weight":        endpoint.GetWeight(),
+				"LoadGenerator": endpointOptions(opts, endpoint, 1).LoadGenerator,
+			},
+			"RequestedQPS":      r["RequestedQPS"],
+			"ActualQPS":         r["ActualQPS"],
+			"ActualDuration":    r["ActualDuration"],
+			"NumThreads":        r["NumThreads"],
+			"DurationHistogram": r["DurationHistogram"],
+			"RetCodes":          r["RetCodes"],
+		}
+		if stages, ok := r[models.LoadTestStagesKey]; ok {
+			res[models.LoadTestStagesKey] = stages
+		}
+		endpoints = append(endpoints, res)
+	}
+
+	requestedDuration, _ := runs[0]["RequestedDuration"].(string)
+	resultsMap, err := mergeRunnerResults(runs, requestedDuration, true)
+	if err != nil {
+		return nil, nil, ErrConvertingResultToMap(err)
+	}
+	delete(resultsMap, models.LoadTestStagesKey)
+	resultsMap[models.LoadTestEndpointsKey] = endpoints
+
+	result, err := runnerResults(resultsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultsMap, result, nil
+}
+
+// endpointOptions returns the options driving the endpoint with the given share of the load
+func endpointOptions(opts *models.LoadTestOptions, endpoint models.LoadTestEndpoint, share float64) *models.LoadTestOptions {
+	o := *opts
+	o.Endpoints = nil
+	o.URL = endpoint.URL
+	o.Method = endpoint.Method
+	o.Headers = nil
+	if len(endpoint.Headers) > 0 {
+		headers := endpoint.Headers
+		o.Headers = &headers
+	}
+	o.Cookies = nil
+	if len(endpoint.Cookies) > 0 {
+		cookies := endpoint.Cookies
+		o.Cookies = &cookies
+	}
+	o.Body = []byte(endpoint.Body)
+	o.ContentType = endpoint.ContentType
+	if endpoint.LoadGenerator != "" {
+		o.LoadGenerator = endpoint.LoadGenerator
+	}
+
+	o.HTTPQPS = opts.HTTPQPS * share
+	if endpoint.QPS > 0 {
+		o.HTTPQPS = endpoint.QPS
+	}
+	o.HTTPNumThreads = scaleConcurrency(opts.HTTPNumThreads, share)
+	if endpoint.Concurrency > 0 {
+		o.HTTPNumThreads = endpoint.Concurrency
+	}
+
+	o.Stages = scaleStages(opts.Stages, share)
+
+	return &o
+}
+
+// scaleStages returns the stages generating the given share of their load
+func scaleStages(stages models.LoadTestStages, share float64) models.LoadTestStages {
+	if len(stages) == 0 {
+		return stages
+	}
+
+	scaled := make(models.LoadTestStages, 0, len(stages))
+	for _, stage := range stages {
+		stage.QPS *= share
+		if stage.TargetQPS != nil {
+			target := *stage.TargetQPS * share
+			stage.TargetQPS = &target
+		}
+		if stage.Concurrency > 0 {
+			stage.Concurrency = scaleConcurrency(stage.Concurrency, share)
+		}
+		scaled = append(scaled, stage)
+	}
+
+	return scaled
+}
+
+func scaleConcurrency(concurrency int, share float64) int {
+	c := int(math.Round(float64(concurrency) * share))
+	if c < 1 {
+		return 1
+	}
+
+	return c
+}
@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/server/models"
+)
+
+func TestEndpointOptions(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		URL:            "http://productpage:9080/",
+		HTTPQPS:        100,
+		HTTPNumThreads: 8,
+		LoadGenerator:  models.FortioLG,
+		Body:           []byte("shared"),
+		Stages:         models.LoadTestStages{{Duration: "10s", QPS: 40, TargetQPS: targetQPS(80), Concurrency: 4}},
+	}
+
+	o := endpointOptions(opts, models.LoadTestEndpoint{
+		URL:     "http://reviews:9080/reviews/1",
+		Method:  "PUT",
+		Headers: map[string]string{"x-user": "jason"},
+		Body:    `{"rating": 5}`,
+	}, 0.25)
+	if o.URL != "http://reviews:9080/reviews/1" || o.Method != "PUT" || string(o.Body) != `{"rating": 5}` || (*o.Headers)["x-user"] != "jason" {
+		t.Errorf("endpointOptions() request = %+v", o)
+	}
+	if o.HTTPQPS != 25 || o.HTTPNumThreads != 2 || o.Endpoints != nil {
+		t.Errorf("endpointOptions() load = %v qps with %d threads, want 25 qps with 2 threads", o.HTTPQPS, o.HTTPNumThreads)
+	}
+	if s := o.Stages[0]; s.QPS != 10 || *s.TargetQPS != 20 || s.Concurrency != 1 {
+		t.Errorf("endpointOptions() stage = %+v, want a quarter of the stage", s)
+	}
+	if opts.Stages[0].QPS != 40 {
+		t.Errorf("endpointOptions() modified the stages of the test")
+	}
+
+	o = endpointOptions(opts, models.LoadTestEndpoint{URL: "grpc://ratings:9080", QPS: 7, Concurrency: 3, LoadGenerator: models.NighthawkLG}, 0.5)
+	if o.HTTPQPS != 7 || o.HTTPNumThreads != 3 || o.LoadGenerator != models.NighthawkLG || len(o.Body) != 0 {
+		t.Errorf("endpointOptions() with the load of the endpoint = %+v", o)
+	}
+}
+
+func TestEndpointsLoadTest(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		HTTPQPS:        40,
+		HTTPNumThreads: 4,
+		Duration:       10 * time.Second,
+		LoadGenerator:  models.FortioLG,
+		Endpoints: models.LoadTestEndpoints{
+			{URL: "http://productpage:9080/", Weight: 3},
+			{URL: "http://reviews:9080/", LoadGenerator: models.Wrk2LG},
+		},
+	}
+
+	generators := map[string]models.LoadGenerator{}
+	resultsMap, result, err := endpointsLoadTest(opts, func(o *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+		generators[o.URL] = o.LoadGenerator
+		return fakeLoadTest(o)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if generators["http://productpage:9080/"] != models.FortioLG || generators["http://reviews:9080/"] != models.Wrk2LG {
+		t.Errorf("endpointsLoadTest() ran the endpoints with %v", generators)
+	}
+
+	// 30 and 10 qps for 10s at once
+	if result.DurationHistogram.Count != 400 || result.ActualDuration != 10*time.Second || result.ActualQPS != 40 {
+		t.Errorf("endpointsLoadTest() result = %d requests over %s at %v qps, want 400 requests over 10s at 40 qps",
+			result.DurationHistogram.Count, result.ActualDuration, result.ActualQPS)
+	}
+	if result.NumThreads != 4 {
+		t.Errorf("endpointsLoadTest() threads = %d, want 4", result.NumThreads)
+	}
+
+	endpoints, _ := resultsMap[models.LoadTestEndpointsKey].([]map[string]interface{})
+	if len(endpoints) != 2 {
+		t.Fatalf("endpointsLoadTest() returned %d endpoint results, want 2", len(endpoints))
+	}
+	if endpoints[0]["ActualQPS"] != 30.0 || endpoints[1]["ActualQPS"] != 10.0 {
+		t.Errorf("endpointsLoadTest() endpoint qps = %v and %v, want 30 and 10", endpoints[0]["ActualQPS"], endpoints[1]["ActualQPS"])
+	}
+}
+
+func TestEndpointsLoadTestInvalidEndpoint(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		Endpoints: models.LoadTestEndpoints{{URL: "productpage"}},
+	}
+	if _, _, err := endpointsLoadTest(opts, fakeLoadTest); err == nil {
+		t.Error("endpointsLoadTest() expected an error for a relative url")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"os/exec"
@@ -14,6 +15,7 @@ import (
 
 	"fortio.org/fortio/fgrpc"
 	"fortio.org/fortio/fhttp"
+	"fortio.org/fortio/fnet"
 	"fortio.org/fortio/periodic"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/layer5io/gowrk2/api"
@@ -35,7 +37,16 @@ var (
 
 // FortioLoadTest is the actual code which invokes Fortio to run the load test
 func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
-	defaults := &periodic.DefaultRunnerOptions
+	if len(opts.Endpoints) > 0 {
+		return endpointsLoadTest(opts, LoadTest)
+	}
+	if len(opts.Stages) > 0 {
+		return stagedLoadTest(opts, FortioLoadTest)
+	}
+	if method := loadTestMethod(opts); method == models.TCP || method == models.UDP {
+		return fortioSocketLoadTest(opts, method)
+	}
+
 	httpOpts, err := sharedHTTPOptions(opts)
 	if err != nil {
 		return nil, nil, ErrGeneratingLoadTest(err)
@@ -43,23 +54,13 @@ func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *peri
 	if opts.IsInsecure {
 		httpOpts.Insecure = true
 	}
-	rURL := httpOpts.URL
-	out := os.Stdout
-	qps := opts.HTTPQPS // TODO possibly use translated <=0 to "max" from results/options normalization in periodic/
-	if qps <= 0 {
-		qps = -1 // 0==unitialized struct == default duration, -1 (0 for flag) is max
-	}
-	labels := opts.Name + " -_- " + rURL
-	ro := periodic.RunnerOptions{
-		QPS:         qps,
-		Duration:    opts.Duration,
-		NumThreads:  opts.HTTPNumThreads,
-		Percentiles: []float64{50, 75, 90, 99, 99.9},
-		Resolution:  defaults.Resolution,
-		Out:         out,
-		Labels:      labels,
-		Exactly:     0,
+	// fortio implies the method of the requests from the body
+	if method := strings.ToUpper(opts.Method); method != "" && method != httpOpts.Method() {
+		return nil, nil, ErrGeneratingLoadTest(fmt.Errorf("fortio sends GET requests without a body and POST requests with one, %s requests can be sent with nighthawk", method))
 	}
+	rURL := httpOpts.URL
+	ro, release := fortioRunnerOptions(opts, rURL)
+	defer release()
 	var res periodic.HasRunnerResult
 	if opts.SupportedLoadTestMethods == 2 {
 		o := fgrpc.GRPCRunnerOptions{
@@ -116,10 +117,23 @@ func FortioLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *peri
 
 // WRK2LoadTest is the actual code which invokes Wrk2 to run the load test
 func WRK2LoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if len(opts.Endpoints) > 0 {
+		return endpointsLoadTest(opts, LoadTest)
+	}
+	if len(opts.Stages) > 0 {
+		return stagedLoadTest(opts, WRK2LoadTest)
+	}
+	if method := loadTestMethod(opts); method == models.TCP || method == models.UDP {
+		return nil, nil, ErrGeneratingLoadTest(fmt.Errorf("%s can't run TCP and UDP load tests, they can be run with fortio", opts.LoadGenerator))
+	}
+
 	qps := opts.HTTPQPS // TODO possibly use translated <=0 to "max" from results/options normalization in periodic/
 	if qps <= 0 {
 		qps = -1 // 0==unitialized struct == default duration, -1 (0 for flag) is max
 	}
+	if method := strings.ToUpper(opts.Method); method != "" && method != http.MethodGet {
+		return nil, nil, ErrGeneratingLoadTest(fmt.Errorf("wrk2 sends GET requests only, %s requests can be sent with nighthawk", method))
+	}
 	rURL := strings.TrimLeft(opts.URL, " \t\r\n")
 
 	labels := opts.Name + " -_- " + rURL
@@ -226,6 +240,16 @@ func startNighthawkServer(timeout int64) error {
 
 // NighthawkLoadTest is the actual code which invokes nighthawk to run the load test
 func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if len(opts.Endpoints) > 0 {
+		return endpointsLoadTest(opts, LoadTest)
+	}
+	if len(opts.Stages) > 0 {
+		return stagedLoadTest(opts, NighthawkLoadTest)
+	}
+	if method := loadTestMethod(opts); method == models.TCP || method == models.UDP {
+		return nil, nil, ErrGeneratingLoadTest(fmt.Errorf("%s can't run TCP and UDP load tests, they can be run with fortio", opts.LoadGenerator))
+	}
+
 	err := startNighthawkServer(int64(opts.Duration))
 	if err != nil {
 		return nil, nil, ErrRunningNighthawkServer(err)
@@ -302,6 +326,9 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 		requestOptions.RequestBodySize = &wrappers.UInt32Value{Value: uint32(0)}
 		requestOptions.RequestMethod = v3.RequestMethod_GET
 	}
+	if method, ok := v3.RequestMethod_value[strings.ToUpper(opts.Method)]; ok && opts.Method != "" {
+		requestOptions.RequestMethod = v3.RequestMethod(method)
+	}
 
 	ro := &nighthawk_proto.CommandLineOptions{
 		OneofDurationOptions: &nighthawk_proto.CommandLineOptions_Duration{
@@ -378,7 +405,7 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 
 	logrus.Info("starting test")
 
-	client, err := c.Handler.ExecutionStream(context.TODO())
+	client, err := c.Handler.ExecutionStream(loadTestContext(opts))
 	if err != nil {
 		return nil, nil, ErrRunningTest(err)
 	}
@@ -441,6 +468,50 @@ func NighthawkLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *p
 	return resultsMap, result, nil
 }
 
+// fortioRunnerOptions returns the options of the fortio runner of the load test, the run
+// is aborted when the context of the load test is done until release is called
+func fortioRunnerOptions(opts *models.LoadTestOptions, rURL string) (ro periodic.RunnerOptions, release func()) {
+	qps := opts.HTTPQPS
+	if qps <= 0 {
+		qps = -1 // 0==unitialized struct == default duration, -1 (0 for flag) is max
+	}
+	labels := opts.Name + " -_- " + rURL
+	ro = periodic.RunnerOptions{
+		QPS:         qps,
+		Duration:    opts.Duration,
+		NumThreads:  opts.HTTPNumThreads,
+		Percentiles: []float64{50, 75, 90, 99, 99.9},
+		Resolution:  periodic.DefaultRunnerOptions.Resolution,
+		Out:         os.Stdout,
+		Labels:      labels,
+		Exactly:     0,
+		Stop:        periodic.NewAborter(),
+	}
+	if opts.Progress != nil {
+		ro.AccessLogger = opts.Progress
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-loadTestContext(opts).Done():
+			ro.Stop.Abort()
+		case <-done:
+		}
+	}()
+
+	return ro, func() { close(done) }
+}
+
+// loadTestContext returns the context of the load test
+func loadTestContext(opts *models.LoadTestOptions) context.Context {
+	if opts.Ctx == nil {
+		return context.Background()
+	}
+
+	return opts.Ctx
+}
+
 // sharedHTTPOptions is the flag->httpoptions transfer code shared between
 // fortio_main and fcurl.
 func sharedHTTPOptions(opts *models.LoadTestOptions) (*fhttp.HTTPOptions, error) {
@@ -478,9 +549,13 @@ func sharedHTTPOptions(opts *models.LoadTestOptions) (*fhttp.HTTPOptions, error)
 			return nil, ErrAddAndValidateExtraHeader(err)
 		}
 	}
+	httpOpts.DisableKeepAlive = opts.DisableConnectionReuse
 	if len(opts.Body) > 0 {
 		httpOpts.Payload = opts.Body
 	}
+	if opts.PayloadSize > 0 {
+		httpOpts.Payload = fnet.GenerateRandomPayload(opts.PayloadSize)
+	}
 	if len(opts.ContentType) > 0 {
 		httpOpts.ContentType = opts.ContentType
 	}
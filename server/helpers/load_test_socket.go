@@ -0,0 +1,134 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"fortio.org/fortio/fhttp"
+	"fortio.org/fortio/fnet"
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/tcprunner"
+	"fortio.org/fortio/udprunner"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/sirupsen/logrus"
+)
+
+// loadTestMethod returns the load test method of the options, implied by the url
+// of the load test when it isn't set
+func loadTestMethod(opts *models.LoadTestOptions) models.SupportedLoadTestMethods {
+	if opts.SupportedLoadTestMethods != 0 {
+		return opts.SupportedLoadTestMethods
+	}
+
+	return models.LoadTestMethodFromURL(opts.URL)
+}
+
+// fortioSocketLoadTest runs a TCP or UDP load test with fortio, the target is expected to
+// echo the payload of every request back. The payload is the body of the options, or a
+// random one of PayloadSize bytes, fortio sends a short unique payload when both are empty.
+func fortioSocketLoadTest(opts *models.LoadTestOptions, method models.SupportedLoadTestMethods) (map[string]interface{}, *periodic.RunnerResults, error) {
+	payload := opts.Body
+	if opts.PayloadSize > 0 {
+		payload = fnet.GenerateRandomPayload(opts.PayloadSize)
+	}
+	ro, release := fortioRunnerOptions(opts, opts.URL)
+	defer release()
+
+	var (
+		res periodic.HasRunnerResult
+		err error
+	)
+	switch method {
+	case models.UDP:
+		o := udprunner.RunnerOptions{RunnerOptions: ro}
+		o.Destination = opts.URL
+		o.Payload = payload
+		o.ReqTimeout = udprunner.UDPTimeOutDefaultValue
+		res, err = udprunner.RunUDPTest(&o)
+	case models.TCP:
+		o := tcprunner.RunnerOptions{RunnerOptions: ro}
+		o.Destination = opts.URL
+		o.Payload = payload
+		o.ReqTimeout = fhttp.HTTPReqTimeOutDefaultValue
+		if opts.DisableConnectionReuse {
+			res, err = runTCPTestWithoutReuse(&o)
+		} else {
+			res, err = tcprunner.RunTCPTest(&o)
+		}
+	default:
+		err = fmt.Errorf("load test method %d is neither TCP nor UDP", method)
+	}
+	if err != nil {
+		return nil, nil, ErrRunningTest(err)
+	}
+	logrus.Debugf("original version of the test: %+#v", res)
+
+	bd, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, ErrConvertingResultToMap(err)
+	}
+	resultsMap := map[string]interface{}{}
+	if err := json.Unmarshal(bd, &resultsMap); err != nil {
+		return nil, nil, ErrUnmarshal(err, "data to map")
+	}
+	// the payload can be as large as fnet.MaxPayloadSize, its size is kept instead
+	delete(resultsMap, "Payload")
+	resultsMap["PayloadSize"] = len(payload)
+
+	return resultsMap, res.Result(), nil
+}
+
+// tcpNewConnectionRunner sends every request of a thread on a new connection
+type tcpNewConnectionRunner struct {
+	client      *tcprunner.TCPClient
+	retCodes    tcprunner.TCPResultMap
+	socketCount int
+	bytesEchoed int64
+}
+
+func (r *tcpNewConnectionRunner) Run(t int) {
+	data, err := r.client.Fetch()
+	// closing the connection makes the next request open a new one
+	r.socketCount = r.client.Close()
+	if err != nil {
+		r.retCodes[err.Error()]++
+		return
+	}
+	r.retCodes[tcprunner.TCPStatusOK]++
+	r.bytesEchoed += int64(len(data))
+}
+
+// runTCPTestWithoutReuse runs a TCP load test like tcprunner.RunTCPTest but with a new
+// connection for every request. The bytes sent and received only count the requests
+// which were echoed back.
+func runTCPTestWithoutReuse(o *tcprunner.RunnerOptions) (*tcprunner.RunnerResults, error) {
+	o.RunType = "TCP"
+	logrus.Infof("starting tcp test for %s with %d threads at %.1f qps without connection reuse", o.Destination, o.NumThreads, o.QPS)
+	r := periodic.NewPeriodicRunner(&o.RunnerOptions)
+	defer r.Options().Abort()
+
+	runners := make([]*tcpNewConnectionRunner, r.Options().NumThreads)
+	for i := range runners {
+		client, err := tcprunner.NewTCPClient(&o.TCPOptions)
+		if client == nil {
+			return nil, fmt.Errorf("unable to create client %d for %s: %w", i, o.Destination, err)
+		}
+		runners[i] = &tcpNewConnectionRunner{client: client, retCodes: tcprunner.TCPResultMap{}}
+		r.Options().Runners[i] = runners[i]
+	}
+
+	total := &tcprunner.RunnerResults{RetCodes: tcprunner.TCPResultMap{}}
+	total.RunnerResults = r.Run()
+	total.Destination = o.Destination
+	for _, runner := range runners {
+		total.SocketCount += runner.socketCount
+		total.BytesSent += runner.bytesEchoed
+		total.BytesReceived += runner.bytesEchoed
+		for k, v := range runner.retCodes {
+			total.RetCodes[k] += v
+		}
+	}
+	r.Options().ReleaseRunners()
+
+	return total, nil
+}
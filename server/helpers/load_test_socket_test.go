@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"fortio.org/fortio/fnet"
+	"github.com/layer5io/meshery/server/models"
+)
+
+func TestFortioSocketLoadTest(t *testing.T) {
+	tcpAddr := fnet.TCPEchoServer("test-tcp-echo", "localhost:0")
+	udpAddr := fnet.UDPEchoServer("test-udp-echo", "localhost:0", false)
+
+	tests := []struct {
+		name        string
+		opts        *models.LoadTestOptions
+		wantRunType string
+		// wantSockets is the number of sockets used, -1 for one per request
+		wantSockets int
+	}{
+		{"tcp", &models.LoadTestOptions{URL: "tcp://" + tcpAddr.String(), PayloadSize: 128}, "TCP", 2},
+		{"tcp without connection reuse", &models.LoadTestOptions{URL: "tcp://" + tcpAddr.String(), DisableConnectionReuse: true}, "TCP", -1},
+		{"udp", &models.LoadTestOptions{URL: "udp://" + udpAddr.String(), Body: []byte("ping")}, "UDP", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.opts.Name = tt.name
+			tt.opts.HTTPQPS = 100
+			tt.opts.HTTPNumThreads = 2
+			tt.opts.Duration = 200 * time.Millisecond
+
+			resultsMap, result, err := FortioLoadTest(tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.RunType != tt.wantRunType || result.DurationHistogram.Count == 0 {
+				t.Fatalf("FortioLoadTest() result = %+v", result)
+			}
+
+			count := float64(result.DurationHistogram.Count)
+			retCodes, _ := resultsMap["RetCodes"].(map[string]interface{})
+			if retCodes["OK"] != count {
+				t.Errorf("FortioLoadTest() ret codes = %v, want %v OK", retCodes, count)
+			}
+			if _, ok := resultsMap["Payload"]; ok {
+				t.Error("FortioLoadTest() kept the payload in the results")
+			}
+
+			sockets, _ := resultsMap["SocketCount"].(float64)
+			wantSockets := float64(tt.wantSockets)
+			if tt.wantSockets < 0 {
+				wantSockets = count
+			}
+			if sockets != wantSockets {
+				t.Errorf("FortioLoadTest() used %v sockets, want %v", sockets, wantSockets)
+			}
+		})
+	}
+}
+
+func TestFortioLoadTestCancel(t *testing.T) {
+	tcpAddr := fnet.TCPEchoServer("test-tcp-echo-cancel", "localhost:0")
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := &models.LoadTestOptions{
+		Name:           "cancel",
+		URL:            "tcp://" + tcpAddr.String(),
+		HTTPQPS:        100,
+		HTTPNumThreads: 2,
+		Duration:       time.Minute,
+		Ctx:            ctx,
+		Progress:       models.NewLoadTestProgressRecorder(),
+	}
+	time.AfterFunc(300*time.Millisecond, cancel)
+
+	start := time.Now()
+	if _, _, err := FortioLoadTest(opts); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("FortioLoadTest() ran for %s once cancelled", d)
+	}
+	if s := opts.Progress.Snapshot(opts.Name); s.Requests == 0 {
+		t.Errorf("progress snapshot = %+v, want the requests of the run", s)
+	}
+}
+
+func TestSocketLoadTestUnsupportedGenerators(t *testing.T) {
+	for _, lg := range []models.LoadGenerator{models.Wrk2LG, models.NighthawkLG} {
+		opts := &models.LoadTestOptions{URL: "tcp://localhost:8078", LoadGenerator: lg}
+		if _, _, err := LoadTest(opts); err == nil {
+			t.Errorf("LoadTest() with %s expected an error for a TCP load test", lg)
+		}
+	}
+}
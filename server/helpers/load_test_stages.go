@@ -0,0 +1,281 @@
+package helpers
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/sirupsen/logrus"
+)
+
+// rampStepDuration is the length of the steps a ramping stage is divided in,
+// the QPS is constant within a step
+const rampStepDuration = 5 * time.Second
+
+// stagedRequestedQPS is reported as the requested QPS of results run at different QPS
+const stagedRequestedQPS = "staged"
+
+// histogramDividers are the histograms of the runner results which are merged
+// along with the divider of the buckets they are merged into
+var histogramDividers = map[string]float64{
+	"DurationHistogram": periodic.DefaultRunnerOptions.Resolution,
+	"Sizes":             1,
+	"HeaderSizes":       1,
+}
+
+type loadTestFunc func(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error)
+
+// stagedLoadTest runs the stages of the load test back to back using the given load
+// generator. The results of each stage are stored under models.LoadTestStagesKey
+// along with the results aggregated over all the stages.
+func stagedLoadTest(opts *models.LoadTestOptions, run loadTestFunc) (map[string]interface{}, *periodic.RunnerResults, error) {
+	if err := opts.Stages.Validate(); err != nil {
+		return nil, nil, ErrGeneratingLoadTest(err)
+	}
+
+	runs := []map[string]interface{}{}
+	stages := []map[string]interface{}{}
+	for i, stage := range opts.Stages {
+		logrus.Infof("running stage %d of %d of the load test %s", i+1, len(opts.Stages), opts.Name)
+
+		stageRuns := []map[string]interface{}{}
+		for _, stepOpts := range stageSteps(opts, stage) {
+			// the results of the stages run so far are dropped along with the cancelled test
+			if err := loadTestContext(opts).Err(); err != nil {
+				return nil, nil, ErrRunningTest(err)
+			}
+			resultsMap, _, err := run(stepOpts)
+			if err != nil {
+				return nil, nil, err
+			}
+			stageRuns = append(stageRuns, resultsMap)
+		}
+		runs = append(runs, stageRuns...)
+
+		stageResults, err := mergeRunnerResults(stageRuns, stage.Duration, false)
+		if err != nil {
+			return nil, nil, ErrConvertingResultToMap(err)
+		}
+		stages = append(stages, map[string]interface{}{
+			"Stage":             stage,
+			"StartTime":         stageResults["StartTime"],
+			"RequestedQPS":      stageResults["RequestedQPS"],
+			"ActualQPS":         stageResults["ActualQPS"],
+			"ActualDuration":    stageResults["ActualDuration"],
+			"NumThreads":        stageResults["NumThreads"],
+			"DurationHistogram": stageResults["DurationHistogram"],
+			"RetCodes":          stageResults["RetCodes"],
+		})
+	}
+
+	resultsMap, err := mergeRunnerResults(runs, opts.Stages.TotalDuration().String(), false)
+	if err != nil {
+		return nil, nil, ErrConvertingResultToMap(err)
+	}
+	resultsMap[models.LoadTestStagesKey] = stages
+
+	result, err := runnerResults(resultsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultsMap, result, nil
+}
+
+// runnerResults converts the results map to the runner results it holds
+func runnerResults(resultsMap map[string]interface{}) (*periodic.RunnerResults, error) {
+	bd, err := json.Marshal(resultsMap)
+	if err != nil {
+		return nil, ErrConvertingResultToMap(err)
+	}
+	result := &periodic.RunnerResults{}
+	if err := json.Unmarshal(bd, result); err != nil {
+		return nil, ErrUnmarshal(err, "data to object")
+	}
+
+	return result, nil
+}
+
+// stageSteps returns the options of the runs making up the stage, a ramp is divided
+// in steps each run at the QPS the ramp has halfway through the step
+func stageSteps(opts *models.LoadTestOptions, stage models.LoadTestStage) []*models.LoadTestOptions {
+	duration, _ := stage.GetDuration()
+	threads := stage.Concurrency
+	if threads < 1 {
+		threads = opts.HTTPNumThreads
+	}
+
+	step := func(qps float64, d time.Duration) *models.LoadTestOptions {
+		o := *opts
+		o.Stages = nil
+		o.HTTPQPS = qps
+		o.HTTPNumThreads = threads
+		o.Duration = d
+		return &o
+	}
+
+	if !stage.IsRamp() {
+		return []*models.LoadTestOptions{step(stage.QPS, duration)}
+	}
+
+	n := int(math.Ceil(float64(duration) / float64(rampStepDuration)))
+	steps := make([]*models.LoadTestOptions, 0, n)
+	for i := 0; i < n; i++ {
+		qps := stage.QPS + (*stage.TargetQPS-stage.QPS)*(float64(i)+0.5)/float64(n)
+		steps = append(steps, step(qps, duration/time.Duration(n)))
+	}
+
+	return steps
+}
+
+// mergeRunnerResults aggregates the runner results of runs which ran either one after the
+// other or concurrently, the results are in the format of the fortio runner results which
+// all the load generators report in
+func mergeRunnerResults(runs []map[string]interface{}, requestedDuration string, concurrent bool) (map[string]interface{}, error) {
+	merged := map[string]interface{}{}
+	for k, v := range runs[0] {
+		merged[k] = v
+	}
+	if len(runs) == 1 {
+		return merged, nil
+	}
+
+	var duration, threads, sockets float64
+	retCodes := map[string]interface{}{}
+	requestedQPS := runs[0]["RequestedQPS"]
+	var totalRequestedQPS float64
+	for _, r := range runs {
+		d, _ := r["ActualDuration"].(float64)
+		t, _ := r["NumThreads"].(float64)
+		if concurrent {
+			duration = math.Max(duration, d)
+			threads += t
+		} else {
+			duration += d
+			threads = math.Max(threads, t)
+		}
+		s, _ := r["SocketCount"].(float64)
+		sockets += s
+
+		// the requested QPS of concurrent runs only adds up if they all have a fixed QPS
+		q, _ := r["RequestedQPS"].(string)
+		if qps, err := strconv.ParseFloat(q, 64); err != nil {
+			totalRequestedQPS = -1
+		} else if totalRequestedQPS >= 0 {
+			totalRequestedQPS += qps
+		}
+		if r["RequestedQPS"] != requestedQPS {
+			requestedQPS = stagedRequestedQPS
+		}
+
+		codes, _ := r["RetCodes"].(map[string]interface{})
+		for code, count := range codes {
+			total, _ := retCodes[code].(float64)
+			c, _ := count.(float64)
+			retCodes[code] = total + c
+		}
+	}
+	if concurrent && totalRequestedQPS >= 0 {
+		requestedQPS = strconv.FormatFloat(totalRequestedQPS, 'f', -1, 64)
+	}
+
+	for key, divider := range histogramDividers {
+		histograms := []*stats.HistogramData{}
+		for _, r := range runs {
+			if _, ok := r[key]; !ok {
+				continue
+			}
+			bd, err := json.Marshal(r[key])
+			if err != nil {
+				return nil, err
+			}
+			h := &stats.HistogramData{}
+			if err := json.Unmarshal(bd, h); err != nil {
+				return nil, err
+			}
+			histograms = append(histograms, h)
+		}
+		if len(histograms) == 0 {
+			continue
+		}
+
+		bd, err := json.Marshal(mergeHistograms(histograms, divider))
+		if err != nil {
+			return nil, err
+		}
+		h := map[string]interface{}{}
+		if err := json.Unmarshal(bd, &h); err != nil {
+			return nil, err
+		}
+		merged[key] = h
+	}
+
+	var count float64
+	if h, ok := merged["DurationHistogram"].(map[string]interface{}); ok {
+		count, _ = h["Count"].(float64)
+	}
+	merged["ActualDuration"] = int64(duration)
+	merged["ActualQPS"] = 0.0
+	if duration > 0 {
+		merged["ActualQPS"] = count / time.Duration(duration).Seconds()
+	}
+	merged["RequestedQPS"] = requestedQPS
+	merged["RequestedDuration"] = requestedDuration
+	merged["NumThreads"] = threads
+	merged["RetCodes"] = retCodes
+	if _, ok := merged["SocketCount"]; ok {
+		merged["SocketCount"] = sockets
+	}
+
+	return merged, nil
+}
+
+// mergeHistograms merges the histograms by recording the midpoint of every bucket in a new
+// histogram, the count, min, max, average and standard deviation are computed exactly
+func mergeHistograms(histograms []*stats.HistogramData, divider float64) *stats.HistogramData {
+	h := stats.NewHistogram(0, divider)
+	var count int64
+	var sum, sumSquares float64
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, hd := range histograms {
+		for _, b := range hd.Data {
+			if b.Count > 0 {
+				h.RecordN((b.Start+b.End)/2, int(b.Count))
+			}
+		}
+		if hd.Count == 0 {
+			continue
+		}
+		count += hd.Count
+		sum += hd.Sum
+		sumSquares += float64(hd.Count) * (hd.StdDev*hd.StdDev + hd.Avg*hd.Avg)
+		min = math.Min(min, hd.Min)
+		max = math.Max(max, hd.Max)
+	}
+
+	res := h.Export()
+	if count == 0 {
+		return res
+	}
+	res.Count = count
+	res.Min = min
+	res.Max = max
+	res.Sum = sum
+	res.Avg = sum / float64(count)
+	res.StdDev = math.Sqrt(math.Max(sumSquares/float64(count)-res.Avg*res.Avg, 0))
+	if len(res.Data) > 0 {
+		res.Data[0].Start = min
+		res.Data[len(res.Data)-1].End = max
+	}
+
+	percentiles := []float64{}
+	for _, p := range histograms[0].Percentiles {
+		percentiles = append(percentiles, p.Percentile)
+	}
+
+	return res.CalcPercentiles(percentiles)
+}
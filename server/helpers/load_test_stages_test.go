@@ -0,0 +1,123 @@
+package helpers
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+	"github.com/layer5io/meshery/server/models"
+)
+
+// fakeLoadTest reports every request of the run with a latency of 1ms per QPS
+func fakeLoadTest(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+	h := stats.NewHistogram(0, periodic.DefaultRunnerOptions.Resolution)
+	count := int(opts.HTTPQPS * opts.Duration.Seconds())
+	h.RecordN(opts.HTTPQPS/1000, count)
+
+	res := &periodic.RunnerResults{
+		RunType:           "HTTP",
+		StartTime:         time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		RequestedQPS:      "100",
+		RequestedDuration: opts.Duration.String(),
+		ActualQPS:         opts.HTTPQPS,
+		ActualDuration:    opts.Duration,
+		NumThreads:        opts.HTTPNumThreads,
+		DurationHistogram: h.Export().CalcPercentiles([]float64{50, 99}),
+	}
+	bd, _ := json.Marshal(struct {
+		*periodic.RunnerResults
+		RetCodes map[int]int64
+	}{res, map[int]int64{200: int64(count)}})
+
+	resultsMap := map[string]interface{}{}
+	err := json.Unmarshal(bd, &resultsMap)
+	return resultsMap, res, err
+}
+
+func targetQPS(qps float64) *float64 {
+	return &qps
+}
+
+func TestStageSteps(t *testing.T) {
+	opts := &models.LoadTestOptions{HTTPNumThreads: 2}
+
+	steps := stageSteps(opts, models.LoadTestStage{Duration: "30s", QPS: 50})
+	if len(steps) != 1 || steps[0].HTTPQPS != 50 || steps[0].HTTPNumThreads != 2 || steps[0].Duration != 30*time.Second {
+		t.Errorf("stageSteps() of a constant stage = %+v", steps[0])
+	}
+
+	steps = stageSteps(opts, models.LoadTestStage{Duration: "20s", QPS: 0, TargetQPS: targetQPS(100), Concurrency: 8})
+	wantQPS := []float64{12.5, 37.5, 62.5, 87.5}
+	if len(steps) != len(wantQPS) {
+		t.Fatalf("stageSteps() of a ramp returned %d steps, want %d", len(steps), len(wantQPS))
+	}
+	for i, s := range steps {
+		if s.HTTPQPS != wantQPS[i] || s.HTTPNumThreads != 8 || s.Duration != rampStepDuration || s.Stages != nil {
+			t.Errorf("stageSteps() step %d = %+v, want %v qps", i, s, wantQPS[i])
+		}
+	}
+
+	// a ramp down to 0 never runs at the max qps
+	steps = stageSteps(opts, models.LoadTestStage{Duration: "10s", QPS: 100, TargetQPS: targetQPS(0)})
+	if len(steps) != 2 || steps[0].HTTPQPS != 75 || steps[1].HTTPQPS != 25 {
+		t.Errorf("stageSteps() of a ramp down = %+v, %+v", steps[0], steps[1])
+	}
+}
+
+func TestStagedLoadTest(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		HTTPNumThreads: 1,
+		Stages: models.LoadTestStages{
+			{Name: "ramp-up", Duration: "10s", QPS: 10, TargetQPS: targetQPS(30)},
+			{Name: "steady", Duration: "10s", QPS: 40, Concurrency: 4},
+		},
+	}
+
+	resultsMap, result, err := stagedLoadTest(opts, fakeLoadTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 15 and 25 qps during the two steps of the ramp then 40 qps
+	wantCount := int64(15*5 + 25*5 + 40*10)
+	if result.DurationHistogram.Count != wantCount {
+		t.Errorf("stagedLoadTest() count = %d, want %d", result.DurationHistogram.Count, wantCount)
+	}
+	if result.ActualDuration != 20*time.Second || result.NumThreads != 4 || result.RequestedDuration != "20s" {
+		t.Errorf("stagedLoadTest() result = %+v", result)
+	}
+	if math.Abs(result.ActualQPS-float64(wantCount)/20) > 1e-9 {
+		t.Errorf("stagedLoadTest() qps = %v, want %v", result.ActualQPS, float64(wantCount)/20)
+	}
+	if result.DurationHistogram.Min != 0.015 || result.DurationHistogram.Max != 0.04 {
+		t.Errorf("stagedLoadTest() latency range = [%v, %v], want [0.015, 0.04]", result.DurationHistogram.Min, result.DurationHistogram.Max)
+	}
+	if len(result.DurationHistogram.Percentiles) != 2 {
+		t.Errorf("stagedLoadTest() percentiles = %v, want p50 and p99", result.DurationHistogram.Percentiles)
+	}
+
+	retCodes, _ := resultsMap["RetCodes"].(map[string]interface{})
+	if retCodes["200"] != float64(wantCount) {
+		t.Errorf("stagedLoadTest() ret codes = %v, want %d 200s", retCodes, wantCount)
+	}
+
+	stages, _ := resultsMap[models.LoadTestStagesKey].([]map[string]interface{})
+	if len(stages) != 2 {
+		t.Fatalf("stagedLoadTest() returned %d stage results, want 2", len(stages))
+	}
+	if stages[0]["RequestedDuration"] != nil || stages[1]["ActualQPS"] != 40.0 {
+		t.Errorf("stagedLoadTest() stage results = %+v", stages)
+	}
+}
+
+func TestStagedLoadTestInvalidStage(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		Stages: models.LoadTestStages{{Duration: "forever", QPS: 10}},
+	}
+	if _, _, err := stagedLoadTest(opts, fakeLoadTest); err == nil {
+		t.Error("stagedLoadTest() expected an error for an invalid duration")
+	}
+}
@@ -0,0 +1,250 @@
+package helpers
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/sirupsen/logrus"
+)
+
+// workerHeartbeat is the interval at which a worker reports that its load test is still running
+const workerHeartbeat = 10 * time.Second
+
+// NewLoadTestWorker returns the handler of a load test worker, it runs the load tests
+// posted to models.LoadTestWorkerPath and streams models.LoadTestWorkerMessage back as
+// newline delimited JSON. Requests have to carry the token as a bearer token when it is set.
+func NewLoadTestWorker(token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(models.LoadTestWorkerPath, &loadTestWorker{
+		token:     token,
+		run:       LoadTest,
+		heartbeat: workerHeartbeat,
+	})
+
+	return mux
+}
+
+type loadTestWorker struct {
+	token     string
+	run       loadTestFunc
+	heartbeat time.Duration
+}
+
+func (w *loadTestWorker) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "load tests have to be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	if w.token != "" && subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), []byte("Bearer "+w.token)) != 1 {
+		http.Error(rw, "invalid worker token", http.StatusUnauthorized)
+		return
+	}
+
+	opts := &models.LoadTestOptions{}
+	if err := json.NewDecoder(req.Body).Decode(opts); err != nil {
+		http.Error(rw, ErrUnmarshal(err, "load test options").Error(), http.StatusBadRequest)
+		return
+	}
+	// a worker never fans the load test out any further
+	opts.Workers = nil
+	// the run is aborted when the server cancels it and closes the connection
+	opts.Ctx = req.Context()
+	opts.Progress = models.NewLoadTestProgressRecorder()
+
+	type runResult struct {
+		resultsMap map[string]interface{}
+		err        error
+	}
+	done := make(chan runResult, 1)
+	go func() {
+		resultsMap, _, err := w.run(opts)
+		done <- runResult{resultsMap, err}
+	}()
+
+	rw.Header().Set("Content-Type", "application/x-ndjson")
+	flusher, _ := rw.(http.Flusher)
+	enc := json.NewEncoder(rw)
+	send := func(msg *models.LoadTestWorkerMessage) {
+		if err := enc.Encode(msg); err != nil {
+			logrus.Warnf("unable to stream the load test %s back: %v", opts.Name, err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	logrus.Infof("running the load test %s", opts.Name)
+	send(&models.LoadTestWorkerMessage{
+		Status:  models.LoadTestInfo,
+		Message: "Running the load test " + opts.Name,
+	})
+
+	start := time.Now()
+	ticker := time.NewTicker(w.heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			send(&models.LoadTestWorkerMessage{
+				Status:   models.LoadTestProgress,
+				Message:  fmt.Sprintf("Load test running for %s", time.Since(start).Round(time.Second)),
+				Snapshot: opts.Progress.Snapshot(opts.Name),
+			})
+		case r := <-done:
+			if r.err != nil {
+				logrus.Errorf("load test %s failed: %v", opts.Name, r.err)
+				send(&models.LoadTestWorkerMessage{
+					Status:  models.LoadTestError,
+					Message: r.err.Error(),
+				})
+				return
+			}
+			send(&models.LoadTestWorkerMessage{
+				Status: models.LoadTestSuccess,
+				Result: r.resultsMap,
+			})
+			return
+		}
+	}
+}
+
+// distributedLoadTest splits the load of the load test evenly across its workers which all
+// run their share at once. The results of each worker are stored under models.LoadTestWorkersKey
+// along with the results aggregated over all of them.
+func distributedLoadTest(opts *models.LoadTestOptions, client *http.Client) (map[string]interface{}, *periodic.RunnerResults, error) {
+	share := 1 / float64(len(opts.Workers))
+	runs := make([]map[string]interface{}, len(opts.Workers))
+	errs := make([]error, len(opts.Workers))
+	var wg sync.WaitGroup
+	for i, worker := range opts.Workers {
+		wg.Add(1)
+		go func(i int, worker string) {
+			defer wg.Done()
+			logrus.Infof("running the load test %s on the worker %s", opts.Name, worker)
+			runs[i], errs[i] = runOnWorker(client, worker, opts.WorkerToken, scaleLoadTestOptions(opts, share))
+		}(i, worker)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	workers := []map[string]interface{}{}
+	for i, worker := range opts.Workers {
+		r := runs[i]
+		res := map[string]interface{}{
+			"Worker":            worker,
+			"RequestedQPS":      r["RequestedQPS"],
+			"ActualQPS":         r["ActualQPS"],
+			"ActualDuration":    r["ActualDuration"],
+			"NumThreads":        r["NumThreads"],
+			"DurationHistogram": r["DurationHistogram"],
+			"RetCodes":          r["RetCodes"],
+		}
+		for _, key := range []string{models.LoadTestStagesKey, models.LoadTestEndpointsKey} {
+			if v, ok := r[key]; ok {
+				res[key] = v
+			}
+		}
+		workers = append(workers, res)
+	}
+
+	requestedDuration, _ := runs[0]["RequestedDuration"].(string)
+	resultsMap, err := mergeRunnerResults(runs, requestedDuration, true)
+	if err != nil {
+		return nil, nil, ErrConvertingResultToMap(err)
+	}
+	delete(resultsMap, models.LoadTestStagesKey)
+	delete(resultsMap, models.LoadTestEndpointsKey)
+	resultsMap[models.LoadTestWorkersKey] = workers
+
+	result, err := runnerResults(resultsMap)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultsMap, result, nil
+}
+
+// runOnWorker runs the load test on the worker and waits for its results
+func runOnWorker(client *http.Client, worker, token string, opts *models.LoadTestOptions) (map[string]interface{}, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, ErrLoadTestWorker(err, worker)
+	}
+	// cancelling the load test closes the connection which aborts the run of the worker
+	req, err := http.NewRequestWithContext(loadTestContext(opts), http.MethodPost, strings.TrimSuffix(worker, "/")+models.LoadTestWorkerPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, ErrLoadTestWorker(err, worker)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, ErrLoadTestWorker(err, worker)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		return nil, ErrLoadTestWorker(fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b))), worker)
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		msg := models.LoadTestWorkerMessage{}
+		if err := dec.Decode(&msg); err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("the worker closed the stream without the results")
+			}
+			return nil, ErrLoadTestWorker(err, worker)
+		}
+		switch msg.Status {
+		case models.LoadTestError:
+			return nil, ErrLoadTestWorker(fmt.Errorf("%s", msg.Message), worker)
+		case models.LoadTestSuccess:
+			return msg.Result, nil
+		default:
+			logrus.Debugf("load test worker %s: %s %+v", worker, msg.Message, msg.Snapshot)
+		}
+	}
+}
+
+// scaleLoadTestOptions returns the options generating the given share of the load
+func scaleLoadTestOptions(opts *models.LoadTestOptions, share float64) *models.LoadTestOptions {
+	o := *opts
+	o.Workers = nil
+	o.WorkerToken = ""
+	o.HTTPQPS = opts.HTTPQPS * share
+	o.HTTPNumThreads = scaleConcurrency(opts.HTTPNumThreads, share)
+	o.Stages = scaleStages(opts.Stages, share)
+
+	if len(opts.Endpoints) > 0 {
+		o.Endpoints = make(models.LoadTestEndpoints, 0, len(opts.Endpoints))
+		for _, endpoint := range opts.Endpoints {
+			endpoint.QPS *= share
+			if endpoint.Concurrency > 0 {
+				endpoint.Concurrency = scaleConcurrency(endpoint.Concurrency, share)
+			}
+			o.Endpoints = append(o.Endpoints, endpoint)
+		}
+	}
+
+	return &o
+}
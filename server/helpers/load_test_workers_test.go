@@ -0,0 +1,116 @@
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"github.com/layer5io/meshery/server/models"
+)
+
+func newTestWorker(t *testing.T, token string, run loadTestFunc) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.Handle(models.LoadTestWorkerPath, &loadTestWorker{token: token, run: run, heartbeat: time.Millisecond})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestDistributedLoadTest(t *testing.T) {
+	slow := func(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+		// long enough for the workers to send heartbeats
+		time.Sleep(10 * time.Millisecond)
+		return fakeLoadTest(opts)
+	}
+	w1, w2 := newTestWorker(t, "secret", slow), newTestWorker(t, "secret", slow)
+
+	opts := &models.LoadTestOptions{
+		Name:           "distributed",
+		HTTPQPS:        40,
+		HTTPNumThreads: 4,
+		Duration:       10 * time.Second,
+		Workers:        []string{w1.URL, w2.URL + "/"},
+		WorkerToken:    "secret",
+	}
+	resultsMap, result, err := distributedLoadTest(opts, w1.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// each worker runs at 20 qps with 2 threads
+	if result.DurationHistogram.Count != 400 || result.NumThreads != 4 || result.ActualDuration != 10*time.Second {
+		t.Errorf("distributedLoadTest() result = %+v", result)
+	}
+	if result.DurationHistogram.Min != 0.02 || result.DurationHistogram.Max != 0.02 {
+		t.Errorf("distributedLoadTest() latency range = [%v, %v], want [0.02, 0.02]", result.DurationHistogram.Min, result.DurationHistogram.Max)
+	}
+
+	workers, _ := resultsMap[models.LoadTestWorkersKey].([]map[string]interface{})
+	if len(workers) != 2 || workers[0]["Worker"] != w1.URL || workers[1]["NumThreads"] != 2.0 {
+		t.Errorf("distributedLoadTest() worker results = %+v", workers)
+	}
+}
+
+func TestDistributedLoadTestErrors(t *testing.T) {
+	failing := func(opts *models.LoadTestOptions) (map[string]interface{}, *periodic.RunnerResults, error) {
+		return nil, nil, fmt.Errorf("load generator unavailable")
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		run     loadTestFunc
+		wantErr string
+	}{
+		{"invalid token", "other", fakeLoadTest, "401"},
+		{"failing load test", "secret", failing, "load generator unavailable"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := newTestWorker(t, "secret", tt.run)
+			opts := &models.LoadTestOptions{
+				HTTPQPS:     10,
+				Duration:    time.Second,
+				Workers:     []string{w.URL},
+				WorkerToken: tt.token,
+			}
+			_, _, err := distributedLoadTest(opts, w.Client())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("distributedLoadTest() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScaleLoadTestOptions(t *testing.T) {
+	opts := &models.LoadTestOptions{
+		HTTPQPS:        90,
+		HTTPNumThreads: 6,
+		Workers:        []string{"http://a", "http://b", "http://c"},
+		WorkerToken:    "secret",
+		Stages:         models.LoadTestStages{{Duration: "10s", QPS: 30, TargetQPS: targetQPS(60), Concurrency: 3}},
+		Endpoints:      models.LoadTestEndpoints{{URL: "http://a/x", QPS: 9}, {URL: "http://a/y"}},
+	}
+
+	o := scaleLoadTestOptions(opts, 1.0/3)
+	if o.Workers != nil || o.WorkerToken != "" {
+		t.Errorf("scaleLoadTestOptions() kept the workers %v", o.Workers)
+	}
+	if o.HTTPQPS != 30 || o.HTTPNumThreads != 2 {
+		t.Errorf("scaleLoadTestOptions() load = %v qps, %d threads, want 30 qps, 2 threads", o.HTTPQPS, o.HTTPNumThreads)
+	}
+	if s := o.Stages[0]; s.QPS != 10 || *s.TargetQPS != 20 || s.Concurrency != 1 {
+		t.Errorf("scaleLoadTestOptions() stage = %+v", s)
+	}
+	if o.Endpoints[0].QPS != 3 || o.Endpoints[1].QPS != 0 {
+		t.Errorf("scaleLoadTestOptions() endpoints = %+v", o.Endpoints)
+	}
+	if opts.Stages[0].QPS != 30 || opts.Endpoints[0].QPS != 9 {
+		t.Error("scaleLoadTestOptions() modified the options it scaled")
+	}
+}
@@ -196,6 +196,18 @@ type ComplexityRoot struct {
 		Describe func(childComplexity int) int
 	}
 
+	LoadTestSnapshot struct {
+		Elapsed      func(childComplexity int) int
+		LatencyAvgMs func(childComplexity int) int
+		LatencyMaxMs func(childComplexity int) int
+		LatencyP50Ms func(childComplexity int) int
+		LatencyP90Ms func(childComplexity int) int
+		LatencyP99Ms func(childComplexity int) int
+		QPS          func(childComplexity int) int
+		Requests     func(childComplexity int) int
+		TestID       func(childComplexity int) int
+	}
+
 	Location struct {
 		Branch func(childComplexity int) int
 		Host   func(childComplexity int) int
@@ -278,6 +290,62 @@ type ComplexityRoot struct {
 		OperatorStatus func(childComplexity int) int
 	}
 
+	PatternDeployment struct {
+		ContextIds   func(childComplexity int) int
+		ContextNames func(childComplexity int) int
+		FinishedAt   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		IsDelete     func(childComplexity int) int
+		Message      func(childComplexity int) int
+		PatternID    func(childComplexity int) int
+		PatternName  func(childComplexity int) int
+		Services     func(childComplexity int) int
+		StartedAt    func(childComplexity int) int
+		Status       func(childComplexity int) int
+		UserID       func(childComplexity int) int
+		VersionHash  func(childComplexity int) int
+	}
+
+	PatternDeploymentPage struct {
+		Deployments func(childComplexity int) int
+		Page        func(childComplexity int) int
+		PageSize    func(childComplexity int) int
+		TotalCount  func(childComplexity int) int
+	}
+
+	PatternDeploymentService struct {
+		ID      func(childComplexity int) int
+		Message func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Status  func(childComplexity int) int
+		Type    func(childComplexity int) int
+	}
+
+	PatternDriftReport struct {
+		CheckedAt    func(childComplexity int) int
+		DeploymentID func(childComplexity int) int
+		Orphans      func(childComplexity int) int
+		PatternID    func(childComplexity int) int
+		PatternName  func(childComplexity int) int
+		Services     func(childComplexity int) int
+		Status       func(childComplexity int) int
+	}
+
+	PatternFieldDrift struct {
+		Desired func(childComplexity int) int
+		Live    func(childComplexity int) int
+		Path    func(childComplexity int) int
+	}
+
+	PatternOrphanedObject struct {
+		APIVersion func(childComplexity int) int
+		Context    func(childComplexity int) int
+		Kind       func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Namespace  func(childComplexity int) int
+		ResourceID func(childComplexity int) int
+	}
+
 	PatternPageResult struct {
 		Page       func(childComplexity int) int
 		PageSize   func(childComplexity int) int
@@ -299,6 +367,19 @@ type ComplexityRoot struct {
 		Visibility  func(childComplexity int) int
 	}
 
+	PatternServiceDrift struct {
+		APIVersion func(childComplexity int) int
+		Context    func(childComplexity int) int
+		Fields     func(childComplexity int) int
+		Kind       func(childComplexity int) int
+		Message    func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Namespace  func(childComplexity int) int
+		ResourceID func(childComplexity int) int
+		Service    func(childComplexity int) int
+		Status     func(childComplexity int) int
+	}
+
 	PerfPageProfiles struct {
 		Page       func(childComplexity int) int
 		PageSize   func(childComplexity int) int
@@ -339,6 +420,7 @@ type ComplexityRoot struct {
 		FetchAllResults            func(childComplexity int, selector model.PageFilter) int
 		FetchFilterCatalogContent  func(childComplexity int, selector *model.CatalogSelector) int
 		FetchPatternCatalogContent func(childComplexity int, selector *model.CatalogSelector) int
+		FetchPatternDeployments    func(childComplexity int, selector model.PageFilter, patternID *string) int
 		FetchPatterns              func(childComplexity int, selector model.PageFilter) int
 		FetchResults               func(childComplexity int, selector model.PageFilter, profileID string) int
 		GetAvailableAddons         func(childComplexity int, filter *model.ServiceMeshFilter) int
@@ -350,6 +432,7 @@ type ComplexityRoot struct {
 		GetMeshsyncStatus          func(childComplexity int, k8scontextID string) int
 		GetNatsStatus              func(childComplexity int, k8scontextID string) int
 		GetOperatorStatus          func(childComplexity int, k8scontextID string) int
+		GetPatternDeployment       func(childComplexity int, id string) int
 		GetPerfResult              func(childComplexity int, id string) int
 		GetPerformanceProfiles     func(childComplexity int, selector model.PageFilter) int
 		GetScopes                  func(childComplexity int, name *string, id *string, trim *bool) int
@@ -373,8 +456,10 @@ type ComplexityRoot struct {
 		SubscribeClusterResources         func(childComplexity int, k8scontextIDs []string, namespace string) int
 		SubscribeConfiguration            func(childComplexity int, applicationSelector model.PageFilter, patternSelector model.PageFilter, filterSelector model.PageFilter) int
 		SubscribeK8sContext               func(childComplexity int, selector model.PageFilter) int
+		SubscribeLoadTestProgress         func(childComplexity int, testID string) int
 		SubscribeMeshSyncEvents           func(childComplexity int, k8scontextIDs []string) int
 		SubscribeMesheryControllersStatus func(childComplexity int, k8scontextIDs []string) int
+		SubscribePatternDrift             func(childComplexity int, deploymentID string) int
 		SubscribePerfProfiles             func(childComplexity int, selector model.PageFilter) int
 		SubscribePerfResults              func(childComplexity int, selector model.PageFilter, profileID string) int
 	}
@@ -399,6 +484,8 @@ type QueryResolver interface {
 	GetPerformanceProfiles(ctx context.Context, selector model.PageFilter) (*model.PerfPageProfiles, error)
 	FetchAllResults(ctx context.Context, selector model.PageFilter) (*model.PerfPageResult, error)
 	FetchPatterns(ctx context.Context, selector model.PageFilter) (*model.PatternPageResult, error)
+	FetchPatternDeployments(ctx context.Context, selector model.PageFilter, patternID *string) (*model.PatternDeploymentPage, error)
+	GetPatternDeployment(ctx context.Context, id string) (*model.PatternDeployment, error)
 	GetWorkloads(ctx context.Context, name *string, id *string, trim *bool) ([]*model.OAMCapability, error)
 	GetTraits(ctx context.Context, name *string, id *string, trim *bool) ([]*model.OAMCapability, error)
 	GetScopes(ctx context.Context, name *string, id *string, trim *bool) ([]*model.OAMCapability, error)
@@ -415,12 +502,14 @@ type SubscriptionResolver interface {
 	ListenToMeshSyncEvents(ctx context.Context, k8scontextIDs []string) (<-chan *model.OperatorControllerStatusPerK8sContext, error)
 	SubscribePerfProfiles(ctx context.Context, selector model.PageFilter) (<-chan *model.PerfPageProfiles, error)
 	SubscribePerfResults(ctx context.Context, selector model.PageFilter, profileID string) (<-chan *model.PerfPageResult, error)
+	SubscribeLoadTestProgress(ctx context.Context, testID string) (<-chan *model.LoadTestSnapshot, error)
 	SubscribeBrokerConnection(ctx context.Context) (<-chan bool, error)
 	SubscribeMesheryControllersStatus(ctx context.Context, k8scontextIDs []string) (<-chan []*model.MesheryControllersStatusListItem, error)
 	SubscribeMeshSyncEvents(ctx context.Context, k8scontextIDs []string) (<-chan *model.MeshSyncEvent, error)
 	SubscribeConfiguration(ctx context.Context, applicationSelector model.PageFilter, patternSelector model.PageFilter, filterSelector model.PageFilter) (<-chan *model.ConfigurationPage, error)
 	SubscribeClusterResources(ctx context.Context, k8scontextIDs []string, namespace string) (<-chan *model.ClusterResources, error)
 	SubscribeK8sContext(ctx context.Context, selector model.PageFilter) (<-chan *model.K8sContextsPage, error)
+	SubscribePatternDrift(ctx context.Context, deploymentID string) (<-chan *model.PatternDriftReport, error)
 }
 
 type executableSchema struct {
@@ -1089,6 +1178,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.KctlDescribeDetails.Describe(childComplexity), true
 
+	case "LoadTestSnapshot.elapsed":
+		if e.complexity.LoadTestSnapshot.Elapsed == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.Elapsed(childComplexity), true
+
+	case "LoadTestSnapshot.latency_avg_ms":
+		if e.complexity.LoadTestSnapshot.LatencyAvgMs == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.LatencyAvgMs(childComplexity), true
+
+	case "LoadTestSnapshot.latency_max_ms":
+		if e.complexity.LoadTestSnapshot.LatencyMaxMs == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.LatencyMaxMs(childComplexity), true
+
+	case "LoadTestSnapshot.latency_p50_ms":
+		if e.complexity.LoadTestSnapshot.LatencyP50Ms == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.LatencyP50Ms(childComplexity), true
+
+	case "LoadTestSnapshot.latency_p90_ms":
+		if e.complexity.LoadTestSnapshot.LatencyP90Ms == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.LatencyP90Ms(childComplexity), true
+
+	case "LoadTestSnapshot.latency_p99_ms":
+		if e.complexity.LoadTestSnapshot.LatencyP99Ms == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.LatencyP99Ms(childComplexity), true
+
+	case "LoadTestSnapshot.qps":
+		if e.complexity.LoadTestSnapshot.QPS == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.QPS(childComplexity), true
+
+	case "LoadTestSnapshot.requests":
+		if e.complexity.LoadTestSnapshot.Requests == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.Requests(childComplexity), true
+
+	case "LoadTestSnapshot.test_id":
+		if e.complexity.LoadTestSnapshot.TestID == nil {
+			break
+		}
+
+		return e.complexity.LoadTestSnapshot.TestID(childComplexity), true
+
 	case "Location.branch":
 		if e.complexity.Location.Branch == nil {
 			break
@@ -1416,6 +1568,272 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OperatorStatusPerK8sContext.OperatorStatus(childComplexity), true
 
+	case "PatternDeployment.context_ids":
+		if e.complexity.PatternDeployment.ContextIds == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.ContextIds(childComplexity), true
+
+	case "PatternDeployment.context_names":
+		if e.complexity.PatternDeployment.ContextNames == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.ContextNames(childComplexity), true
+
+	case "PatternDeployment.finished_at":
+		if e.complexity.PatternDeployment.FinishedAt == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.FinishedAt(childComplexity), true
+
+	case "PatternDeployment.id":
+		if e.complexity.PatternDeployment.ID == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.ID(childComplexity), true
+
+	case "PatternDeployment.is_delete":
+		if e.complexity.PatternDeployment.IsDelete == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.IsDelete(childComplexity), true
+
+	case "PatternDeployment.message":
+		if e.complexity.PatternDeployment.Message == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.Message(childComplexity), true
+
+	case "PatternDeployment.pattern_id":
+		if e.complexity.PatternDeployment.PatternID == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.PatternID(childComplexity), true
+
+	case "PatternDeployment.pattern_name":
+		if e.complexity.PatternDeployment.PatternName == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.PatternName(childComplexity), true
+
+	case "PatternDeployment.services":
+		if e.complexity.PatternDeployment.Services == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.Services(childComplexity), true
+
+	case "PatternDeployment.started_at":
+		if e.complexity.PatternDeployment.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.StartedAt(childComplexity), true
+
+	case "PatternDeployment.status":
+		if e.complexity.PatternDeployment.Status == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.Status(childComplexity), true
+
+	case "PatternDeployment.user_id":
+		if e.complexity.PatternDeployment.UserID == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.UserID(childComplexity), true
+
+	case "PatternDeployment.version_hash":
+		if e.complexity.PatternDeployment.VersionHash == nil {
+			break
+		}
+
+		return e.complexity.PatternDeployment.VersionHash(childComplexity), true
+
+	case "PatternDeploymentPage.deployments":
+		if e.complexity.PatternDeploymentPage.Deployments == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentPage.Deployments(childComplexity), true
+
+	case "PatternDeploymentPage.page":
+		if e.complexity.PatternDeploymentPage.Page == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentPage.Page(childComplexity), true
+
+	case "PatternDeploymentPage.page_size":
+		if e.complexity.PatternDeploymentPage.PageSize == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentPage.PageSize(childComplexity), true
+
+	case "PatternDeploymentPage.total_count":
+		if e.complexity.PatternDeploymentPage.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentPage.TotalCount(childComplexity), true
+
+	case "PatternDeploymentService.id":
+		if e.complexity.PatternDeploymentService.ID == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentService.ID(childComplexity), true
+
+	case "PatternDeploymentService.message":
+		if e.complexity.PatternDeploymentService.Message == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentService.Message(childComplexity), true
+
+	case "PatternDeploymentService.name":
+		if e.complexity.PatternDeploymentService.Name == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentService.Name(childComplexity), true
+
+	case "PatternDeploymentService.status":
+		if e.complexity.PatternDeploymentService.Status == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentService.Status(childComplexity), true
+
+	case "PatternDeploymentService.type":
+		if e.complexity.PatternDeploymentService.Type == nil {
+			break
+		}
+
+		return e.complexity.PatternDeploymentService.Type(childComplexity), true
+
+	case "PatternDriftReport.checked_at":
+		if e.complexity.PatternDriftReport.CheckedAt == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.CheckedAt(childComplexity), true
+
+	case "PatternDriftReport.deployment_id":
+		if e.complexity.PatternDriftReport.DeploymentID == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.DeploymentID(childComplexity), true
+
+	case "PatternDriftReport.orphans":
+		if e.complexity.PatternDriftReport.Orphans == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.Orphans(childComplexity), true
+
+	case "PatternDriftReport.pattern_id":
+		if e.complexity.PatternDriftReport.PatternID == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.PatternID(childComplexity), true
+
+	case "PatternDriftReport.pattern_name":
+		if e.complexity.PatternDriftReport.PatternName == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.PatternName(childComplexity), true
+
+	case "PatternDriftReport.services":
+		if e.complexity.PatternDriftReport.Services == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.Services(childComplexity), true
+
+	case "PatternDriftReport.status":
+		if e.complexity.PatternDriftReport.Status == nil {
+			break
+		}
+
+		return e.complexity.PatternDriftReport.Status(childComplexity), true
+
+	case "PatternFieldDrift.desired":
+		if e.complexity.PatternFieldDrift.Desired == nil {
+			break
+		}
+
+		return e.complexity.PatternFieldDrift.Desired(childComplexity), true
+
+	case "PatternFieldDrift.live":
+		if e.complexity.PatternFieldDrift.Live == nil {
+			break
+		}
+
+		return e.complexity.PatternFieldDrift.Live(childComplexity), true
+
+	case "PatternFieldDrift.path":
+		if e.complexity.PatternFieldDrift.Path == nil {
+			break
+		}
+
+		return e.complexity.PatternFieldDrift.Path(childComplexity), true
+
+	case "PatternOrphanedObject.api_version":
+		if e.complexity.PatternOrphanedObject.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.APIVersion(childComplexity), true
+
+	case "PatternOrphanedObject.context":
+		if e.complexity.PatternOrphanedObject.Context == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.Context(childComplexity), true
+
+	case "PatternOrphanedObject.kind":
+		if e.complexity.PatternOrphanedObject.Kind == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.Kind(childComplexity), true
+
+	case "PatternOrphanedObject.name":
+		if e.complexity.PatternOrphanedObject.Name == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.Name(childComplexity), true
+
+	case "PatternOrphanedObject.namespace":
+		if e.complexity.PatternOrphanedObject.Namespace == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.Namespace(childComplexity), true
+
+	case "PatternOrphanedObject.resource_id":
+		if e.complexity.PatternOrphanedObject.ResourceID == nil {
+			break
+		}
+
+		return e.complexity.PatternOrphanedObject.ResourceID(childComplexity), true
+
 	case "PatternPageResult.page":
 		if e.complexity.PatternPageResult.Page == nil {
 			break
@@ -1521,6 +1939,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PatternResult.Visibility(childComplexity), true
 
+	case "PatternServiceDrift.api_version":
+		if e.complexity.PatternServiceDrift.APIVersion == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.APIVersion(childComplexity), true
+
+	case "PatternServiceDrift.context":
+		if e.complexity.PatternServiceDrift.Context == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Context(childComplexity), true
+
+	case "PatternServiceDrift.fields":
+		if e.complexity.PatternServiceDrift.Fields == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Fields(childComplexity), true
+
+	case "PatternServiceDrift.kind":
+		if e.complexity.PatternServiceDrift.Kind == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Kind(childComplexity), true
+
+	case "PatternServiceDrift.message":
+		if e.complexity.PatternServiceDrift.Message == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Message(childComplexity), true
+
+	case "PatternServiceDrift.name":
+		if e.complexity.PatternServiceDrift.Name == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Name(childComplexity), true
+
+	case "PatternServiceDrift.namespace":
+		if e.complexity.PatternServiceDrift.Namespace == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Namespace(childComplexity), true
+
+	case "PatternServiceDrift.resource_id":
+		if e.complexity.PatternServiceDrift.ResourceID == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.ResourceID(childComplexity), true
+
+	case "PatternServiceDrift.service":
+		if e.complexity.PatternServiceDrift.Service == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Service(childComplexity), true
+
+	case "PatternServiceDrift.status":
+		if e.complexity.PatternServiceDrift.Status == nil {
+			break
+		}
+
+		return e.complexity.PatternServiceDrift.Status(childComplexity), true
+
 	case "PerfPageProfiles.page":
 		if e.complexity.PerfPageProfiles.Page == nil {
 			break
@@ -1756,6 +2244,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.FetchPatternCatalogContent(childComplexity, args["selector"].(*model.CatalogSelector)), true
 
+	case "Query.fetchPatternDeployments":
+		if e.complexity.Query.FetchPatternDeployments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_fetchPatternDeployments_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FetchPatternDeployments(childComplexity, args["selector"].(model.PageFilter), args["patternID"].(*string)), true
+
 	case "Query.fetchPatterns":
 		if e.complexity.Query.FetchPatterns == nil {
 			break
@@ -1888,8 +2388,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetOperatorStatus(childComplexity, args["k8scontextID"].(string)), true
 
-	case "Query.getPerfResult":
-		if e.complexity.Query.GetPerfResult == nil {
+	case "Query.getPatternDeployment":
+		if e.complexity.Query.GetPatternDeployment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getPatternDeployment_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetPatternDeployment(childComplexity, args["id"].(string)), true
+
+	case "Query.getPerfResult":
+		if e.complexity.Query.GetPerfResult == nil {
 			break
 		}
 
@@ -2077,6 +2589,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.SubscribeK8sContext(childComplexity, args["selector"].(model.PageFilter)), true
 
+	case "Subscription.subscribeLoadTestProgress":
+		if e.complexity.Subscription.SubscribeLoadTestProgress == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_subscribeLoadTestProgress_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SubscribeLoadTestProgress(childComplexity, args["testID"].(string)), true
+
 	case "Subscription.subscribeMeshSyncEvents":
 		if e.complexity.Subscription.SubscribeMeshSyncEvents == nil {
 			break
@@ -2101,6 +2625,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.SubscribeMesheryControllersStatus(childComplexity, args["k8scontextIDs"].([]string)), true
 
+	case "Subscription.subscribePatternDrift":
+		if e.complexity.Subscription.SubscribePatternDrift == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_subscribePatternDrift_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Subscription.SubscribePatternDrift(childComplexity, args["deploymentID"].(string)), true
+
 	case "Subscription.subscribePerfProfiles":
 		if e.complexity.Subscription.SubscribePerfProfiles == nil {
 			break
@@ -2610,6 +3146,75 @@ type PatternResult {
   updated_at: String
 }
 
+type PatternDeploymentPage {
+  page: Int!
+  page_size: Int!
+  total_count: Int!
+  deployments: [PatternDeployment!]!
+}
+
+type PatternDeployment {
+  id: ID!
+  pattern_id: String
+  pattern_name: String
+  version_hash: String!
+  context_ids: [String!]
+  context_names: [String!]
+  user_id: String
+  is_delete: Boolean!
+  status: String!
+  message: String
+  services: [PatternDeploymentService!]
+  started_at: String
+  finished_at: String
+}
+
+type PatternDriftReport {
+  deployment_id: ID!
+  pattern_id: String
+  pattern_name: String
+  status: String!
+  checked_at: String
+  services: [PatternServiceDrift!]!
+  orphans: [PatternOrphanedObject!]!
+}
+
+type PatternServiceDrift {
+  service: String!
+  resource_id: String
+  context: String
+  api_version: String
+  kind: String
+  name: String
+  namespace: String
+  status: String!
+  fields: [PatternFieldDrift!]
+  message: String
+}
+
+type PatternFieldDrift {
+  path: String!
+  live: Any
+  desired: Any
+}
+
+type PatternOrphanedObject {
+  resource_id: String!
+  context: String
+  api_version: String
+  kind: String
+  name: String
+  namespace: String
+}
+
+type PatternDeploymentService {
+  id: ID!
+  name: String!
+  type: String
+  status: String!
+  message: String
+}
+
 type Location {
   branch: String
   host: String
@@ -2639,6 +3244,19 @@ type PerfPageResult {
   results: [MesheryResult]
 }
 
+# Progress of a running load test since the previous snapshot, the latencies are in milliseconds
+type LoadTestSnapshot {
+  test_id: String!
+  elapsed: Float!
+  requests: Int!
+  qps: Float!
+  latency_avg_ms: Float!
+  latency_p50_ms: Float!
+  latency_p90_ms: Float!
+  latency_p99_ms: Float!
+  latency_max_ms: Float!
+}
+
 type PerfPageProfiles {
   page: Int!
   page_size: Int!
@@ -2778,6 +3396,12 @@ type Query {
   # Query for fetching all patterns with selector
   fetchPatterns(selector: PageFilter!): PatternPageResult!
 
+  # Query for fetching the recorded deployments of all the patterns or of a single pattern
+  fetchPatternDeployments(selector: PageFilter!, patternID: String): PatternDeploymentPage!
+
+  # Query for a single pattern deployment along with the outcome of its services
+  getPatternDeployment(id: ID!): PatternDeployment
+
   # Query for getting workloads
   getWorkloads(name: String, id: ID, trim: Boolean): [OAMCapability]
   # Query for getting traits
@@ -2841,6 +3465,9 @@ type Subscription {
   # Listen to all results for profile ID
   subscribePerfResults(selector: PageFilter!, profileID: String!): PerfPageResult!
 
+  # Listen to the progress of a running load test
+  subscribeLoadTestProgress(testID: String!): LoadTestSnapshot!
+
   # Listen to changes in Broker (NATS) Connection
   subscribeBrokerConnection: Boolean!
 
@@ -2864,6 +3491,9 @@ type Subscription {
 
   subscribeK8sContext(selector: PageFilter!) : K8sContextsPage!
 
+  # Listen to the drift of a pattern deployment from the state of the clusters as observed by MeshSync
+  subscribePatternDrift(deploymentID: ID!): PatternDriftReport!
+
 }
 
 type OAMCapability {
@@ -2992,6 +3622,30 @@ func (ec *executionContext) field_Query_fetchPatternCatalogContent_args(ctx cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_fetchPatternDeployments_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 model.PageFilter
+	if tmp, ok := rawArgs["selector"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("selector"))
+		arg0, err = ec.unmarshalNPageFilter2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPageFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["selector"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["patternID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("patternID"))
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["patternID"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_fetchPatterns_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3193,6 +3847,21 @@ func (ec *executionContext) field_Query_getOperatorStatus_args(ctx context.Conte
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_getPatternDeployment_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_getPerfResult_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3493,6 +4162,21 @@ func (ec *executionContext) field_Subscription_subscribeK8sContext_args(ctx cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_subscribeLoadTestProgress_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["testID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("testID"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["testID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Subscription_subscribeMeshSyncEvents_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -3523,6 +4207,21 @@ func (ec *executionContext) field_Subscription_subscribeMesheryControllersStatus
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_subscribePatternDrift_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["deploymentID"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deploymentID"))
+		arg0, err = ec.unmarshalNID2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["deploymentID"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Subscription_subscribePerfProfiles_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -7826,8 +8525,8 @@ func (ec *executionContext) fieldContext_KctlDescribeDetails_ctxid(ctx context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_branch(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_test_id(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_test_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7840,23 +8539,26 @@ func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Branch, nil
+		return obj.TestID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_test_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -7867,8 +8569,8 @@ func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_host(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_host(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_elapsed(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_elapsed(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7881,35 +8583,38 @@ func (ec *executionContext) _Location_host(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Host, nil
+		return obj.Elapsed, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_elapsed(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_path(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_path(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_requests(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_requests(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7922,35 +8627,38 @@ func (ec *executionContext) _Location_path(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Path, nil
+		return obj.Requests, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_requests(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_type(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Location_type(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_qps(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_qps(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7963,35 +8671,38 @@ func (ec *executionContext) _Location_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.QPS, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Location_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_qps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_type(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_latency_avg_ms(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_latency_avg_ms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8004,7 +8715,7 @@ func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.LatencyAvgMs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8016,26 +8727,26 @@ func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_latency_avg_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_object(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_latency_p50_ms(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_latency_p50_ms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8048,7 +8759,7 @@ func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Object, nil
+		return obj.LatencyP50Ms, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8060,26 +8771,26 @@ func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_latency_p50_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MeshSyncEvent_contextId(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_latency_p90_ms(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_latency_p90_ms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8092,7 +8803,7 @@ func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.LatencyP90Ms, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8104,26 +8815,26 @@ func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_latency_p90_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MeshSyncEvent",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_contextId(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_latency_p99_ms(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_latency_p99_ms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8136,7 +8847,7 @@ func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.LatencyP99Ms, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8148,26 +8859,26 @@ func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_latency_p99_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_controller(ctx, field)
+func (ec *executionContext) _LoadTestSnapshot_latency_max_ms(ctx context.Context, field graphql.CollectedField, obj *model.LoadTestSnapshot) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_LoadTestSnapshot_latency_max_ms(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8180,7 +8891,7 @@ func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Controller, nil
+		return obj.LatencyMaxMs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8192,26 +8903,26 @@ func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MesheryController)
+	res := resTmp.(float64)
 	fc.Result = res
-	return ec.marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx, field.Selections, res)
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_LoadTestSnapshot_latency_max_ms(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "LoadTestSnapshot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MesheryController does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryControllersStatusListItem_status(ctx, field)
+func (ec *executionContext) _Location_branch(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_branch(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8224,38 +8935,35 @@ func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Branch, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.MesheryControllerStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_branch(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryControllersStatusListItem",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type MesheryControllerStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+func (ec *executionContext) _Location_host(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_host(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8268,7 +8976,7 @@ func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MesheryID, nil
+		return obj.Host, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8282,9 +8990,9 @@ func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8295,8 +9003,8 @@ func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_name(ctx, field)
+func (ec *executionContext) _Location_path(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8309,7 +9017,7 @@ func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8323,9 +9031,9 @@ func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8336,8 +9044,8 @@ func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_mesh(ctx, field)
+func (ec *executionContext) _Location_type(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Location_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8350,7 +9058,7 @@ func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Mesh, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8364,9 +9072,9 @@ func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Location_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "Location",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8377,8 +9085,8 @@ func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_performance_profile(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8391,23 +9099,26 @@ func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PerformanceProfile, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8418,8 +9129,8 @@ func (ec *executionContext) fieldContext_MesheryResult_performance_profile(ctx c
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_test_id(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_object(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8432,35 +9143,38 @@ func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TestID, nil
+		return obj.Object, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_test_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_object(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_runner_results(ctx, field)
+func (ec *executionContext) _MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MeshSyncEvent) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MeshSyncEvent_contextId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8473,35 +9187,38 @@ func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RunnerResults, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MeshSyncEvent_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MeshSyncEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_server_metrics(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_contextId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8514,23 +9231,26 @@ func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerMetrics, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_contextId(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8541,8 +9261,8 @@ func (ec *executionContext) fieldContext_MesheryResult_server_metrics(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_server_board_config(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_controller(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8555,35 +9275,38 @@ func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServerBoardConfig, nil
+		return obj.Controller, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.MesheryController)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_controller(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MesheryController does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_test_start_time(ctx, field)
+func (ec *executionContext) _MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField, obj *model.MesheryControllersStatusListItem) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryControllersStatusListItem_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8596,35 +9319,38 @@ func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TestStartTime, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.MesheryControllerStatus)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryControllersStatusListItem_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "MesheryResult",
+		Object:     "MesheryControllersStatusListItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type MesheryControllerStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_user_id(ctx, field)
+func (ec *executionContext) _MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_meshery_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8637,7 +9363,7 @@ func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.MesheryID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8651,7 +9377,7 @@ func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field gr
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_meshery_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "MesheryResult",
 		Field:      field,
@@ -8664,8 +9390,8 @@ func (ec *executionContext) fieldContext_MesheryResult_user_id(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_updated_at(ctx, field)
+func (ec *executionContext) _MesheryResult_name(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8678,7 +9404,7 @@ func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8692,7 +9418,7 @@ func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "MesheryResult",
 		Field:      field,
@@ -8705,8 +9431,8 @@ func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_MesheryResult_created_at(ctx, field)
+func (ec *executionContext) _MesheryResult_mesh(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_mesh(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8719,7 +9445,7 @@ func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Mesh, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8733,7 +9459,7 @@ func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "MesheryResult",
 		Field:      field,
@@ -8746,8 +9472,8 @@ func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_changeOperatorStatus(ctx, field)
+func (ec *executionContext) _MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_performance_profile(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8760,49 +9486,35 @@ func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().ChangeOperatorStatus(rctx, fc.Args["input"].(*model.OperatorStatusInput))
+		return obj.PerformanceProfile, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_performance_profile(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "MesheryResult",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_changeOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field graphql.CollectedField, obj *model.NameSpace) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NameSpace_namespace(ctx, field)
+func (ec *executionContext) _MesheryResult_test_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_test_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8815,26 +9527,23 @@ func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Namespace, nil
+		return obj.TestID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_test_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NameSpace",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8845,8 +9554,8 @@ func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _NullString_String(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NullString_String(ctx, field)
+func (ec *executionContext) _MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_runner_results(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8859,38 +9568,35 @@ func (ec *executionContext) _NullString_String(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.String, nil
+		return obj.RunnerResults, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(map[string]interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOMap2map(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NullString_String(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_runner_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NullString",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Map does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_NullString_Valid(ctx, field)
+func (ec *executionContext) _MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_server_metrics(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8903,38 +9609,35 @@ func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Valid, nil
+		return obj.ServerMetrics, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_NullString_Valid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_server_metrics(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "NullString",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_oam_definition(ctx, field)
+func (ec *executionContext) _MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_server_board_config(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8947,7 +9650,7 @@ func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OamDefinition, nil
+		return obj.ServerBoardConfig, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8956,26 +9659,26 @@ func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_server_board_config(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_id(ctx, field)
+func (ec *executionContext) _MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_test_start_time(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8988,7 +9691,7 @@ func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TestStartTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9002,9 +9705,9 @@ func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_test_start_time(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9015,8 +9718,8 @@ func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_oam_ref_schema(ctx, field)
+func (ec *executionContext) _MesheryResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_user_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9029,7 +9732,7 @@ func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OamRefSchema, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9043,9 +9746,9 @@ func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, f
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9056,8 +9759,8 @@ func (ec *executionContext) fieldContext_OAMCapability_oam_ref_schema(ctx contex
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_host(ctx, field)
+func (ec *executionContext) _MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_updated_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9070,7 +9773,7 @@ func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Host, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9084,9 +9787,9 @@ func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graph
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9097,8 +9800,8 @@ func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_restricted(ctx, field)
+func (ec *executionContext) _MesheryResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.MesheryResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MesheryResult_created_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9111,7 +9814,7 @@ func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Restricted, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9120,26 +9823,26 @@ func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_restricted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_MesheryResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "MesheryResult",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OAMCapability_metadata(ctx, field)
+func (ec *executionContext) _Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_changeOperatorStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9152,35 +9855,49 @@ func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Metadata, nil
+		return ec.resolvers.Mutation().ChangeOperatorStatus(rctx, fc.Args["input"].(*model.OperatorStatusInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(interface{})
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalOAny2interface(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OAMCapability_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_changeOperatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OAMCapability",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_changeOperatorStatus_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+func (ec *executionContext) _NameSpace_namespace(ctx context.Context, field graphql.CollectedField, obj *model.NameSpace) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NameSpace_namespace(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9193,7 +9910,7 @@ func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Namespace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9210,9 +9927,9 @@ func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context,
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NameSpace_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "NameSpace",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9223,8 +9940,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx conte
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+func (ec *executionContext) _NullString_String(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NullString_String(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9237,7 +9954,7 @@ func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.String, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9254,9 +9971,9 @@ func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NullString_String(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "NullString",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9267,8 +9984,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx co
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+func (ec *executionContext) _NullString_Valid(ctx context.Context, field graphql.CollectedField, obj *model.NullString) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_NullString_Valid(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9281,7 +9998,7 @@ func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Valid, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9293,26 +10010,26 @@ func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_NullString_Valid(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "NullString",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+func (ec *executionContext) _OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_oam_definition(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9325,7 +10042,7 @@ func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.OamDefinition, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9334,32 +10051,26 @@ func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Error)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_oam_definition(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "description":
-				return ec.fieldContext_Error_description(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+func (ec *executionContext) _OAMCapability_id(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9372,26 +10083,23 @@ func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatus",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9402,8 +10110,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatus_contextID(ctx
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx, field)
+func (ec *executionContext) _OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_oam_ref_schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9416,26 +10124,23 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.OamRefSchema, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_oam_ref_schema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatusPerK8sContext",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9446,8 +10151,8 @@ func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_c
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field)
+func (ec *executionContext) _OAMCapability_host(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_host(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9460,50 +10165,35 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorContr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperatorControllerStatus, nil
+		return obj.Host, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorControllerStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_host(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorControllerStatusPerK8sContext",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_status(ctx, field)
+func (ec *executionContext) _OAMCapability_restricted(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_restricted(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9516,38 +10206,35 @@ func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.Restricted, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(model.Status)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_restricted(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Status does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_version(ctx, field)
+func (ec *executionContext) _OAMCapability_metadata(ctx context.Context, field graphql.CollectedField, obj *model.OAMCapability) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OAMCapability_metadata(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9560,38 +10247,35 @@ func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.Metadata, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OAMCapability_metadata(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "OAMCapability",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_controllers(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9604,7 +10288,7 @@ func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Controllers, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9616,38 +10300,26 @@ func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.OperatorControllerStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
-			case "status":
-				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_error(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9660,41 +10332,38 @@ func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Error, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Error)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "code":
-				return ec.fieldContext_Error_code(ctx, field)
-			case "description":
-				return ec.fieldContext_Error_description(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatus_contextID(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9707,7 +10376,7 @@ func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9719,26 +10388,26 @@ func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatus",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_contextID(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9751,38 +10420,41 @@ func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContextID, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Error)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatusPerK8sContext",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "description":
+				return ec.fieldContext_Error_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx, field)
+func (ec *executionContext) _OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9795,7 +10467,7 @@ func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperatorStatus, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9807,38 +10479,26 @@ func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.OperatorStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OperatorStatusPerK8sContext",
+		Object:     "OperatorControllerStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "status":
-				return ec.fieldContext_OperatorStatus_status(ctx, field)
-			case "version":
-				return ec.fieldContext_OperatorStatus_version(ctx, field)
-			case "controllers":
-				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
-			case "error":
-				return ec.fieldContext_OperatorStatus_error(ctx, field)
-			case "contextID":
-				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_page(ctx, field)
+func (ec *executionContext) _OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9851,7 +10511,7 @@ func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9863,26 +10523,26 @@ func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OperatorControllerStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_page_size(ctx, field)
+func (ec *executionContext) _OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorControllerStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9895,7 +10555,7 @@ func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.OperatorControllerStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9907,26 +10567,38 @@ func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.OperatorControllerStatus)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OperatorControllerStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_total_count(ctx, field)
+func (ec *executionContext) _OperatorStatus_status(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9939,7 +10611,7 @@ func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9951,26 +10623,26 @@ func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(model.Status)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Status does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternPageResult_patterns(ctx, field)
+func (ec *executionContext) _OperatorStatus_version(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_version(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9983,59 +10655,38 @@ func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Patterns, nil
+		return obj.Version, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PatternResult)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOPatternResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternResult(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternPageResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_PatternResult_id(ctx, field)
-			case "name":
-				return ec.fieldContext_PatternResult_name(ctx, field)
-			case "user_id":
-				return ec.fieldContext_PatternResult_user_id(ctx, field)
-			case "location":
-				return ec.fieldContext_PatternResult_location(ctx, field)
-			case "pattern_file":
-				return ec.fieldContext_PatternResult_pattern_file(ctx, field)
-			case "visibility":
-				return ec.fieldContext_PatternResult_visibility(ctx, field)
-			case "catalog_data":
-				return ec.fieldContext_PatternResult_catalog_data(ctx, field)
-			case "canSupport":
-				return ec.fieldContext_PatternResult_canSupport(ctx, field)
-			case "errmsg":
-				return ec.fieldContext_PatternResult_errmsg(ctx, field)
-			case "created_at":
-				return ec.fieldContext_PatternResult_created_at(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_PatternResult_updated_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PatternResult", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_id(ctx, field)
+func (ec *executionContext) _OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_controllers(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10048,7 +10699,7 @@ func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Controllers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10060,26 +10711,38 @@ func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.OperatorControllerStatus)
 	fc.Result = res
-	return ec.marshalNID2string(ctx, field.Selections, res)
+	return ec.marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_controllers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_OperatorControllerStatus_name(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorControllerStatus_version(ctx, field)
+			case "status":
+				return ec.fieldContext_OperatorControllerStatus_status(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorControllerStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorControllerStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorControllerStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_name(ctx, field)
+func (ec *executionContext) _OperatorStatus_error(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_error(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10092,38 +10755,41 @@ func (ec *executionContext) _PatternResult_name(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Error, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Error)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOError2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_error(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "code":
+				return ec.fieldContext_Error_code(ctx, field)
+			case "description":
+				return ec.fieldContext_Error_description(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Error", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_user_id(ctx, field)
+func (ec *executionContext) _OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatus) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatus_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10136,7 +10802,7 @@ func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10153,9 +10819,9 @@ func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field gr
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatus_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatus",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10166,8 +10832,8 @@ func (ec *executionContext) fieldContext_PatternResult_user_id(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_location(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_location(ctx, field)
+func (ec *executionContext) _OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_contextID(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10180,7 +10846,7 @@ func (ec *executionContext) _PatternResult_location(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Location, nil
+		return obj.ContextID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10192,36 +10858,26 @@ func (ec *executionContext) _PatternResult_location(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Location)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_contextID(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "branch":
-				return ec.fieldContext_Location_branch(ctx, field)
-			case "host":
-				return ec.fieldContext_Location_host(ctx, field)
-			case "path":
-				return ec.fieldContext_Location_path(ctx, field)
-			case "type":
-				return ec.fieldContext_Location_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_pattern_file(ctx, field)
+func (ec *executionContext) _OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField, obj *model.OperatorStatusPerK8sContext) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10234,7 +10890,7 @@ func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PatternFile, nil
+		return obj.OperatorStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10246,26 +10902,38 @@ func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.OperatorStatus)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_OperatorStatusPerK8sContext_operatorStatus(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "OperatorStatusPerK8sContext",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_OperatorStatus_status(ctx, field)
+			case "version":
+				return ec.fieldContext_OperatorStatus_version(ctx, field)
+			case "controllers":
+				return ec.fieldContext_OperatorStatus_controllers(ctx, field)
+			case "error":
+				return ec.fieldContext_OperatorStatus_error(ctx, field)
+			case "contextID":
+				return ec.fieldContext_OperatorStatus_contextID(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OperatorStatus", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_visibility(ctx, field)
+func (ec *executionContext) _PatternDeployment_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10278,7 +10946,7 @@ func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Visibility, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10292,12 +10960,53 @@ func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternDeployment_pattern_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_pattern_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PatternID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternDeployment_pattern_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10308,8 +11017,8 @@ func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_catalog_data(ctx, field)
+func (ec *executionContext) _PatternDeployment_pattern_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_pattern_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10322,7 +11031,7 @@ func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CatalogData, nil
+		return obj.PatternName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10331,26 +11040,26 @@ func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, fie
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(map[string]interface{})
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOMap2map(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_pattern_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Map does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_canSupport(ctx, field)
+func (ec *executionContext) _PatternDeployment_version_hash(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_version_hash(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10363,7 +11072,7 @@ func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CanSupport, nil
+		return obj.VersionHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10375,26 +11084,26 @@ func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_canSupport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_version_hash(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_errmsg(ctx, field)
+func (ec *executionContext) _PatternDeployment_context_ids(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_context_ids(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10407,7 +11116,7 @@ func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Errmsg, nil
+		return obj.ContextIds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10416,14 +11125,14 @@ func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_context_ids(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10434,8 +11143,8 @@ func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_created_at(ctx, field)
+func (ec *executionContext) _PatternDeployment_context_names(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_context_names(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10448,7 +11157,7 @@ func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.ContextNames, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10457,14 +11166,14 @@ func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_context_names(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10475,8 +11184,8 @@ func (ec *executionContext) fieldContext_PatternResult_created_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PatternResult_updated_at(ctx, field)
+func (ec *executionContext) _PatternDeployment_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_user_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10489,7 +11198,7 @@ func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.UserID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10503,9 +11212,9 @@ func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PatternResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PatternResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10516,8 +11225,8 @@ func (ec *executionContext) fieldContext_PatternResult_updated_at(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_page(ctx, field)
+func (ec *executionContext) _PatternDeployment_is_delete(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_is_delete(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10530,7 +11239,7 @@ func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.IsDelete, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10542,26 +11251,26 @@ func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_is_delete(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+func (ec *executionContext) _PatternDeployment_status(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10574,7 +11283,7 @@ func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10586,26 +11295,26 @@ func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
+func (ec *executionContext) _PatternDeployment_message(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10618,38 +11327,35 @@ func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, f
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
+func (ec *executionContext) _PatternDeployment_services(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_services(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10662,7 +11368,7 @@ func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profiles, nil
+		return obj.Services, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10671,62 +11377,38 @@ func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.PerfProfile)
+	res := resTmp.([]*model.PatternDeploymentService)
 	fc.Result = res
-	return ec.marshalOPerfProfile2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfProfile(ctx, field.Selections, res)
+	return ec.marshalOPatternDeploymentService2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentServiceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_services(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageProfiles",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "concurrent_request":
-				return ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
-			case "created_at":
-				return ec.fieldContext_PerfProfile_created_at(ctx, field)
-			case "duration":
-				return ec.fieldContext_PerfProfile_duration(ctx, field)
-			case "endpoints":
-				return ec.fieldContext_PerfProfile_endpoints(ctx, field)
 			case "id":
-				return ec.fieldContext_PerfProfile_id(ctx, field)
-			case "last_run":
-				return ec.fieldContext_PerfProfile_last_run(ctx, field)
-			case "load_generators":
-				return ec.fieldContext_PerfProfile_load_generators(ctx, field)
+				return ec.fieldContext_PatternDeploymentService_id(ctx, field)
 			case "name":
-				return ec.fieldContext_PerfProfile_name(ctx, field)
-			case "qps":
-				return ec.fieldContext_PerfProfile_qps(ctx, field)
-			case "total_results":
-				return ec.fieldContext_PerfProfile_total_results(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_PerfProfile_updated_at(ctx, field)
-			case "user_id":
-				return ec.fieldContext_PerfProfile_user_id(ctx, field)
-			case "request_headers":
-				return ec.fieldContext_PerfProfile_request_headers(ctx, field)
-			case "request_cookies":
-				return ec.fieldContext_PerfProfile_request_cookies(ctx, field)
-			case "request_body":
-				return ec.fieldContext_PerfProfile_request_body(ctx, field)
-			case "content_type":
-				return ec.fieldContext_PerfProfile_content_type(ctx, field)
-			case "service_mesh":
-				return ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+				return ec.fieldContext_PatternDeploymentService_name(ctx, field)
+			case "type":
+				return ec.fieldContext_PatternDeploymentService_type(ctx, field)
+			case "status":
+				return ec.fieldContext_PatternDeploymentService_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PatternDeploymentService_message(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfProfile", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PatternDeploymentService", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_page(ctx, field)
+func (ec *executionContext) _PatternDeployment_started_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_started_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10739,38 +11421,35 @@ func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Page, nil
+		return obj.StartedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_started_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_page_size(ctx, field)
+func (ec *executionContext) _PatternDeployment_finished_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeployment) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeployment_finished_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10783,38 +11462,35 @@ func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageSize, nil
+		return obj.FinishedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeployment_finished_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternDeployment",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_total_count(ctx, field)
+func (ec *executionContext) _PatternDeploymentPage_page(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentPage_page(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10827,7 +11503,7 @@ func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Page, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10844,9 +11520,9 @@ func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, fie
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentPage_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternDeploymentPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10857,8 +11533,8 @@ func (ec *executionContext) fieldContext_PerfPageResult_total_count(ctx context.
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfPageResult_results(ctx, field)
+func (ec *executionContext) _PatternDeploymentPage_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentPage_page_size(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10871,61 +11547,38 @@ func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Results, nil
+		return obj.PageSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.MesheryResult)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOMesheryResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfPageResult_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentPage_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfPageResult",
+		Object:     "PatternDeploymentPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "meshery_id":
-				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
-			case "name":
-				return ec.fieldContext_MesheryResult_name(ctx, field)
-			case "mesh":
-				return ec.fieldContext_MesheryResult_mesh(ctx, field)
-			case "performance_profile":
-				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
-			case "test_id":
-				return ec.fieldContext_MesheryResult_test_id(ctx, field)
-			case "runner_results":
-				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
-			case "server_metrics":
-				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
-			case "server_board_config":
-				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
-			case "test_start_time":
-				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
-			case "user_id":
-				return ec.fieldContext_MesheryResult_user_id(ctx, field)
-			case "updated_at":
-				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
-			case "created_at":
-				return ec.fieldContext_MesheryResult_created_at(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
+func (ec *executionContext) _PatternDeploymentPage_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentPage_total_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10938,7 +11591,7 @@ func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ConcurrentRequest, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10955,9 +11608,9 @@ func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context,
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentPage_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10968,8 +11621,8 @@ func (ec *executionContext) fieldContext_PerfProfile_concurrent_request(ctx cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_created_at(ctx, field)
+func (ec *executionContext) _PatternDeploymentPage_deployments(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentPage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentPage_deployments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10982,35 +11635,66 @@ func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.Deployments, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.PatternDeployment)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPatternDeployment2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentPage_deployments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentPage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PatternDeployment_id(ctx, field)
+			case "pattern_id":
+				return ec.fieldContext_PatternDeployment_pattern_id(ctx, field)
+			case "pattern_name":
+				return ec.fieldContext_PatternDeployment_pattern_name(ctx, field)
+			case "version_hash":
+				return ec.fieldContext_PatternDeployment_version_hash(ctx, field)
+			case "context_ids":
+				return ec.fieldContext_PatternDeployment_context_ids(ctx, field)
+			case "context_names":
+				return ec.fieldContext_PatternDeployment_context_names(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PatternDeployment_user_id(ctx, field)
+			case "is_delete":
+				return ec.fieldContext_PatternDeployment_is_delete(ctx, field)
+			case "status":
+				return ec.fieldContext_PatternDeployment_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PatternDeployment_message(ctx, field)
+			case "services":
+				return ec.fieldContext_PatternDeployment_services(ctx, field)
+			case "started_at":
+				return ec.fieldContext_PatternDeployment_started_at(ctx, field)
+			case "finished_at":
+				return ec.fieldContext_PatternDeployment_finished_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternDeployment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_duration(ctx, field)
+func (ec *executionContext) _PatternDeploymentService_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentService) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentService_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11023,7 +11707,51 @@ func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Duration, nil
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternDeploymentService_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternDeploymentService",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternDeploymentService_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentService) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentService_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11040,9 +11768,9 @@ func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field gra
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentService_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentService",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11053,8 +11781,8 @@ func (ec *executionContext) fieldContext_PerfProfile_duration(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_endpoints(ctx, field)
+func (ec *executionContext) _PatternDeploymentService_type(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentService) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentService_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11067,7 +11795,7 @@ func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Endpoints, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11076,14 +11804,14 @@ func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentService_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentService",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11094,8 +11822,8 @@ func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_id(ctx, field)
+func (ec *executionContext) _PatternDeploymentService_status(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentService) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentService_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11108,7 +11836,7 @@ func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11125,9 +11853,9 @@ func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentService_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentService",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11138,8 +11866,8 @@ func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_last_run(ctx, field)
+func (ec *executionContext) _PatternDeploymentService_message(ctx context.Context, field graphql.CollectedField, obj *model.PatternDeploymentService) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDeploymentService_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11152,7 +11880,7 @@ func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastRun, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11166,9 +11894,9 @@ func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field gra
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_last_run(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDeploymentService_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDeploymentService",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11179,8 +11907,8 @@ func (ec *executionContext) fieldContext_PerfProfile_last_run(ctx context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_load_generators(ctx, field)
+func (ec *executionContext) _PatternDriftReport_deployment_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_deployment_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11193,35 +11921,38 @@ func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LoadGenerators, nil
+		return obj.DeploymentID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+	return ec.marshalNID2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_deployment_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_name(ctx, field)
+func (ec *executionContext) _PatternDriftReport_pattern_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_pattern_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11234,7 +11965,7 @@ func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.PatternID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11248,9 +11979,9 @@ func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_pattern_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11261,8 +11992,8 @@ func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_qps(ctx, field)
+func (ec *executionContext) _PatternDriftReport_pattern_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_pattern_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11275,7 +12006,7 @@ func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QPS, nil
+		return obj.PatternName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11284,26 +12015,26 @@ func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_qps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_pattern_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_total_results(ctx, field)
+func (ec *executionContext) _PatternDriftReport_status(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_status(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11316,35 +12047,38 @@ func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalResults, nil
+		return obj.Status, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_total_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_updated_at(ctx, field)
+func (ec *executionContext) _PatternDriftReport_checked_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_checked_at(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11357,7 +12091,7 @@ func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.CheckedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11371,9 +12105,9 @@ func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field g
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_checked_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11384,8 +12118,8 @@ func (ec *executionContext) fieldContext_PerfProfile_updated_at(ctx context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_user_id(ctx, field)
+func (ec *executionContext) _PatternDriftReport_services(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_services(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11398,7 +12132,7 @@ func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UserID, nil
+		return obj.Services, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11410,26 +12144,48 @@ func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.PatternServiceDrift)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNPatternServiceDrift2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternServiceDriftᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_services(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "service":
+				return ec.fieldContext_PatternServiceDrift_service(ctx, field)
+			case "resource_id":
+				return ec.fieldContext_PatternServiceDrift_resource_id(ctx, field)
+			case "context":
+				return ec.fieldContext_PatternServiceDrift_context(ctx, field)
+			case "api_version":
+				return ec.fieldContext_PatternServiceDrift_api_version(ctx, field)
+			case "kind":
+				return ec.fieldContext_PatternServiceDrift_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_PatternServiceDrift_name(ctx, field)
+			case "namespace":
+				return ec.fieldContext_PatternServiceDrift_namespace(ctx, field)
+			case "status":
+				return ec.fieldContext_PatternServiceDrift_status(ctx, field)
+			case "fields":
+				return ec.fieldContext_PatternServiceDrift_fields(ctx, field)
+			case "message":
+				return ec.fieldContext_PatternServiceDrift_message(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternServiceDrift", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_headers(ctx, field)
+func (ec *executionContext) _PatternDriftReport_orphans(ctx context.Context, field graphql.CollectedField, obj *model.PatternDriftReport) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternDriftReport_orphans(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11442,35 +12198,52 @@ func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestHeaders, nil
+		return obj.Orphans, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.PatternOrphanedObject)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNPatternOrphanedObject2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternOrphanedObjectᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternDriftReport_orphans(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternDriftReport",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "resource_id":
+				return ec.fieldContext_PatternOrphanedObject_resource_id(ctx, field)
+			case "context":
+				return ec.fieldContext_PatternOrphanedObject_context(ctx, field)
+			case "api_version":
+				return ec.fieldContext_PatternOrphanedObject_api_version(ctx, field)
+			case "kind":
+				return ec.fieldContext_PatternOrphanedObject_kind(ctx, field)
+			case "name":
+				return ec.fieldContext_PatternOrphanedObject_name(ctx, field)
+			case "namespace":
+				return ec.fieldContext_PatternOrphanedObject_namespace(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternOrphanedObject", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+func (ec *executionContext) _PatternFieldDrift_path(ctx context.Context, field graphql.CollectedField, obj *model.PatternFieldDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternFieldDrift_path(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11483,23 +12256,26 @@ func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestCookies, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternFieldDrift_path(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternFieldDrift",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11510,8 +12286,8 @@ func (ec *executionContext) fieldContext_PerfProfile_request_cookies(ctx context
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_request_body(ctx, field)
+func (ec *executionContext) _PatternFieldDrift_live(ctx context.Context, field graphql.CollectedField, obj *model.PatternFieldDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternFieldDrift_live(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11524,7 +12300,7 @@ func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RequestBody, nil
+		return obj.Live, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11533,26 +12309,26 @@ func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_request_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternFieldDrift_live(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternFieldDrift",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_content_type(ctx, field)
+func (ec *executionContext) _PatternFieldDrift_desired(ctx context.Context, field graphql.CollectedField, obj *model.PatternFieldDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternFieldDrift_desired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11565,7 +12341,7 @@ func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ContentType, nil
+		return obj.Desired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11574,26 +12350,26 @@ func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_content_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternFieldDrift_desired(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternFieldDrift",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+func (ec *executionContext) _PatternOrphanedObject_resource_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_resource_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11606,23 +12382,26 @@ func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ServiceMesh, nil
+		return obj.ResourceID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PatternOrphanedObject_resource_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PerfProfile",
+		Object:     "PatternOrphanedObject",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -11633,8 +12412,8 @@ func (ec *executionContext) fieldContext_PerfProfile_service_mesh(ctx context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getAvailableAddons(ctx, field)
+func (ec *executionContext) _PatternOrphanedObject_context(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_context(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11647,21 +12426,2446 @@ func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetAvailableAddons(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+		return obj.Context, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.AddonList)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNAddonList2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐAddonListᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternOrphanedObject_context(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternOrphanedObject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternOrphanedObject_api_version(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_api_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.APIVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternOrphanedObject_api_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternOrphanedObject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternOrphanedObject_kind(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternOrphanedObject_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternOrphanedObject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternOrphanedObject_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternOrphanedObject_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternOrphanedObject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternOrphanedObject_namespace(ctx context.Context, field graphql.CollectedField, obj *model.PatternOrphanedObject) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternOrphanedObject_namespace(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Namespace, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternOrphanedObject_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternOrphanedObject",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_page_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageSize, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_total_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField, obj *model.PatternPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternPageResult_patterns(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Patterns, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PatternResult)
+	fc.Result = res
+	return ec.marshalOPatternResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternPageResult_patterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PatternResult_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PatternResult_name(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PatternResult_user_id(ctx, field)
+			case "location":
+				return ec.fieldContext_PatternResult_location(ctx, field)
+			case "pattern_file":
+				return ec.fieldContext_PatternResult_pattern_file(ctx, field)
+			case "visibility":
+				return ec.fieldContext_PatternResult_visibility(ctx, field)
+			case "catalog_data":
+				return ec.fieldContext_PatternResult_catalog_data(ctx, field)
+			case "canSupport":
+				return ec.fieldContext_PatternResult_canSupport(ctx, field)
+			case "errmsg":
+				return ec.fieldContext_PatternResult_errmsg(ctx, field)
+			case "created_at":
+				return ec.fieldContext_PatternResult_created_at(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_PatternResult_updated_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNID2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_user_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_location(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_location(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Location)
+	fc.Result = res
+	return ec.marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_location(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "branch":
+				return ec.fieldContext_Location_branch(ctx, field)
+			case "host":
+				return ec.fieldContext_Location_host(ctx, field)
+			case "path":
+				return ec.fieldContext_Location_path(ctx, field)
+			case "type":
+				return ec.fieldContext_Location_type(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_pattern_file(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PatternFile, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_pattern_file(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_visibility(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_visibility(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Visibility, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_visibility(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_catalog_data(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CatalogData, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(map[string]interface{})
+	fc.Result = res
+	return ec.marshalOMap2map(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_catalog_data(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Map does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_canSupport(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_canSupport(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CanSupport, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_canSupport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_errmsg(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_errmsg(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errmsg, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_errmsg(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_created_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternResult_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PatternResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternResult_updated_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternResult_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_service(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_service(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Service, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_service(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_resource_id(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_resource_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ResourceID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_resource_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_context(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_context(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Context, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_context(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_api_version(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_api_version(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.APIVersion, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_api_version(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_kind(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_kind(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_name(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_namespace(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_namespace(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Namespace, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_namespace(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_status(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_status(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_status(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_fields(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PatternFieldDrift)
+	fc.Result = res
+	return ec.marshalOPatternFieldDrift2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternFieldDriftᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "path":
+				return ec.fieldContext_PatternFieldDrift_path(ctx, field)
+			case "live":
+				return ec.fieldContext_PatternFieldDrift_live(ctx, field)
+			case "desired":
+				return ec.fieldContext_PatternFieldDrift_desired(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternFieldDrift", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PatternServiceDrift_message(ctx context.Context, field graphql.CollectedField, obj *model.PatternServiceDrift) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PatternServiceDrift_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PatternServiceDrift_message(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PatternServiceDrift",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageProfiles_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageProfiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageSize, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageProfiles_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageProfiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageProfiles_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageProfiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageProfiles) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Profiles, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PerfProfile)
+	fc.Result = res
+	return ec.marshalOPerfProfile2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfProfile(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageProfiles_profiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageProfiles",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "concurrent_request":
+				return ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
+			case "created_at":
+				return ec.fieldContext_PerfProfile_created_at(ctx, field)
+			case "duration":
+				return ec.fieldContext_PerfProfile_duration(ctx, field)
+			case "endpoints":
+				return ec.fieldContext_PerfProfile_endpoints(ctx, field)
+			case "id":
+				return ec.fieldContext_PerfProfile_id(ctx, field)
+			case "last_run":
+				return ec.fieldContext_PerfProfile_last_run(ctx, field)
+			case "load_generators":
+				return ec.fieldContext_PerfProfile_load_generators(ctx, field)
+			case "name":
+				return ec.fieldContext_PerfProfile_name(ctx, field)
+			case "qps":
+				return ec.fieldContext_PerfProfile_qps(ctx, field)
+			case "total_results":
+				return ec.fieldContext_PerfProfile_total_results(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_PerfProfile_updated_at(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PerfProfile_user_id(ctx, field)
+			case "request_headers":
+				return ec.fieldContext_PerfProfile_request_headers(ctx, field)
+			case "request_cookies":
+				return ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+			case "request_body":
+				return ec.fieldContext_PerfProfile_request_body(ctx, field)
+			case "content_type":
+				return ec.fieldContext_PerfProfile_content_type(ctx, field)
+			case "service_mesh":
+				return ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerfProfile", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageResult_page(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_page(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Page, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageResult_page(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_page_size(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageSize, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageResult_page_size(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_total_count(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageResult_total_count(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfPageResult_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfPageResult) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfPageResult_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Results, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.MesheryResult)
+	fc.Result = res
+	return ec.marshalOMesheryResult2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfPageResult_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfPageResult",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "meshery_id":
+				return ec.fieldContext_MesheryResult_meshery_id(ctx, field)
+			case "name":
+				return ec.fieldContext_MesheryResult_name(ctx, field)
+			case "mesh":
+				return ec.fieldContext_MesheryResult_mesh(ctx, field)
+			case "performance_profile":
+				return ec.fieldContext_MesheryResult_performance_profile(ctx, field)
+			case "test_id":
+				return ec.fieldContext_MesheryResult_test_id(ctx, field)
+			case "runner_results":
+				return ec.fieldContext_MesheryResult_runner_results(ctx, field)
+			case "server_metrics":
+				return ec.fieldContext_MesheryResult_server_metrics(ctx, field)
+			case "server_board_config":
+				return ec.fieldContext_MesheryResult_server_board_config(ctx, field)
+			case "test_start_time":
+				return ec.fieldContext_MesheryResult_test_start_time(ctx, field)
+			case "user_id":
+				return ec.fieldContext_MesheryResult_user_id(ctx, field)
+			case "updated_at":
+				return ec.fieldContext_MesheryResult_updated_at(ctx, field)
+			case "created_at":
+				return ec.fieldContext_MesheryResult_created_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MesheryResult", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_concurrent_request(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConcurrentRequest, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_concurrent_request(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_created_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_created_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CreatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_created_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_duration(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_duration(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Duration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_duration(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_endpoints(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Endpoints, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_endpoints(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_last_run(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_last_run(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastRun, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_last_run(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_load_generators(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LoadGenerators, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*string)
+	fc.Result = res
+	return ec.marshalOString2ᚕᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_load_generators(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_name(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_name(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_qps(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_qps(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QPS, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_qps(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_total_results(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_total_results(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalResults, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_total_results(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_updated_at(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UpdatedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_updated_at(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_user_id(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_user_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.UserID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_user_id(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_headers(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestHeaders, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_headers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_cookies(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestCookies, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_cookies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_request_body(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_request_body(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RequestBody, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_request_body(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_content_type(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_content_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ContentType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_content_type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField, obj *model.PerfProfile) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PerfProfile_service_mesh(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServiceMesh, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PerfProfile_service_mesh(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PerfProfile",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getAvailableAddons(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().GetAvailableAddons(rctx, fc.Args["filter"].(*model.ServiceMeshFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.AddonList)
+	fc.Result = res
+	return ec.marshalNAddonList2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐAddonListᚄ(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) fieldContext_Query_getAvailableAddons(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
@@ -12409,10 +15613,140 @@ func (ec *executionContext) _Query_getPerformanceProfiles(ctx context.Context, f
 	}
 	res := resTmp.(*model.PerfPageProfiles)
 	fc.Result = res
-	return ec.marshalNPerfPageProfiles2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageProfiles(ctx, field.Selections, res)
+	return ec.marshalNPerfPageProfiles2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageProfiles(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PerfPageProfiles_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
+			case "profiles":
+				return ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerfPageProfiles", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getPerformanceProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchAllResults(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchAllResults(rctx, fc.Args["selector"].(model.PageFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PerfPageResult)
+	fc.Result = res
+	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "page":
+				return ec.fieldContext_PerfPageResult_page(ctx, field)
+			case "page_size":
+				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
+			case "total_count":
+				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
+			case "results":
+				return ec.fieldContext_PerfPageResult_results(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fetchAllResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchPatterns(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().FetchPatterns(rctx, fc.Args["selector"].(model.PageFilter))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PatternPageResult)
+	fc.Result = res
+	return ec.marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -12421,15 +15755,15 @@ func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx contex
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "page":
-				return ec.fieldContext_PerfPageProfiles_page(ctx, field)
+				return ec.fieldContext_PatternPageResult_page(ctx, field)
 			case "page_size":
-				return ec.fieldContext_PerfPageProfiles_page_size(ctx, field)
+				return ec.fieldContext_PatternPageResult_page_size(ctx, field)
 			case "total_count":
-				return ec.fieldContext_PerfPageProfiles_total_count(ctx, field)
-			case "profiles":
-				return ec.fieldContext_PerfPageProfiles_profiles(ctx, field)
+				return ec.fieldContext_PatternPageResult_total_count(ctx, field)
+			case "patterns":
+				return ec.fieldContext_PatternPageResult_patterns(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfPageProfiles", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PatternPageResult", field.Name)
 		},
 	}
 	defer func() {
@@ -12439,15 +15773,15 @@ func (ec *executionContext) fieldContext_Query_getPerformanceProfiles(ctx contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getPerformanceProfiles_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchPatterns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchAllResults(ctx, field)
+func (ec *executionContext) _Query_fetchPatternDeployments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_fetchPatternDeployments(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12460,7 +15794,7 @@ func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchAllResults(rctx, fc.Args["selector"].(model.PageFilter))
+		return ec.resolvers.Query().FetchPatternDeployments(rctx, fc.Args["selector"].(model.PageFilter), fc.Args["patternID"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12472,12 +15806,12 @@ func (ec *executionContext) _Query_fetchAllResults(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PerfPageResult)
+	res := resTmp.(*model.PatternDeploymentPage)
 	fc.Result = res
-	return ec.marshalNPerfPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageResult(ctx, field.Selections, res)
+	return ec.marshalNPatternDeploymentPage2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentPage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_fetchPatternDeployments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -12486,15 +15820,15 @@ func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Conte
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "page":
-				return ec.fieldContext_PerfPageResult_page(ctx, field)
+				return ec.fieldContext_PatternDeploymentPage_page(ctx, field)
 			case "page_size":
-				return ec.fieldContext_PerfPageResult_page_size(ctx, field)
+				return ec.fieldContext_PatternDeploymentPage_page_size(ctx, field)
 			case "total_count":
-				return ec.fieldContext_PerfPageResult_total_count(ctx, field)
-			case "results":
-				return ec.fieldContext_PerfPageResult_results(ctx, field)
+				return ec.fieldContext_PatternDeploymentPage_total_count(ctx, field)
+			case "deployments":
+				return ec.fieldContext_PatternDeploymentPage_deployments(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type PerfPageResult", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PatternDeploymentPage", field.Name)
 		},
 	}
 	defer func() {
@@ -12504,15 +15838,15 @@ func (ec *executionContext) fieldContext_Query_fetchAllResults(ctx context.Conte
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchAllResults_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_fetchPatternDeployments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_fetchPatterns(ctx, field)
+func (ec *executionContext) _Query_getPatternDeployment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getPatternDeployment(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12525,24 +15859,21 @@ func (ec *executionContext) _Query_fetchPatterns(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().FetchPatterns(rctx, fc.Args["selector"].(model.PageFilter))
+		return ec.resolvers.Query().GetPatternDeployment(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PatternPageResult)
+	res := resTmp.(*model.PatternDeployment)
 	fc.Result = res
-	return ec.marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx, field.Selections, res)
+	return ec.marshalOPatternDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeployment(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getPatternDeployment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -12550,16 +15881,34 @@ func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "page":
-				return ec.fieldContext_PatternPageResult_page(ctx, field)
-			case "page_size":
-				return ec.fieldContext_PatternPageResult_page_size(ctx, field)
-			case "total_count":
-				return ec.fieldContext_PatternPageResult_total_count(ctx, field)
-			case "patterns":
-				return ec.fieldContext_PatternPageResult_patterns(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PatternPageResult", field.Name)
+			case "id":
+				return ec.fieldContext_PatternDeployment_id(ctx, field)
+			case "pattern_id":
+				return ec.fieldContext_PatternDeployment_pattern_id(ctx, field)
+			case "pattern_name":
+				return ec.fieldContext_PatternDeployment_pattern_name(ctx, field)
+			case "version_hash":
+				return ec.fieldContext_PatternDeployment_version_hash(ctx, field)
+			case "context_ids":
+				return ec.fieldContext_PatternDeployment_context_ids(ctx, field)
+			case "context_names":
+				return ec.fieldContext_PatternDeployment_context_names(ctx, field)
+			case "user_id":
+				return ec.fieldContext_PatternDeployment_user_id(ctx, field)
+			case "is_delete":
+				return ec.fieldContext_PatternDeployment_is_delete(ctx, field)
+			case "status":
+				return ec.fieldContext_PatternDeployment_status(ctx, field)
+			case "message":
+				return ec.fieldContext_PatternDeployment_message(ctx, field)
+			case "services":
+				return ec.fieldContext_PatternDeployment_services(ctx, field)
+			case "started_at":
+				return ec.fieldContext_PatternDeployment_started_at(ctx, field)
+			case "finished_at":
+				return ec.fieldContext_PatternDeployment_finished_at(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternDeployment", field.Name)
 		},
 	}
 	defer func() {
@@ -12569,7 +15918,7 @@ func (ec *executionContext) fieldContext_Query_fetchPatterns(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_fetchPatterns_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_getPatternDeployment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return
 	}
@@ -13788,6 +17137,95 @@ func (ec *executionContext) fieldContext_Subscription_subscribePerfResults(ctx c
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_subscribeLoadTestProgress(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_subscribeLoadTestProgress(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SubscribeLoadTestProgress(rctx, fc.Args["testID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.LoadTestSnapshot):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNLoadTestSnapshot2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLoadTestSnapshot(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_subscribeLoadTestProgress(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "test_id":
+				return ec.fieldContext_LoadTestSnapshot_test_id(ctx, field)
+			case "elapsed":
+				return ec.fieldContext_LoadTestSnapshot_elapsed(ctx, field)
+			case "requests":
+				return ec.fieldContext_LoadTestSnapshot_requests(ctx, field)
+			case "qps":
+				return ec.fieldContext_LoadTestSnapshot_qps(ctx, field)
+			case "latency_avg_ms":
+				return ec.fieldContext_LoadTestSnapshot_latency_avg_ms(ctx, field)
+			case "latency_p50_ms":
+				return ec.fieldContext_LoadTestSnapshot_latency_p50_ms(ctx, field)
+			case "latency_p90_ms":
+				return ec.fieldContext_LoadTestSnapshot_latency_p90_ms(ctx, field)
+			case "latency_p99_ms":
+				return ec.fieldContext_LoadTestSnapshot_latency_p99_ms(ctx, field)
+			case "latency_max_ms":
+				return ec.fieldContext_LoadTestSnapshot_latency_max_ms(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type LoadTestSnapshot", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_subscribeLoadTestProgress_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Subscription_subscribeBrokerConnection(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
 	fc, err := ec.fieldContext_Subscription_subscribeBrokerConnection(ctx, field)
 	if err != nil {
@@ -14225,6 +17663,91 @@ func (ec *executionContext) fieldContext_Subscription_subscribeK8sContext(ctx co
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_subscribePatternDrift(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_subscribePatternDrift(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().SubscribePatternDrift(rctx, fc.Args["deploymentID"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.PatternDriftReport):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNPatternDriftReport2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDriftReport(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_subscribePatternDrift(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "deployment_id":
+				return ec.fieldContext_PatternDriftReport_deployment_id(ctx, field)
+			case "pattern_id":
+				return ec.fieldContext_PatternDriftReport_pattern_id(ctx, field)
+			case "pattern_name":
+				return ec.fieldContext_PatternDriftReport_pattern_name(ctx, field)
+			case "status":
+				return ec.fieldContext_PatternDriftReport_status(ctx, field)
+			case "checked_at":
+				return ec.fieldContext_PatternDriftReport_checked_at(ctx, field)
+			case "services":
+				return ec.fieldContext_PatternDriftReport_services(ctx, field)
+			case "orphans":
+				return ec.fieldContext_PatternDriftReport_orphans(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PatternDriftReport", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_subscribePatternDrift_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext___Directive_name(ctx, field)
 	if err != nil {
@@ -17160,47 +20683,131 @@ func (ec *executionContext) _K8sContextsPage(ctx context.Context, sel ast.Select
 			out.Values[i] = graphql.MarshalString("K8sContextsPage")
 		case "total_count":
 
-			out.Values[i] = ec._K8sContextsPage_total_count(ctx, field, obj)
+			out.Values[i] = ec._K8sContextsPage_total_count(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "contexts":
+
+			out.Values[i] = ec._K8sContextsPage_contexts(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var kctlDescribeDetailsImplementors = []string{"KctlDescribeDetails"}
+
+func (ec *executionContext) _KctlDescribeDetails(ctx context.Context, sel ast.SelectionSet, obj *model.KctlDescribeDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, kctlDescribeDetailsImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("KctlDescribeDetails")
+		case "describe":
+
+			out.Values[i] = ec._KctlDescribeDetails_describe(ctx, field, obj)
+
+		case "ctxid":
+
+			out.Values[i] = ec._KctlDescribeDetails_ctxid(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var loadTestSnapshotImplementors = []string{"LoadTestSnapshot"}
+
+func (ec *executionContext) _LoadTestSnapshot(ctx context.Context, sel ast.SelectionSet, obj *model.LoadTestSnapshot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, loadTestSnapshotImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LoadTestSnapshot")
+		case "test_id":
+
+			out.Values[i] = ec._LoadTestSnapshot_test_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "elapsed":
+
+			out.Values[i] = ec._LoadTestSnapshot_elapsed(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "requests":
+
+			out.Values[i] = ec._LoadTestSnapshot_requests(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "qps":
+
+			out.Values[i] = ec._LoadTestSnapshot_qps(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "contexts":
+		case "latency_avg_ms":
 
-			out.Values[i] = ec._K8sContextsPage_contexts(ctx, field, obj)
+			out.Values[i] = ec._LoadTestSnapshot_latency_avg_ms(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch()
-	if invalids > 0 {
-		return graphql.Null
-	}
-	return out
-}
+		case "latency_p50_ms":
 
-var kctlDescribeDetailsImplementors = []string{"KctlDescribeDetails"}
+			out.Values[i] = ec._LoadTestSnapshot_latency_p50_ms(ctx, field, obj)
 
-func (ec *executionContext) _KctlDescribeDetails(ctx context.Context, sel ast.SelectionSet, obj *model.KctlDescribeDetails) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, kctlDescribeDetailsImplementors)
-	out := graphql.NewFieldSet(fields)
-	var invalids uint32
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("KctlDescribeDetails")
-		case "describe":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "latency_p90_ms":
 
-			out.Values[i] = ec._KctlDescribeDetails_describe(ctx, field, obj)
+			out.Values[i] = ec._LoadTestSnapshot_latency_p90_ms(ctx, field, obj)
 
-		case "ctxid":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "latency_p99_ms":
 
-			out.Values[i] = ec._KctlDescribeDetails_ctxid(ctx, field, obj)
+			out.Values[i] = ec._LoadTestSnapshot_latency_p99_ms(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "latency_max_ms":
 
+			out.Values[i] = ec._LoadTestSnapshot_latency_max_ms(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -17453,7 +21060,228 @@ func (ec *executionContext) _NameSpace(ctx context.Context, sel ast.SelectionSet
 			out.Values[i] = graphql.MarshalString("NameSpace")
 		case "namespace":
 
-			out.Values[i] = ec._NameSpace_namespace(ctx, field, obj)
+			out.Values[i] = ec._NameSpace_namespace(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var nullStringImplementors = []string{"NullString"}
+
+func (ec *executionContext) _NullString(ctx context.Context, sel ast.SelectionSet, obj *model.NullString) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, nullStringImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("NullString")
+		case "String":
+
+			out.Values[i] = ec._NullString_String(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "Valid":
+
+			out.Values[i] = ec._NullString_Valid(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var oAMCapabilityImplementors = []string{"OAMCapability"}
+
+func (ec *executionContext) _OAMCapability(ctx context.Context, sel ast.SelectionSet, obj *model.OAMCapability) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, oAMCapabilityImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OAMCapability")
+		case "oam_definition":
+
+			out.Values[i] = ec._OAMCapability_oam_definition(ctx, field, obj)
+
+		case "id":
+
+			out.Values[i] = ec._OAMCapability_id(ctx, field, obj)
+
+		case "oam_ref_schema":
+
+			out.Values[i] = ec._OAMCapability_oam_ref_schema(ctx, field, obj)
+
+		case "host":
+
+			out.Values[i] = ec._OAMCapability_host(ctx, field, obj)
+
+		case "restricted":
+
+			out.Values[i] = ec._OAMCapability_restricted(ctx, field, obj)
+
+		case "metadata":
+
+			out.Values[i] = ec._OAMCapability_metadata(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var operatorControllerStatusImplementors = []string{"OperatorControllerStatus"}
+
+func (ec *executionContext) _OperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorControllerStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, operatorControllerStatusImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OperatorControllerStatus")
+		case "name":
+
+			out.Values[i] = ec._OperatorControllerStatus_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "version":
+
+			out.Values[i] = ec._OperatorControllerStatus_version(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+
+			out.Values[i] = ec._OperatorControllerStatus_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+
+			out.Values[i] = ec._OperatorControllerStatus_error(ctx, field, obj)
+
+		case "contextID":
+
+			out.Values[i] = ec._OperatorControllerStatus_contextID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var operatorControllerStatusPerK8sContextImplementors = []string{"OperatorControllerStatusPerK8sContext"}
+
+func (ec *executionContext) _OperatorControllerStatusPerK8sContext(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorControllerStatusPerK8sContext) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, operatorControllerStatusPerK8sContextImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OperatorControllerStatusPerK8sContext")
+		case "contextID":
+
+			out.Values[i] = ec._OperatorControllerStatusPerK8sContext_contextID(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "OperatorControllerStatus":
+
+			out.Values[i] = ec._OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var operatorStatusImplementors = []string{"OperatorStatus"}
+
+func (ec *executionContext) _OperatorStatus(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorStatus) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, operatorStatusImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OperatorStatus")
+		case "status":
+
+			out.Values[i] = ec._OperatorStatus_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "version":
+
+			out.Values[i] = ec._OperatorStatus_version(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "controllers":
+
+			out.Values[i] = ec._OperatorStatus_controllers(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "error":
+
+			out.Values[i] = ec._OperatorStatus_error(ctx, field, obj)
+
+		case "contextID":
+
+			out.Values[i] = ec._OperatorStatus_contextID(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -17469,26 +21297,26 @@ func (ec *executionContext) _NameSpace(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var nullStringImplementors = []string{"NullString"}
+var operatorStatusPerK8sContextImplementors = []string{"OperatorStatusPerK8sContext"}
 
-func (ec *executionContext) _NullString(ctx context.Context, sel ast.SelectionSet, obj *model.NullString) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, nullStringImplementors)
+func (ec *executionContext) _OperatorStatusPerK8sContext(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorStatusPerK8sContext) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, operatorStatusPerK8sContextImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("NullString")
-		case "String":
+			out.Values[i] = graphql.MarshalString("OperatorStatusPerK8sContext")
+		case "contextID":
 
-			out.Values[i] = ec._NullString_String(ctx, field, obj)
+			out.Values[i] = ec._OperatorStatusPerK8sContext_contextID(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "Valid":
+		case "operatorStatus":
 
-			out.Values[i] = ec._NullString_Valid(ctx, field, obj)
+			out.Values[i] = ec._OperatorStatusPerK8sContext_operatorStatus(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -17504,39 +21332,79 @@ func (ec *executionContext) _NullString(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var oAMCapabilityImplementors = []string{"OAMCapability"}
+var patternDeploymentImplementors = []string{"PatternDeployment"}
 
-func (ec *executionContext) _OAMCapability(ctx context.Context, sel ast.SelectionSet, obj *model.OAMCapability) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, oAMCapabilityImplementors)
+func (ec *executionContext) _PatternDeployment(ctx context.Context, sel ast.SelectionSet, obj *model.PatternDeployment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternDeploymentImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OAMCapability")
-		case "oam_definition":
+			out.Values[i] = graphql.MarshalString("PatternDeployment")
+		case "id":
 
-			out.Values[i] = ec._OAMCapability_oam_definition(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_id(ctx, field, obj)
 
-		case "id":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pattern_id":
 
-			out.Values[i] = ec._OAMCapability_id(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_pattern_id(ctx, field, obj)
 
-		case "oam_ref_schema":
+		case "pattern_name":
 
-			out.Values[i] = ec._OAMCapability_oam_ref_schema(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_pattern_name(ctx, field, obj)
 
-		case "host":
+		case "version_hash":
 
-			out.Values[i] = ec._OAMCapability_host(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_version_hash(ctx, field, obj)
 
-		case "restricted":
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "context_ids":
 
-			out.Values[i] = ec._OAMCapability_restricted(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_context_ids(ctx, field, obj)
 
-		case "metadata":
+		case "context_names":
 
-			out.Values[i] = ec._OAMCapability_metadata(ctx, field, obj)
+			out.Values[i] = ec._PatternDeployment_context_names(ctx, field, obj)
+
+		case "user_id":
+
+			out.Values[i] = ec._PatternDeployment_user_id(ctx, field, obj)
+
+		case "is_delete":
+
+			out.Values[i] = ec._PatternDeployment_is_delete(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "status":
+
+			out.Values[i] = ec._PatternDeployment_status(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "message":
+
+			out.Values[i] = ec._PatternDeployment_message(ctx, field, obj)
+
+		case "services":
+
+			out.Values[i] = ec._PatternDeployment_services(ctx, field, obj)
+
+		case "started_at":
+
+			out.Values[i] = ec._PatternDeployment_started_at(ctx, field, obj)
+
+		case "finished_at":
+
+			out.Values[i] = ec._PatternDeployment_finished_at(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -17549,44 +21417,40 @@ func (ec *executionContext) _OAMCapability(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var operatorControllerStatusImplementors = []string{"OperatorControllerStatus"}
+var patternDeploymentPageImplementors = []string{"PatternDeploymentPage"}
 
-func (ec *executionContext) _OperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorControllerStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, operatorControllerStatusImplementors)
+func (ec *executionContext) _PatternDeploymentPage(ctx context.Context, sel ast.SelectionSet, obj *model.PatternDeploymentPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternDeploymentPageImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OperatorControllerStatus")
-		case "name":
+			out.Values[i] = graphql.MarshalString("PatternDeploymentPage")
+		case "page":
 
-			out.Values[i] = ec._OperatorControllerStatus_name(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentPage_page(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "version":
+		case "page_size":
 
-			out.Values[i] = ec._OperatorControllerStatus_version(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentPage_page_size(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "status":
+		case "total_count":
 
-			out.Values[i] = ec._OperatorControllerStatus_status(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentPage_total_count(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "error":
-
-			out.Values[i] = ec._OperatorControllerStatus_error(ctx, field, obj)
-
-		case "contextID":
+		case "deployments":
 
-			out.Values[i] = ec._OperatorControllerStatus_contextID(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentPage_deployments(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -17602,30 +21466,45 @@ func (ec *executionContext) _OperatorControllerStatus(ctx context.Context, sel a
 	return out
 }
 
-var operatorControllerStatusPerK8sContextImplementors = []string{"OperatorControllerStatusPerK8sContext"}
+var patternDeploymentServiceImplementors = []string{"PatternDeploymentService"}
 
-func (ec *executionContext) _OperatorControllerStatusPerK8sContext(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorControllerStatusPerK8sContext) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, operatorControllerStatusPerK8sContextImplementors)
+func (ec *executionContext) _PatternDeploymentService(ctx context.Context, sel ast.SelectionSet, obj *model.PatternDeploymentService) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternDeploymentServiceImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OperatorControllerStatusPerK8sContext")
-		case "contextID":
+			out.Values[i] = graphql.MarshalString("PatternDeploymentService")
+		case "id":
 
-			out.Values[i] = ec._OperatorControllerStatusPerK8sContext_contextID(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentService_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "OperatorControllerStatus":
+		case "name":
 
-			out.Values[i] = ec._OperatorControllerStatusPerK8sContext_OperatorControllerStatus(ctx, field, obj)
+			out.Values[i] = ec._PatternDeploymentService_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "type":
+
+			out.Values[i] = ec._PatternDeploymentService_type(ctx, field, obj)
+
+		case "status":
+
+			out.Values[i] = ec._PatternDeploymentService_status(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "message":
+
+			out.Values[i] = ec._PatternDeploymentService_message(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -17637,44 +21516,52 @@ func (ec *executionContext) _OperatorControllerStatusPerK8sContext(ctx context.C
 	return out
 }
 
-var operatorStatusImplementors = []string{"OperatorStatus"}
+var patternDriftReportImplementors = []string{"PatternDriftReport"}
 
-func (ec *executionContext) _OperatorStatus(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorStatus) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, operatorStatusImplementors)
+func (ec *executionContext) _PatternDriftReport(ctx context.Context, sel ast.SelectionSet, obj *model.PatternDriftReport) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternDriftReportImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OperatorStatus")
-		case "status":
+			out.Values[i] = graphql.MarshalString("PatternDriftReport")
+		case "deployment_id":
 
-			out.Values[i] = ec._OperatorStatus_status(ctx, field, obj)
+			out.Values[i] = ec._PatternDriftReport_deployment_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "version":
+		case "pattern_id":
 
-			out.Values[i] = ec._OperatorStatus_version(ctx, field, obj)
+			out.Values[i] = ec._PatternDriftReport_pattern_id(ctx, field, obj)
+
+		case "pattern_name":
+
+			out.Values[i] = ec._PatternDriftReport_pattern_name(ctx, field, obj)
+
+		case "status":
+
+			out.Values[i] = ec._PatternDriftReport_status(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "controllers":
+		case "checked_at":
 
-			out.Values[i] = ec._OperatorStatus_controllers(ctx, field, obj)
+			out.Values[i] = ec._PatternDriftReport_checked_at(ctx, field, obj)
+
+		case "services":
+
+			out.Values[i] = ec._PatternDriftReport_services(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "error":
+		case "orphans":
 
-			out.Values[i] = ec._OperatorStatus_error(ctx, field, obj)
-
-		case "contextID":
-
-			out.Values[i] = ec._OperatorStatus_contextID(ctx, field, obj)
+			out.Values[i] = ec._PatternDriftReport_orphans(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
@@ -17690,30 +21577,79 @@ func (ec *executionContext) _OperatorStatus(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var operatorStatusPerK8sContextImplementors = []string{"OperatorStatusPerK8sContext"}
+var patternFieldDriftImplementors = []string{"PatternFieldDrift"}
 
-func (ec *executionContext) _OperatorStatusPerK8sContext(ctx context.Context, sel ast.SelectionSet, obj *model.OperatorStatusPerK8sContext) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, operatorStatusPerK8sContextImplementors)
+func (ec *executionContext) _PatternFieldDrift(ctx context.Context, sel ast.SelectionSet, obj *model.PatternFieldDrift) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternFieldDriftImplementors)
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OperatorStatusPerK8sContext")
-		case "contextID":
+			out.Values[i] = graphql.MarshalString("PatternFieldDrift")
+		case "path":
 
-			out.Values[i] = ec._OperatorStatusPerK8sContext_contextID(ctx, field, obj)
+			out.Values[i] = ec._PatternFieldDrift_path(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "operatorStatus":
+		case "live":
 
-			out.Values[i] = ec._OperatorStatusPerK8sContext_operatorStatus(ctx, field, obj)
+			out.Values[i] = ec._PatternFieldDrift_live(ctx, field, obj)
+
+		case "desired":
+
+			out.Values[i] = ec._PatternFieldDrift_desired(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var patternOrphanedObjectImplementors = []string{"PatternOrphanedObject"}
+
+func (ec *executionContext) _PatternOrphanedObject(ctx context.Context, sel ast.SelectionSet, obj *model.PatternOrphanedObject) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternOrphanedObjectImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PatternOrphanedObject")
+		case "resource_id":
+
+			out.Values[i] = ec._PatternOrphanedObject_resource_id(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "context":
+
+			out.Values[i] = ec._PatternOrphanedObject_context(ctx, field, obj)
+
+		case "api_version":
+
+			out.Values[i] = ec._PatternOrphanedObject_api_version(ctx, field, obj)
+
+		case "kind":
+
+			out.Values[i] = ec._PatternOrphanedObject_kind(ctx, field, obj)
+
+		case "name":
+
+			out.Values[i] = ec._PatternOrphanedObject_name(ctx, field, obj)
+
+		case "namespace":
+
+			out.Values[i] = ec._PatternOrphanedObject_namespace(ctx, field, obj)
+
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -17783,68 +21719,135 @@ func (ec *executionContext) _PatternResult(ctx context.Context, sel ast.Selectio
 			out.Values[i] = graphql.MarshalString("PatternResult")
 		case "id":
 
-			out.Values[i] = ec._PatternResult_id(ctx, field, obj)
+			out.Values[i] = ec._PatternResult_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "name":
+
+			out.Values[i] = ec._PatternResult_name(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "user_id":
+
+			out.Values[i] = ec._PatternResult_user_id(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "location":
+
+			out.Values[i] = ec._PatternResult_location(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pattern_file":
+
+			out.Values[i] = ec._PatternResult_pattern_file(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "visibility":
+
+			out.Values[i] = ec._PatternResult_visibility(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "catalog_data":
+
+			out.Values[i] = ec._PatternResult_catalog_data(ctx, field, obj)
+
+		case "canSupport":
+
+			out.Values[i] = ec._PatternResult_canSupport(ctx, field, obj)
+
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "errmsg":
+
+			out.Values[i] = ec._PatternResult_errmsg(ctx, field, obj)
+
+		case "created_at":
+
+			out.Values[i] = ec._PatternResult_created_at(ctx, field, obj)
+
+		case "updated_at":
+
+			out.Values[i] = ec._PatternResult_updated_at(ctx, field, obj)
+
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var patternServiceDriftImplementors = []string{"PatternServiceDrift"}
+
+func (ec *executionContext) _PatternServiceDrift(ctx context.Context, sel ast.SelectionSet, obj *model.PatternServiceDrift) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, patternServiceDriftImplementors)
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PatternServiceDrift")
+		case "service":
+
+			out.Values[i] = ec._PatternServiceDrift_service(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "name":
+		case "resource_id":
 
-			out.Values[i] = ec._PatternResult_name(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_resource_id(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "user_id":
+		case "context":
 
-			out.Values[i] = ec._PatternResult_user_id(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_context(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "location":
+		case "api_version":
 
-			out.Values[i] = ec._PatternResult_location(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_api_version(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "pattern_file":
+		case "kind":
 
-			out.Values[i] = ec._PatternResult_pattern_file(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_kind(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "visibility":
+		case "name":
 
-			out.Values[i] = ec._PatternResult_visibility(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_name(ctx, field, obj)
 
-			if out.Values[i] == graphql.Null {
-				invalids++
-			}
-		case "catalog_data":
+		case "namespace":
 
-			out.Values[i] = ec._PatternResult_catalog_data(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_namespace(ctx, field, obj)
 
-		case "canSupport":
+		case "status":
 
-			out.Values[i] = ec._PatternResult_canSupport(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_status(ctx, field, obj)
 
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "errmsg":
-
-			out.Values[i] = ec._PatternResult_errmsg(ctx, field, obj)
-
-		case "created_at":
+		case "fields":
 
-			out.Values[i] = ec._PatternResult_created_at(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_fields(ctx, field, obj)
 
-		case "updated_at":
+		case "message":
 
-			out.Values[i] = ec._PatternResult_updated_at(ctx, field, obj)
+			out.Values[i] = ec._PatternServiceDrift_message(ctx, field, obj)
 
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
@@ -18405,6 +22408,49 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
 			}
 
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "fetchPatternDeployments":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fetchPatternDeployments(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
+			out.Concurrently(i, func() graphql.Marshaler {
+				return rrm(innerCtx)
+			})
+		case "getPatternDeployment":
+			field := field
+
+			innerFunc := func(ctx context.Context) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getPatternDeployment(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx, innerFunc)
+			}
+
 			out.Concurrently(i, func() graphql.Marshaler {
 				return rrm(innerCtx)
 			})
@@ -18645,6 +22691,8 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_subscribePerfProfiles(ctx, fields[0])
 	case "subscribePerfResults":
 		return ec._Subscription_subscribePerfResults(ctx, fields[0])
+	case "subscribeLoadTestProgress":
+		return ec._Subscription_subscribeLoadTestProgress(ctx, fields[0])
 	case "subscribeBrokerConnection":
 		return ec._Subscription_subscribeBrokerConnection(ctx, fields[0])
 	case "subscribeMesheryControllersStatus":
@@ -18657,6 +22705,8 @@ func (ec *executionContext) _Subscription(ctx context.Context, sel ast.Selection
 		return ec._Subscription_subscribeClusterResources(ctx, fields[0])
 	case "subscribeK8sContext":
 		return ec._Subscription_subscribeK8sContext(ctx, fields[0])
+	case "subscribePatternDrift":
+		return ec._Subscription_subscribePatternDrift(ctx, fields[0])
 	default:
 		panic("unknown field " + strconv.Quote(fields[0].Name))
 	}
@@ -19422,6 +23472,21 @@ func (ec *executionContext) marshalNDataPlane2ᚖgithubᚗcomᚋlayer5ioᚋmeshe
 	return ec._DataPlane(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
 func (ec *executionContext) unmarshalNID2string(ctx context.Context, v interface{}) (string, error) {
 	res, err := graphql.UnmarshalID(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -19518,72 +23583,208 @@ func (ec *executionContext) marshalNKctlDescribeDetails2ᚖgithubᚗcomᚋlayer5
 	return ec._KctlDescribeDetails(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNLoadTestSnapshot2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLoadTestSnapshot(ctx context.Context, sel ast.SelectionSet, v model.LoadTestSnapshot) graphql.Marshaler {
+	return ec._LoadTestSnapshot(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNLoadTestSnapshot2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLoadTestSnapshot(ctx context.Context, sel ast.SelectionSet, v *model.LoadTestSnapshot) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._LoadTestSnapshot(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNLocation2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐLocation(ctx context.Context, sel ast.SelectionSet, v *model.Location) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
+		return graphql.Null
+	}
+	return ec._Location(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMap2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNMeshSyncEvent2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshSyncEvent(ctx context.Context, sel ast.SelectionSet, v model.MeshSyncEvent) graphql.Marshaler {
+	return ec._MeshSyncEvent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMeshSyncEvent2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshSyncEvent(ctx context.Context, sel ast.SelectionSet, v *model.MeshSyncEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MeshSyncEvent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx context.Context, v interface{}) (model.MesheryController, error) {
+	var res model.MesheryController
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx context.Context, sel ast.SelectionSet, v model.MesheryController) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx context.Context, v interface{}) (model.MesheryControllerStatus, error) {
+	var res model.MesheryControllerStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx context.Context, sel ast.SelectionSet, v model.MesheryControllerStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MesheryControllersStatusListItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMesheryControllersStatusListItem2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItem(ctx context.Context, sel ast.SelectionSet, v *model.MesheryControllersStatusListItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MesheryControllersStatusListItem(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NameSpace) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNNameSpace2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpace(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
-	return ec._Location(ctx, sel, v)
-}
 
-func (ec *executionContext) unmarshalNMap2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
-	res, err := graphql.UnmarshalMap(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ret
 }
 
-func (ec *executionContext) marshalNMap2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+func (ec *executionContext) marshalNNameSpace2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpace(ctx context.Context, sel ast.SelectionSet, v *model.NameSpace) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalMap(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) marshalNMeshSyncEvent2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshSyncEvent(ctx context.Context, sel ast.SelectionSet, v model.MeshSyncEvent) graphql.Marshaler {
-	return ec._MeshSyncEvent(ctx, sel, &v)
+	return ec._NameSpace(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMeshSyncEvent2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMeshSyncEvent(ctx context.Context, sel ast.SelectionSet, v *model.MeshSyncEvent) graphql.Marshaler {
+func (ec *executionContext) marshalNNullString2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNullString(ctx context.Context, sel ast.SelectionSet, v *model.NullString) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._MeshSyncEvent(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx context.Context, v interface{}) (model.MesheryController, error) {
-	var res model.MesheryController
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNMesheryController2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryController(ctx context.Context, sel ast.SelectionSet, v model.MesheryController) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx context.Context, v interface{}) (model.MesheryControllerStatus, error) {
-	var res model.MesheryControllerStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._NullString(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNMesheryControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllerStatus(ctx context.Context, sel ast.SelectionSet, v model.MesheryControllerStatus) graphql.Marshaler {
-	return v
+func (ec *executionContext) marshalNOperatorControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, v model.OperatorControllerStatus) graphql.Marshaler {
+	return ec._OperatorControllerStatus(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MesheryControllersStatusListItem) graphql.Marshaler {
+func (ec *executionContext) marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OperatorControllerStatus) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -19607,7 +23808,7 @@ func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚕᚖgithu
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNMesheryControllersStatusListItem2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItem(ctx, sel, v[i])
+			ret[i] = ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -19627,17 +23828,32 @@ func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚕᚖgithu
 	return ret
 }
 
-func (ec *executionContext) marshalNMesheryControllersStatusListItem2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐMesheryControllersStatusListItem(ctx context.Context, sel ast.SelectionSet, v *model.MesheryControllersStatusListItem) graphql.Marshaler {
+func (ec *executionContext) marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, v *model.OperatorControllerStatus) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._MesheryControllersStatusListItem(ctx, sel, v)
+	return ec._OperatorControllerStatus(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpaceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.NameSpace) graphql.Marshaler {
+func (ec *executionContext) marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx context.Context, sel ast.SelectionSet, v *model.OperatorStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OperatorStatus(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPageFilter2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPageFilter(ctx context.Context, v interface{}) (model.PageFilter, error) {
+	res, err := ec.unmarshalInputPageFilter(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPatternDeployment2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PatternDeployment) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -19661,7 +23877,7 @@ func (ec *executionContext) marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋme
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNNameSpace2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpace(ctx, sel, v[i])
+			ret[i] = ec.marshalNPatternDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeployment(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -19681,31 +23897,65 @@ func (ec *executionContext) marshalNNameSpace2ᚕᚖgithubᚗcomᚋlayer5ioᚋme
 	return ret
 }
 
-func (ec *executionContext) marshalNNameSpace2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNameSpace(ctx context.Context, sel ast.SelectionSet, v *model.NameSpace) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeployment(ctx context.Context, sel ast.SelectionSet, v *model.PatternDeployment) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._NameSpace(ctx, sel, v)
+	return ec._PatternDeployment(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNNullString2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐNullString(ctx context.Context, sel ast.SelectionSet, v *model.NullString) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternDeploymentPage2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentPage(ctx context.Context, sel ast.SelectionSet, v model.PatternDeploymentPage) graphql.Marshaler {
+	return ec._PatternDeploymentPage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPatternDeploymentPage2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentPage(ctx context.Context, sel ast.SelectionSet, v *model.PatternDeploymentPage) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._NullString(ctx, sel, v)
+	return ec._PatternDeploymentPage(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOperatorControllerStatus2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, v model.OperatorControllerStatus) graphql.Marshaler {
-	return ec._OperatorControllerStatus(ctx, sel, &v)
+func (ec *executionContext) marshalNPatternDeploymentService2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentService(ctx context.Context, sel ast.SelectionSet, v *model.PatternDeploymentService) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PatternDeploymentService(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOperatorControllerStatus2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatusᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OperatorControllerStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternDriftReport2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDriftReport(ctx context.Context, sel ast.SelectionSet, v model.PatternDriftReport) graphql.Marshaler {
+	return ec._PatternDriftReport(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPatternDriftReport2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDriftReport(ctx context.Context, sel ast.SelectionSet, v *model.PatternDriftReport) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PatternDriftReport(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPatternFieldDrift2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternFieldDrift(ctx context.Context, sel ast.SelectionSet, v *model.PatternFieldDrift) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PatternFieldDrift(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNPatternOrphanedObject2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternOrphanedObjectᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PatternOrphanedObject) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -19729,7 +23979,7 @@ func (ec *executionContext) marshalNOperatorControllerStatus2ᚕᚖgithubᚗcom
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx, sel, v[i])
+			ret[i] = ec.marshalNPatternOrphanedObject2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternOrphanedObject(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -19749,43 +23999,82 @@ func (ec *executionContext) marshalNOperatorControllerStatus2ᚕᚖgithubᚗcom
 	return ret
 }
 
-func (ec *executionContext) marshalNOperatorControllerStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorControllerStatus(ctx context.Context, sel ast.SelectionSet, v *model.OperatorControllerStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternOrphanedObject2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternOrphanedObject(ctx context.Context, sel ast.SelectionSet, v *model.PatternOrphanedObject) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._OperatorControllerStatus(ctx, sel, v)
+	return ec._PatternOrphanedObject(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOperatorStatus2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐOperatorStatus(ctx context.Context, sel ast.SelectionSet, v *model.OperatorStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternPageResult2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx context.Context, sel ast.SelectionSet, v model.PatternPageResult) graphql.Marshaler {
+	return ec._PatternPageResult(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx context.Context, sel ast.SelectionSet, v *model.PatternPageResult) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._OperatorStatus(ctx, sel, v)
+	return ec._PatternPageResult(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPageFilter2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPageFilter(ctx context.Context, v interface{}) (model.PageFilter, error) {
-	res, err := ec.unmarshalInputPageFilter(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalNPatternServiceDrift2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternServiceDriftᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PatternServiceDrift) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPatternServiceDrift2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternServiceDrift(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNPatternPageResult2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx context.Context, sel ast.SelectionSet, v model.PatternPageResult) graphql.Marshaler {
-	return ec._PatternPageResult(ctx, sel, &v)
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx context.Context, sel ast.SelectionSet, v *model.PatternPageResult) graphql.Marshaler {
+func (ec *executionContext) marshalNPatternServiceDrift2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternServiceDrift(ctx context.Context, sel ast.SelectionSet, v *model.PatternServiceDrift) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PatternPageResult(ctx, sel, v)
+	return ec._PatternServiceDrift(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNPerfPageProfiles2githubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPerfPageProfiles(ctx context.Context, sel ast.SelectionSet, v model.PerfPageProfiles) graphql.Marshaler {
@@ -20613,6 +24902,107 @@ func (ec *executionContext) marshalOOperatorStatusPerK8sContext2ᚖgithubᚗcom
 	return ec._OperatorStatusPerK8sContext(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPatternDeployment2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeployment(ctx context.Context, sel ast.SelectionSet, v *model.PatternDeployment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PatternDeployment(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPatternDeploymentService2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentServiceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PatternDeploymentService) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPatternDeploymentService2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternDeploymentService(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalOPatternFieldDrift2ᚕᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternFieldDriftᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PatternFieldDrift) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPatternFieldDrift2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternFieldDrift(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalOPatternPageResult2ᚖgithubᚗcomᚋlayer5ioᚋmesheryᚋserverᚋinternalᚋgraphqlᚋmodelᚐPatternPageResult(ctx context.Context, sel ast.SelectionSet, v *model.PatternPageResult) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
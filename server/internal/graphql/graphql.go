@@ -1,9 +1,12 @@
 package graphql
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"time"
 
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -14,6 +17,7 @@ import (
 	"github.com/layer5io/meshkit/broker"
 	"github.com/layer5io/meshkit/logger"
 	"github.com/layer5io/meshkit/utils/broadcast"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 type Options struct {
@@ -51,9 +55,51 @@ func New(opts Options) http.Handler {
 		},
 	})
 
+	srv.AroundOperations(authorizeMutations(opts))
+
 	return srv
 }
 
+// authorizeMutations rejects the mutations of the users of the local provider whose
+// role is below operator and those of scoped API tokens, queries and subscriptions
+// are allowed to every role. The rejected mutations are recorded in the audit log
+func authorizeMutations(opts Options) gqlgraphql.OperationMiddleware {
+	return func(ctx context.Context, next gqlgraphql.OperationHandler) gqlgraphql.ResponseHandler {
+		oc := gqlgraphql.GetOperationContext(ctx)
+		if oc.Operation == nil || oc.Operation.Operation != ast.Mutation {
+			return next(ctx)
+		}
+
+		deny := func(format string, args ...interface{}) gqlgraphql.ResponseHandler {
+			for _, mutation := range mutationNames(oc.Operation) {
+				resolver.AuditMutation(ctx, opts.Config, opts.Logger, mutation, nil, models.AuditDenied, fmt.Sprintf(format, args...))
+			}
+			return gqlgraphql.OneShot(gqlgraphql.ErrorResponse(ctx, format, args...))
+		}
+		user, ok := ctx.Value(models.UserCtxKey).(*models.User)
+		if ok && user.Role != "" && !models.UserRole(user.Role).Allows(models.RoleOperator) {
+			return deny("the %s role is not allowed to run mutations, the %s role is required", user.Role, models.RoleOperator)
+		}
+		if token := models.APITokenFromContext(ctx); token != nil && token.IsScoped() {
+			return deny("scoped API tokens are not allowed to run mutations")
+		}
+
+		return next(ctx)
+	}
+}
+
+// mutationNames returns the names of the mutations run by the operation
+func mutationNames(op *ast.OperationDefinition) []string {
+	names := []string{}
+	for _, selection := range op.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok {
+			names = append(names, field.Name)
+		}
+	}
+
+	return names
+}
+
 // NewPlayground returns a graphql playground instance
 func NewPlayground(opts Options) http.Handler {
 	return playground.Handler("GraphQL playground", opts.URL)
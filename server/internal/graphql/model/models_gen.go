@@ -169,6 +169,18 @@ type KctlDescribeDetails struct {
 	Ctxid    *string `json:"ctxid"`
 }
 
+type LoadTestSnapshot struct {
+	TestID       string  `json:"test_id"`
+	Elapsed      float64 `json:"elapsed"`
+	Requests     int     `json:"requests"`
+	QPS          float64 `json:"qps"`
+	LatencyAvgMs float64 `json:"latency_avg_ms"`
+	LatencyP50Ms float64 `json:"latency_p50_ms"`
+	LatencyP90Ms float64 `json:"latency_p90_ms"`
+	LatencyP99Ms float64 `json:"latency_p99_ms"`
+	LatencyMaxMs float64 `json:"latency_max_ms"`
+}
+
 type Location struct {
 	Branch *string `json:"branch"`
 	Host   *string `json:"host"`
@@ -261,6 +273,62 @@ type PageFilter struct {
 	To       *string `json:"to"`
 }
 
+type PatternDeployment struct {
+	ID           string                      `json:"id"`
+	PatternID    *string                     `json:"pattern_id"`
+	PatternName  *string                     `json:"pattern_name"`
+	VersionHash  string                      `json:"version_hash"`
+	ContextIds   []string                    `json:"context_ids"`
+	ContextNames []string                    `json:"context_names"`
+	UserID       *string                     `json:"user_id"`
+	IsDelete     bool                        `json:"is_delete"`
+	Status       string                      `json:"status"`
+	Message      *string                     `json:"message"`
+	Services     []*PatternDeploymentService `json:"services"`
+	StartedAt    *string                     `json:"started_at"`
+	FinishedAt   *string                     `json:"finished_at"`
+}
+
+type PatternDeploymentPage struct {
+	Page        int                  `json:"page"`
+	PageSize    int                  `json:"page_size"`
+	TotalCount  int                  `json:"total_count"`
+	Deployments []*PatternDeployment `json:"deployments"`
+}
+
+type PatternDeploymentService struct {
+	ID      string  `json:"id"`
+	Name    string  `json:"name"`
+	Type    *string `json:"type"`
+	Status  string  `json:"status"`
+	Message *string `json:"message"`
+}
+
+type PatternDriftReport struct {
+	DeploymentID string                   `json:"deployment_id"`
+	PatternID    *string                  `json:"pattern_id"`
+	PatternName  *string                  `json:"pattern_name"`
+	Status       string                   `json:"status"`
+	CheckedAt    *string                  `json:"checked_at"`
+	Services     []*PatternServiceDrift   `json:"services"`
+	Orphans      []*PatternOrphanedObject `json:"orphans"`
+}
+
+type PatternFieldDrift struct {
+	Path    string      `json:"path"`
+	Live    interface{} `json:"live"`
+	Desired interface{} `json:"desired"`
+}
+
+type PatternOrphanedObject struct {
+	ResourceID string  `json:"resource_id"`
+	Context    *string `json:"context"`
+	APIVersion *string `json:"api_version"`
+	Kind       *string `json:"kind"`
+	Name       *string `json:"name"`
+	Namespace  *string `json:"namespace"`
+}
+
 type PatternPageResult struct {
 	Page       int              `json:"page"`
 	PageSize   int              `json:"page_size"`
@@ -282,6 +350,19 @@ type PatternResult struct {
 	UpdatedAt   *string                `json:"updated_at"`
 }
 
+type PatternServiceDrift struct {
+	Service    string               `json:"service"`
+	ResourceID *string              `json:"resource_id"`
+	Context    *string              `json:"context"`
+	APIVersion *string              `json:"api_version"`
+	Kind       *string              `json:"kind"`
+	Name       *string              `json:"name"`
+	Namespace  *string              `json:"namespace"`
+	Status     string               `json:"status"`
+	Fields     []*PatternFieldDrift `json:"fields"`
+	Message    *string              `json:"message"`
+}
+
 type PerfPageProfiles struct {
 	Page       int            `json:"page"`
 	PageSize   int            `json:"page_size"`
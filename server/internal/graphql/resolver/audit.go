@@ -0,0 +1,47 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+
+	gqlgraphql "github.com/99designs/gqlgen/graphql"
+	"github.com/layer5io/meshery/server/models"
+	"github.com/layer5io/meshkit/logger"
+)
+
+// AuditMutation records a GraphQL mutation in the audit log along with the user who ran it,
+// the kubernetes contexts it targets and the hash of the operation and its variables
+func AuditMutation(ctx context.Context, config *models.HandlerConfig, log logger.Handler, mutation string, k8sContexts []string, outcome models.AuditOutcome, detail string) {
+	if config == nil || config.AuditPersister == nil {
+		return
+	}
+
+	event := &models.AuditEvent{
+		Kind:    models.AuditKindGraphQL,
+		Route:   "mutation " + mutation,
+		Outcome: outcome,
+		Detail:  detail,
+	}
+	user, _ := ctx.Value(models.UserCtxKey).(*models.User)
+	provider, _ := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	event.SetActor(user, provider, models.APITokenFromContext(ctx))
+	event.AddK8sContexts(k8sContexts...)
+	if gqlgraphql.HasOperationContext(ctx) {
+		oc := gqlgraphql.GetOperationContext(ctx)
+		variables, _ := json.Marshal(oc.Variables)
+		event.RequestHash = config.AuditPersister.HashRequest([]byte(oc.RawQuery), variables)
+	}
+
+	if err := config.AuditPersister.AppendAuditEvent(event); err != nil {
+		log.Error(ErrAuditMutation(err))
+	}
+}
+
+// auditMutation records the outcome of a mutation run by its resolver
+func (r *Resolver) auditMutation(ctx context.Context, mutation string, k8sContexts []string, err error) {
+	if err != nil {
+		AuditMutation(ctx, r.Config, r.Log, mutation, k8sContexts, models.AuditFailure, err.Error())
+		return
+	}
+	AuditMutation(ctx, r.Config, r.Log, mutation, k8sContexts, models.AuditSuccess, "")
+}
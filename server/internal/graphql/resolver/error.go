@@ -31,6 +31,10 @@ const (
 	ErrK8sContextCode                       = "2245"
 	ErrClusterResourcesSubscriptionCode     = "2246"
 	ErrGettingClusterResourcesCode          = "2247"
+	ErrFetchingPatternDeploymentsCode       = "2260"
+	ErrPatternDriftSubscriptionCode         = "2263"
+	ErrLoadTestNotRunningCode               = "2277"
+	ErrAuditMutationCode                    = "2296"
 )
 
 var (
@@ -91,6 +95,10 @@ func ErrMesheryClient(err error) error {
 func ErrGettingNamespace(err error) error {
 	return errors.New(ErrGettingNamespaceCode, errors.Alert, []string{"Cannot get available namespaces"}, []string{err.Error()}, []string{"The table in the database might not exist"}, []string{})
 }
+func ErrFetchingPatternDeployments(err error) error {
+	return errors.New(ErrFetchingPatternDeploymentsCode, errors.Alert, []string{"Cannot fetch pattern deployments"}, []string{err.Error()}, []string{"The provider might not support persisting pattern deployments"}, []string{"Make sure that the selected provider supports pattern deployments"})
+}
+
 func ErrFetchingPatterns(err error) error {
 	return errors.New(ErrFetchingPatternsCode, errors.Alert, []string{"Cannot fetch patterns"}, []string{err.Error()}, []string{"There might be something wrong with the Meshery or Meshery Cloud"}, []string{"Try again, if still exist, please post an issue on Meshery repository"})
 }
@@ -151,3 +159,15 @@ func ErrGettingClusterResources(err error) error {
 func ErrK8sContextSubscription(err error) error {
 	return errors.New(ErrK8sContextCode, errors.Alert, []string{"Failed to get k8s context from remote provider", err.Error()}, []string{"There might be something wrong with the Meshery or Meshery Cloud"}, []string{"Could be a network issue"}, nil)
 }
+
+func ErrPatternDriftSubscription(err error) error {
+	return errors.New(ErrPatternDriftSubscriptionCode, errors.Alert, []string{"Pattern drift subscription failed"}, []string{err.Error()}, []string{"Pattern deployment was not recorded along with its pattern file", "MeshSync data could not be read from the database"}, []string{"Deploy the pattern again to record its pattern file", "Make sure that MeshSync is running in the selected kubernetes contexts"})
+}
+
+func ErrLoadTestNotRunning(testID string) error {
+	return errors.New(ErrLoadTestNotRunningCode, errors.Alert, []string{"Load test progress subscription failed"}, []string{"No load test with the uuid " + testID + " is running"}, []string{"The load test is already over or has not started yet", "The load test was started by another user"}, []string{"Subscribe to the progress of the load test once it is running"})
+}
+
+func ErrAuditMutation(err error) error {
+	return errors.New(ErrAuditMutationCode, errors.Alert, []string{"Error failed to record the mutation in the audit log"}, []string{err.Error()}, []string{"The database of Meshery is not reachable"}, []string{"Check the logs of Meshery and the database"})
+}
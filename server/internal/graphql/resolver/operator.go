@@ -396,3 +396,16 @@ func (r *Resolver) listenToOperatorsState(ctx context.Context, provider models.P
 	}()
 	return operatorChannel, nil
 }
+
+// operatorStatusContexts returns the context whose operator is changed, the first
+// selected context when none is given
+func operatorStatusContexts(ctx context.Context, ctxID string) []string {
+	if ctxID != "" {
+		return []string{ctxID}
+	}
+	if k8scontexts, ok := ctx.Value(models.KubeClustersKey).([]models.K8sContext); ok && len(k8scontexts) > 0 {
+		return []string{k8scontexts[0].ID}
+	}
+
+	return nil
+}
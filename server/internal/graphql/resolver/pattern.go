@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/layer5io/meshery/server/handlers"
 	"github.com/layer5io/meshery/server/internal/graphql/model"
@@ -36,3 +37,110 @@ func (r *Resolver) fetchPatterns(ctx context.Context, provider models.Provider,
 
 	return patterns, nil
 }
+
+func (r *Resolver) fetchPatternDeployments(ctx context.Context, provider models.Provider, selector model.PageFilter, patternID *string) (*model.PatternDeploymentPage, error) {
+	tokenString := ctx.Value(models.TokenCtxKey).(string)
+
+	order := ""
+	if selector.Order != nil {
+		order = *selector.Order
+	}
+	id := ""
+	if patternID != nil {
+		id = *patternID
+	}
+
+	resp, err := provider.GetPatternDeployments(tokenString, selector.Page, selector.PageSize, order, id)
+	if err != nil {
+		r.Log.Error(ErrFetchingPatternDeployments(err))
+		return nil, err
+	}
+
+	deployments := &model.PatternDeploymentPage{}
+	if err := json.Unmarshal(resp, deployments); err != nil {
+		obj := "pattern deployments"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	return deployments, nil
+}
+
+func (r *Resolver) getPatternDeployment(ctx context.Context, provider models.Provider, id string) (*model.PatternDeployment, error) {
+	tokenString := ctx.Value(models.TokenCtxKey).(string)
+
+	resp, err := provider.GetPatternDeployment(tokenString, id)
+	if err != nil {
+		r.Log.Error(ErrFetchingPatternDeployments(err))
+		return nil, err
+	}
+
+	deployment := &model.PatternDeployment{}
+	if err := json.Unmarshal(resp, deployment); err != nil {
+		obj := "pattern deployment"
+		return nil, handlers.ErrUnmarshal(err, obj)
+	}
+
+	return deployment, nil
+}
+
+// patternDriftInterval is the interval at which the drift is evaluated again
+// even if MeshSync didn't report any change in the clusters
+const patternDriftInterval = 30 * time.Second
+
+func (r *Resolver) subscribePatternDrift(ctx context.Context, provider models.Provider, deploymentID string) (<-chan *model.PatternDriftReport, error) {
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	respChan := make(chan *model.PatternDriftReport)
+
+	r.Config.DashboardK8sResourcesChan.SubscribeDashbordK8Resources(ch)
+
+	go func() {
+		r.Log.Info("Pattern drift subscription started")
+		ticker := time.NewTicker(patternDriftInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ch:
+			case <-ticker.C:
+			case <-ctx.Done():
+				r.Log.Info("Pattern drift subscription stopped")
+				return
+			}
+
+			report, err := r.getPatternDrift(ctx, provider, deploymentID)
+			if err != nil {
+				r.Log.Error(ErrPatternDriftSubscription(err))
+				continue
+			}
+
+			select {
+			case respChan <- report:
+			case <-ctx.Done():
+				r.Log.Info("Pattern drift subscription stopped")
+				return
+			}
+		}
+	}()
+
+	return respChan, nil
+}
+
+func (r *Resolver) getPatternDrift(ctx context.Context, provider models.Provider, deploymentID string) (*model.PatternDriftReport, error) {
+	report, err := handlers.DetectPatternDrift(ctx, provider, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	byt, err := json.Marshal(report)
+	if err != nil {
+		return nil, handlers.ErrMarshal(err, "pattern drift")
+	}
+
+	drift := &model.PatternDriftReport{}
+	if err := json.Unmarshal(byt, drift); err != nil {
+		return nil, handlers.ErrUnmarshal(err, "pattern drift")
+	}
+
+	return drift, nil
+}
@@ -135,6 +135,64 @@ func (r *Resolver) subscribePerfResults(ctx context.Context, provider models.Pro
 	return perfResultChannel, nil
 }
 
+func (r *Resolver) subscribeLoadTestProgress(ctx context.Context, testID string) (<-chan *model.LoadTestSnapshot, error) {
+	// the load tests of other users are reported as not running
+	run, ok := r.Config.LoadTestRuns.Get(testID)
+	user, _ := ctx.Value(models.UserCtxKey).(*models.User)
+	if ok && run.UserID != "" && (user == nil || run.UserID != user.UserID) {
+		ok = false
+	}
+	snapshots, unsubscribe, subscribed := r.Config.LoadTestRuns.Subscribe(testID)
+	if !ok || !subscribed {
+		if subscribed {
+			unsubscribe()
+		}
+		r.Log.Error(ErrLoadTestNotRunning(testID))
+		return nil, ErrLoadTestNotRunning(testID)
+	}
+
+	progressChannel := make(chan *model.LoadTestSnapshot)
+
+	go func() {
+		r.Log.Info("Load test progress subscription started")
+		defer unsubscribe()
+		// closing the channel ends the subscription once the load test is over
+		defer close(progressChannel)
+
+		for {
+			select {
+			case s, ok := <-snapshots:
+				if !ok {
+					r.Log.Info("Load test progress subscription stopped")
+					return
+				}
+				select {
+				case progressChannel <- &model.LoadTestSnapshot{
+					TestID:       s.TestID,
+					Elapsed:      s.Elapsed,
+					Requests:     int(s.Requests),
+					QPS:          s.QPS,
+					LatencyAvgMs: s.LatencyAvg,
+					LatencyP50Ms: s.LatencyP50,
+					LatencyP90Ms: s.LatencyP90,
+					LatencyP99Ms: s.LatencyP99,
+					LatencyMaxMs: s.LatencyMax,
+				}:
+				case <-ctx.Done():
+					r.Log.Info("Load test progress subscription stopped")
+					return
+				}
+
+			case <-ctx.Done():
+				r.Log.Info("Load test progress subscription stopped")
+				return
+			}
+		}
+	}()
+
+	return progressChannel, nil
+}
+
 func (r *Resolver) subscribePerfProfiles(ctx context.Context, provider models.Provider, selector model.PageFilter) (<-chan *model.PerfPageProfiles, error) {
 	performanceProfilesChannel := make(chan *model.PerfPageProfiles)
 
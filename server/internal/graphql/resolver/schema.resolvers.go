@@ -17,7 +17,9 @@ import (
 
 func (r *mutationResolver) ChangeOperatorStatus(ctx context.Context, input *model.OperatorStatusInput) (model.Status, error) {
 	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
-	return r.changeOperatorStatus(ctx, provider, input.TargetStatus, input.ContextID)
+	status, err := r.changeOperatorStatus(ctx, provider, input.TargetStatus, input.ContextID)
+	r.auditMutation(ctx, "changeOperatorStatus", operatorStatusContexts(ctx, input.ContextID), err)
+	return status, err
 }
 
 func (r *queryResolver) GetAvailableAddons(ctx context.Context, filter *model.ServiceMeshFilter) ([]*model.AddonList, error) {
@@ -108,6 +110,16 @@ func (r *queryResolver) FetchPatterns(ctx context.Context, selector model.PageFi
 	return r.fetchPatterns(ctx, provider, selector)
 }
 
+func (r *queryResolver) FetchPatternDeployments(ctx context.Context, selector model.PageFilter, patternID *string) (*model.PatternDeploymentPage, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.fetchPatternDeployments(ctx, provider, selector, patternID)
+}
+
+func (r *queryResolver) GetPatternDeployment(ctx context.Context, id string) (*model.PatternDeployment, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.getPatternDeployment(ctx, provider, id)
+}
+
 func (r *queryResolver) GetWorkloads(ctx context.Context, name *string, id *string, trim *bool) ([]*model.OAMCapability, error) {
 	return r.getWorkloads(ctx, name, id, trim)
 }
@@ -187,6 +199,10 @@ func (r *subscriptionResolver) SubscribePerfResults(ctx context.Context, selecto
 	return r.subscribePerfResults(ctx, provider, selector, profileID)
 }
 
+func (r *subscriptionResolver) SubscribeLoadTestProgress(ctx context.Context, testID string) (<-chan *model.LoadTestSnapshot, error) {
+	return r.subscribeLoadTestProgress(ctx, testID)
+}
+
 func (r *subscriptionResolver) SubscribeBrokerConnection(ctx context.Context) (<-chan bool, error) {
 	return r.subscribeBrokerConnection(ctx)
 }
@@ -301,6 +317,11 @@ func (r *subscriptionResolver) SubscribeK8sContext(ctx context.Context, selector
 	return r.subscribeK8sContexts(ctx, provider, selector)
 }
 
+func (r *subscriptionResolver) SubscribePatternDrift(ctx context.Context, deploymentID string) (<-chan *model.PatternDriftReport, error) {
+	provider := ctx.Value(models.ProviderCtxKey).(models.Provider)
+	return r.subscribePatternDrift(ctx, provider, deploymentID)
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
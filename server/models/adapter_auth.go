@@ -0,0 +1,157 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultAdapterTrustedCIDRs are the networks the adapters are trusted from by default, the
+// loopback and the private networks which the docker and kubernetes deployments use
+var DefaultAdapterTrustedCIDRs = []string{
+	"127.0.0.0/8",
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"::1/128",
+	"fc00::/7",
+}
+
+// AdapterAuth authenticates the adapters which register their capabilities with Meshery.
+// An adapter has to connect from a trusted network and, when a shared secret or a client
+// CA is configured, to present the secret as a bearer token or a verified client certificate
+type AdapterAuth struct {
+	token           string
+	trustedNetworks []*net.IPNet
+	// tlsIdentities are the common names or DNS names the client certificates may have,
+	// every verified certificate is accepted if empty
+	tlsIdentities []string
+	// requireIdentity is true if the network of the adapter alone is not enough
+	requireIdentity bool
+}
+
+// NewAdapterAuth returns the authentication of the adapters, clientCerts tells if the server
+// verifies the client certificates against a CA. Without a token nor client certificates the
+// adapters are trusted by their network alone, which trustNetwork has to enable explicitly
+func NewAdapterAuth(token string, trustedCIDRs []string, clientCerts bool, tlsIdentities []string, trustNetwork bool) (*AdapterAuth, error) {
+	if token == "" && !clientCerts && !trustNetwork {
+		return nil, ErrAdapterAuth(fmt.Errorf("the adapters need a registration token or a client certificate unless they are trusted by their network"))
+	}
+
+	aa := &AdapterAuth{
+		token:           token,
+		tlsIdentities:   tlsIdentities,
+		requireIdentity: token != "" || clientCerts,
+	}
+	for _, cidr := range trustedCIDRs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, ErrAdapterAuth(err)
+		}
+		aa.trustedNetworks = append(aa.trustedNetworks, network)
+	}
+
+	return aa, nil
+}
+
+// adapterTokenSize is the size of the generated registration tokens
+const adapterTokenSize = 32
+
+// LoadAdapterRegistrationToken returns the registration token stored in the file, which is
+// generated on the first call so that it can be shared with the bundled adapters
+func LoadAdapterRegistrationToken(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		generated := make([]byte, adapterTokenSize)
+		if _, err := rand.Read(generated); err != nil {
+			return "", ErrAdapterAuth(err)
+		}
+		token := hex.EncodeToString(generated)
+		if err := os.WriteFile(path, []byte(token), 0600); err != nil {
+			return "", ErrAdapterAuth(err)
+		}
+		return token, nil
+	}
+	if err != nil {
+		return "", ErrAdapterAuth(err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", ErrAdapterAuth(fmt.Errorf("the registration token file %s is empty", path))
+	}
+
+	return token, nil
+}
+
+// RequiresIdentity returns true if the adapters have to present a secret or a certificate
+func (aa *AdapterAuth) RequiresIdentity() bool {
+	return aa.requireIdentity
+}
+
+// Authenticate returns the identity of the adapter which sent the request, the status
+// tells if the adapter is unauthenticated or not allowed from its network
+func (aa *AdapterAuth) Authenticate(req *http.Request) (string, int, error) {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !aa.trusts(ip) {
+		return "", http.StatusForbidden, ErrAdapterAuth(fmt.Errorf("adapters are not trusted from %s", host))
+	}
+
+	if identity, ok := aa.tlsIdentity(req); ok {
+		return identity, http.StatusOK, nil
+	}
+	if aa.token != "" {
+		secret := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(secret), []byte(aa.token)) == 1 {
+			return "token from " + host, http.StatusOK, nil
+		}
+	}
+	if aa.requireIdentity {
+		return "", http.StatusUnauthorized, ErrAdapterAuth(fmt.Errorf("the adapter at %s presented neither the registration token nor a trusted client certificate", host))
+	}
+
+	return host, http.StatusOK, nil
+}
+
+func (aa *AdapterAuth) trusts(ip net.IP) bool {
+	for _, network := range aa.trustedNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tlsIdentity returns the name of the client certificate the server verified
+func (aa *AdapterAuth) tlsIdentity(req *http.Request) (string, bool) {
+	if req.TLS == nil || len(req.TLS.VerifiedChains) == 0 || len(req.TLS.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	cert := req.TLS.VerifiedChains[0][0]
+	if len(aa.tlsIdentities) == 0 {
+		return cert.Subject.CommonName, true
+	}
+	for _, name := range append([]string{cert.Subject.CommonName}, cert.DNSNames...) {
+		for _, identity := range aa.tlsIdentities {
+			if name != "" && name == identity {
+				return name, true
+			}
+		}
+	}
+
+	return "", false
+}
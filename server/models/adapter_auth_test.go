@@ -0,0 +1,81 @@
+package models
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAdapterAuth(t *testing.T) {
+	if _, err := NewAdapterAuth("", []string{"10.0.0.0/33"}, false, nil, true); err == nil {
+		t.Error("invalid CIDR is accepted")
+	}
+	if _, err := NewAdapterAuth("", DefaultAdapterTrustedCIDRs, false, nil, false); err == nil {
+		t.Error("adapters are trusted by their network without the opt-in")
+	}
+
+	networkOnly, err := NewAdapterAuth("", DefaultAdapterTrustedCIDRs, false, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withToken, err := NewAdapterAuth("s3cret", []string{"10.0.0.0/8"}, false, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withCerts, err := NewAdapterAuth("", []string{"10.0.0.0/8"}, true, []string{"meshery-istio"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(remoteAddr, token, certName string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/api/oam/workload", nil)
+		req.RemoteAddr = remoteAddr
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		if certName != "" {
+			cert := &x509.Certificate{Subject: pkix.Name{CommonName: certName}}
+			req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		}
+		return req
+	}
+
+	for _, tc := range []struct {
+		name   string
+		auth   *AdapterAuth
+		req    *http.Request
+		status int
+	}{
+		{"private network", networkOnly, request("172.18.0.5:41000", "", ""), http.StatusOK},
+		{"public network", networkOnly, request("203.0.113.7:41000", "", ""), http.StatusForbidden},
+		{"token", withToken, request("10.1.2.3:41000", "s3cret", ""), http.StatusOK},
+		{"wrong token", withToken, request("10.1.2.3:41000", "guess", ""), http.StatusUnauthorized},
+		{"no token", withToken, request("10.1.2.3:41000", "", ""), http.StatusUnauthorized},
+		{"token from an untrusted network", withToken, request("192.168.1.2:41000", "s3cret", ""), http.StatusForbidden},
+		{"certificate", withCerts, request("10.1.2.3:41000", "", "meshery-istio"), http.StatusOK},
+		{"certificate of another identity", withCerts, request("10.1.2.3:41000", "", "meshery-linkerd"), http.StatusUnauthorized},
+	} {
+		if _, status, err := tc.auth.Authenticate(tc.req); status != tc.status {
+			t.Errorf("%s: status %d, want %d: %v", tc.name, status, tc.status, err)
+		}
+	}
+}
+
+func TestLoadAdapterRegistrationToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "adapter-registration.token")
+	token, err := LoadAdapterRegistrationToken(path)
+	if err != nil || len(token) != 2*adapterTokenSize {
+		t.Fatalf("generated token %q: %v", token, err)
+	}
+	if reloaded, err := LoadAdapterRegistrationToken(path); err != nil || reloaded != token {
+		t.Errorf("token is not kept across restarts: %q %v", reloaded, err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("token file is readable by others: %v", err)
+	}
+}
@@ -0,0 +1,282 @@
+package models
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	// APITokenPrefix prefixes the API tokens so that they can be told apart from the
+	// session tokens sent as bearer tokens
+	APITokenPrefix = "mshry_"
+
+	// DefaultAPITokenExpiry and MaxAPITokenExpiry bound the lifetime of the API tokens
+	DefaultAPITokenExpiry = 30 * 24 * time.Hour
+	MaxAPITokenExpiry     = 365 * 24 * time.Hour
+)
+
+// APITokenScope restricts what an API token is allowed to do, a token without
+// scopes is allowed to do whatever its user is allowed to do
+type APITokenScope string
+
+const (
+	// ScopeReadOnly - the token can only read, it can't be combined with other scopes
+	ScopeReadOnly APITokenScope = "read-only"
+
+	// ScopePatternDeploy - the token can also deploy, undeploy and reconcile patterns
+	ScopePatternDeploy APITokenScope = "pattern:deploy"
+
+	// ScopePerfRun - the token can also save performance profiles and run or cancel load tests
+	ScopePerfRun APITokenScope = "perf:run"
+)
+
+// APITokenScopes are the known scopes
+var APITokenScopes = []APITokenScope{ScopeReadOnly, ScopePatternDeploy, ScopePerfRun}
+
+// APIToken is a token issued by Meshery to automate its API, bound to a user or to a
+// named service account. Only the hash of the token is persisted
+type APIToken struct {
+	ID   *uuid.UUID `json:"id,omitempty"`
+	Name string     `json:"name"`
+
+	// Provider is the name of the provider the token was issued under
+	Provider string `json:"provider"`
+	// UserID is the id of the user who created the token, requests made with the
+	// token act as this user unless the token belongs to a service account
+	UserID string `json:"user_id" gorm:"index"`
+	// ServiceAccount is the name of the service account the token belongs to
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Role is the role of the service account on the local provider
+	Role UserRole `json:"role,omitempty"`
+
+	Scopes pq.StringArray `json:"scopes,omitempty" gorm:"type:text[]"`
+	// Contexts is the allow-list of the ids of the kubernetes contexts the token can use
+	Contexts pq.StringArray `json:"contexts,omitempty" gorm:"type:text[]"`
+
+	TokenHash string `json:"-" gorm:"uniqueIndex"`
+	// SealedProviderToken is the session token of the remote provider of the user,
+	// encrypted with a key derived from the API token
+	SealedProviderToken string `json:"-"`
+
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// APITokenRequest is the payload creating an API token
+type APITokenRequest struct {
+	Name string `json:"name"`
+	// ServiceAccount binds the token to a service account instead of the user
+	ServiceAccount string `json:"service_account,omitempty"`
+	// Role is the role of the service account on the local provider in multi-user
+	// mode, it defaults to the role of the user and can't exceed it
+	Role     UserRole        `json:"role,omitempty"`
+	Scopes   []APITokenScope `json:"scopes,omitempty"`
+	Contexts []string        `json:"contexts,omitempty"`
+	// ExpiresIn is the lifetime of the token, 720h by default and 8760h at most
+	ExpiresIn string `json:"expires_in,omitempty"`
+}
+
+// Validate checks the name, the scopes and the lifetime of the token
+func (r *APITokenRequest) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("the name of the token is required")
+	}
+	for _, s := range r.Scopes {
+		if !s.IsValid() {
+			return fmt.Errorf("unknown scope %q, the scopes are %s", s, joinScopes(APITokenScopes))
+		}
+		if s == ScopeReadOnly && len(r.Scopes) > 1 {
+			return fmt.Errorf("the %s scope can't be combined with other scopes", ScopeReadOnly)
+		}
+	}
+	if r.Role != "" && !r.Role.IsValid() {
+		return fmt.Errorf("invalid role %q", r.Role)
+	}
+	if _, err := r.Expiry(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Expiry returns the lifetime of the token
+func (r *APITokenRequest) Expiry() (time.Duration, error) {
+	if r.ExpiresIn == "" {
+		return DefaultAPITokenExpiry, nil
+	}
+
+	d, err := time.ParseDuration(r.ExpiresIn)
+	if err != nil {
+		return 0, fmt.Errorf("invalid expiry %q: %s", r.ExpiresIn, err)
+	}
+	if d <= 0 || d > MaxAPITokenExpiry {
+		return 0, fmt.Errorf("the expiry should be positive and at most %s", MaxAPITokenExpiry)
+	}
+
+	return d, nil
+}
+
+// IsValid returns true if the scope is one of the known scopes
+func (s APITokenScope) IsValid() bool {
+	for _, scope := range APITokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinScopes(scopes []APITokenScope) string {
+	s := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		s = append(s, string(scope))
+	}
+
+	return strings.Join(s, ", ")
+}
+
+// IsScoped returns true if the token is restricted to its scopes
+func (t *APIToken) IsScoped() bool {
+	return len(t.Scopes) > 0
+}
+
+// HasScope returns true if the token is unrestricted or has the scope
+func (t *APIToken) HasScope(scope APITokenScope) bool {
+	if !t.IsScoped() {
+		return true
+	}
+	for _, s := range t.Scopes {
+		if APITokenScope(s) == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsContext returns true if the token is allowed to use the kubernetes context
+func (t *APIToken) AllowsContext(id string) bool {
+	if len(t.Contexts) == 0 {
+		return true
+	}
+	for _, c := range t.Contexts {
+		if c == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsExpired returns true if the token has expired
+func (t *APIToken) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// ServiceAccountUser returns the user requests made with the token of a service account act as
+func (t *APIToken) ServiceAccountUser() *User {
+	return &User{
+		UserID:    "serviceaccount:" + t.ServiceAccount,
+		FirstName: t.ServiceAccount,
+		Role:      string(t.Role),
+	}
+}
+
+// SealProviderToken encrypts the session token of the remote provider with the API token
+func (t *APIToken) SealProviderToken(secret, providerToken string) error {
+	gcm, err := apiTokenCipher(secret)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	t.SealedProviderToken = base64.StdEncoding.EncodeToString(gcm.Seal(nonce, nonce, []byte(providerToken), nil))
+	return nil
+}
+
+// OpenProviderToken decrypts the session token of the remote provider with the API token
+func (t *APIToken) OpenProviderToken(secret string) (string, error) {
+	if t.SealedProviderToken == "" {
+		return "", nil
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(t.SealedProviderToken)
+	if err != nil {
+		return "", err
+	}
+	gcm, err := apiTokenCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("the sealed provider token is truncated")
+	}
+
+	token, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	return string(token), err
+}
+
+// apiTokenCipher returns the cipher of the provider token of the API token, its key is
+// derived differently from the hash under which the token is persisted
+func apiTokenCipher(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte("meshery-provider-token:" + secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// newAPITokenSecret returns a random API token
+func newAPITokenSecret() (string, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return "", err
+	}
+
+	return APITokenPrefix + token, nil
+}
+
+// BearerAPIToken returns the API token sent as bearer token by the request, if any
+func BearerAPIToken(req *http.Request) string {
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return ""
+	}
+	auth = strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	if !strings.HasPrefix(auth, APITokenPrefix) {
+		return ""
+	}
+
+	return auth
+}
+
+// InjectProviderToken sets the session token of the remote provider on the request, as
+// if it was sent by the browser of the user
+func InjectProviderToken(req *http.Request, providerToken string) {
+	req.AddCookie(&http.Cookie{Name: tokenName, Value: providerToken})
+}
+
+// APITokenFromContext returns the API token the request was authenticated with, if any
+func APITokenFromContext(ctx context.Context) *APIToken {
+	token, _ := ctx.Value(APITokenCtxKey).(*APIToken)
+	return token
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// APITokenPersister is the persister for persisting
+// the API tokens on the database
+type APITokenPersister struct {
+	DB *database.Handler
+}
+
+// CreateAPIToken saves the token with a new secret and returns the secret, it
+// is the only time the secret is known
+func (tp *APITokenPersister) CreateAPIToken(token *APIToken, providerToken string) (string, error) {
+	secret, err := newAPITokenSecret()
+	if err != nil {
+		return "", err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", ErrGenerateUUID(err)
+	}
+	token.ID = &id
+	token.TokenHash = hashSessionToken(secret)
+	if providerToken != "" {
+		if err := token.SealProviderToken(secret, providerToken); err != nil {
+			return "", err
+		}
+	}
+
+	return secret, tp.DB.Create(token).Error
+}
+
+// GetAPITokens returns the tokens issued under the provider, only those of the user if given
+func (tp *APITokenPersister) GetAPITokens(provider, userID string) ([]APIToken, error) {
+	tokens := []APIToken{}
+	query := tp.DB.Where("provider = ?", provider)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+
+	err := query.Order("created_at desc").Find(&tokens).Error
+	return tokens, err
+}
+
+// GetAPIToken returns the token with the given id
+func (tp *APITokenPersister) GetAPIToken(id uuid.UUID) (*APIToken, error) {
+	token := &APIToken{}
+	if err := tp.DB.First(token, id).Error; err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// GetAPITokenBySecret returns the token with the given secret, the token should not
+// have expired. The time the token was last used is updated
+func (tp *APITokenPersister) GetAPITokenBySecret(secret string) (*APIToken, error) {
+	token := &APIToken{}
+	if err := tp.DB.Where("token_hash = ?", hashSessionToken(secret)).First(token).Error; err != nil {
+		return nil, ErrAPIToken(fmt.Errorf("unknown or revoked token"))
+	}
+	if token.IsExpired() {
+		return nil, ErrAPIToken(fmt.Errorf("the token %s expired on %s", token.Name, token.ExpiresAt.Format(time.RFC3339)))
+	}
+
+	now := time.Now()
+	token.LastUsedAt = &now
+	if err := tp.DB.Model(token).UpdateColumn("last_used_at", now).Error; err != nil {
+		return nil, ErrAPIToken(err)
+	}
+
+	return token, nil
+}
+
+// DeleteAPIToken revokes the token with the given id
+func (tp *APITokenPersister) DeleteAPIToken(id uuid.UUID) error {
+	return tp.DB.Delete(&APIToken{ID: &id}).Error
+}
+
+// DeleteUserAPITokens revokes the tokens created by the user under the provider
+func (tp *APITokenPersister) DeleteUserAPITokens(provider, userID string) error {
+	return tp.DB.Where("provider = ? AND user_id = ?", provider, userID).Delete(&APIToken{}).Error
+}
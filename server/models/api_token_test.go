@@ -0,0 +1,112 @@
+package models
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func newTestAPITokenPersister(t *testing.T) *APITokenPersister {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&APIToken{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &APITokenPersister{DB: &db}
+}
+
+func TestAPITokenRequestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		req   APITokenRequest
+		valid bool
+	}{
+		{APITokenRequest{Name: "ci"}, true},
+		{APITokenRequest{Name: "ci", Scopes: []APITokenScope{ScopePatternDeploy, ScopePerfRun}, ExpiresIn: "168h"}, true},
+		{APITokenRequest{Name: " "}, false},
+		{APITokenRequest{Name: "ci", Scopes: []APITokenScope{"cluster:admin"}}, false},
+		{APITokenRequest{Name: "ci", Scopes: []APITokenScope{ScopeReadOnly, ScopePerfRun}}, false},
+		{APITokenRequest{Name: "ci", Role: "root"}, false},
+		{APITokenRequest{Name: "ci", ExpiresIn: "87600h"}, false},
+		{APITokenRequest{Name: "ci", ExpiresIn: "-1h"}, false},
+	} {
+		if err := tc.req.Validate(); (err == nil) != tc.valid {
+			t.Errorf("Validate(%+v) = %v, want valid %t", tc.req, err, tc.valid)
+		}
+	}
+}
+
+func TestAPITokenScopes(t *testing.T) {
+	unscoped := &APIToken{}
+	if !unscoped.HasScope(ScopePatternDeploy) || !unscoped.AllowsContext("any") {
+		t.Error("token without scopes nor contexts is restricted")
+	}
+
+	scoped := &APIToken{Scopes: []string{string(ScopePerfRun)}, Contexts: []string{"a"}}
+	if !scoped.HasScope(ScopePerfRun) || scoped.HasScope(ScopePatternDeploy) {
+		t.Errorf("token with scopes %v", scoped.Scopes)
+	}
+	if !scoped.AllowsContext("a") || scoped.AllowsContext("b") {
+		t.Errorf("token with contexts %v", scoped.Contexts)
+	}
+}
+
+func TestAPITokenPersister(t *testing.T) {
+	tp := newTestAPITokenPersister(t)
+
+	token := &APIToken{Name: "ci", Provider: "Meshery", UserID: "alice", ExpiresAt: time.Now().Add(time.Hour)}
+	secret, err := tp.CreateAPIToken(token, "session of alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.TokenHash == secret || token.SealedProviderToken == "" {
+		t.Error("the secret or the provider token is stored in clear")
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/user", nil)
+	req.Header.Set("Authorization", "Bearer "+secret)
+	found, err := tp.GetAPITokenBySecret(BearerAPIToken(req))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if providerToken, err := found.OpenProviderToken(secret); err != nil || providerToken != "session of alice" {
+		t.Errorf("provider token %q: %v", providerToken, err)
+	}
+	if _, err := found.OpenProviderToken(secret + "x"); err == nil {
+		t.Error("provider token is opened with another secret")
+	}
+	if found.LastUsedAt == nil {
+		t.Error("last use of the token is not recorded")
+	}
+
+	expired := &APIToken{Name: "old", Provider: "Meshery", UserID: "alice", ExpiresAt: time.Now().Add(-time.Minute)}
+	expiredSecret, _ := tp.CreateAPIToken(expired, "")
+	if _, err := tp.GetAPITokenBySecret(expiredSecret); err == nil {
+		t.Error("expired token is accepted")
+	}
+
+	if tokens, err := tp.GetAPITokens("Meshery", "alice"); err != nil || len(tokens) != 2 {
+		t.Errorf("tokens %v of alice: %v", tokens, err)
+	}
+	if err := tp.DeleteUserAPITokens("Meshery", "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tp.GetAPITokenBySecret(secret); err == nil {
+		t.Error("revoked token is accepted")
+	}
+}
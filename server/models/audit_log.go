@@ -0,0 +1,253 @@
+package models
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// AuditEventKind tells what recorded an audit event
+type AuditEventKind string
+
+const (
+	// AuditKindHTTP - a mutating call of the REST API
+	AuditKindHTTP AuditEventKind = "http"
+	// AuditKindGraphQL - a GraphQL mutation
+	AuditKindGraphQL AuditEventKind = "graphql"
+	// AuditKindSystem - an operation of Meshery itself on the audit log, like the retention
+	AuditKindSystem AuditEventKind = "system"
+)
+
+// AuditOutcome is the outcome of an audited operation
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditFailure AuditOutcome = "failure"
+	// AuditDenied - the caller was not authenticated or not allowed to perform the operation
+	AuditDenied AuditOutcome = "denied"
+)
+
+// AuditEvent is an entry of the append-only audit trail. Every event holds the hash
+// of the previous one, so that a removed or modified event breaks the chain
+type AuditEvent struct {
+	ID        uint64         `json:"id" gorm:"primaryKey;autoIncrement"`
+	Timestamp time.Time      `json:"timestamp" gorm:"index"`
+	Kind      AuditEventKind `json:"kind"`
+
+	UserID     string `json:"user_id,omitempty" gorm:"index"`
+	Provider   string `json:"provider,omitempty"`
+	APITokenID string `json:"api_token_id,omitempty"`
+	RemoteAddr string `json:"remote_addr,omitempty"`
+
+	// Method and Route are the method and the path template of the API call, Route is
+	// the operation and its fields for GraphQL mutations
+	Method string `json:"method,omitempty"`
+	Route  string `json:"route" gorm:"index"`
+	Path   string `json:"path,omitempty"`
+
+	K8sContexts pq.StringArray `json:"k8s_contexts,omitempty" gorm:"type:text[]"`
+	PatternIDs  pq.StringArray `json:"pattern_ids,omitempty" gorm:"type:text[]"`
+	AdapterIDs  pq.StringArray `json:"adapter_ids,omitempty" gorm:"type:text[]"`
+
+	Outcome AuditOutcome `json:"outcome" gorm:"index"`
+	Status  int          `json:"status,omitempty"`
+	Detail  string       `json:"detail,omitempty"`
+	// RequestHash is the HMAC-SHA256 of the method, the URL and the body of the API call,
+	// or of the operation and the variables of the GraphQL mutation. It is keyed with a
+	// secret of the server so that the requests can't be brute-forced from the log
+	RequestHash string `json:"request_hash,omitempty"`
+
+	// AnchorHash is the hash of the last event deleted by the retention, recorded in the
+	// retention events. The oldest remaining event is chained to it
+	AnchorHash string `json:"anchor_hash,omitempty"`
+
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash" gorm:"uniqueIndex"`
+
+	// authenticated tells if the caller was identified, as a user or an adapter
+	authenticated bool
+}
+
+// AuditEventFromContext returns the audit event of the request, if it is audited
+func AuditEventFromContext(ctx context.Context) *AuditEvent {
+	event, _ := ctx.Value(AuditEventCtxKey).(*AuditEvent)
+	return event
+}
+
+// auditKeySize is the size of the key of the request hashes
+const auditKeySize = 32
+
+// LoadAuditKey returns the key the requests are hashed with, the base64 encoded key if
+// given or else the key stored in the file, which is generated on the first call
+func LoadAuditKey(key, path string) ([]byte, error) {
+	if key == "" {
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			generated := make([]byte, auditKeySize)
+			if _, err := rand.Read(generated); err != nil {
+				return nil, ErrAuditKey(err)
+			}
+			if err := os.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(generated)), 0600); err != nil {
+				return nil, ErrAuditKey(err)
+			}
+			return generated, nil
+		}
+		if err != nil {
+			return nil, ErrAuditKey(err)
+		}
+		key = strings.TrimSpace(string(data))
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, ErrAuditKey(err)
+	}
+	if len(decoded) < auditKeySize {
+		return nil, ErrAuditKey(fmt.Errorf("the key has %d bytes, at least %d are required", len(decoded), auditKeySize))
+	}
+
+	return decoded, nil
+}
+
+// hashAuditRequest returns the HMAC-SHA256 of the parts of a request
+func hashAuditRequest(key []byte, parts ...[]byte) string {
+	h := hmac.New(sha256.New, key)
+	for _, part := range parts {
+		// the length prefix keeps the parts from being shifted into each other
+		_, _ = h.Write([]byte{byte(len(part) >> 24), byte(len(part) >> 16), byte(len(part) >> 8), byte(len(part))})
+		_, _ = h.Write(part)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SetActor records who performed the operation, the event can be nil
+func (e *AuditEvent) SetActor(user *User, provider Provider, token *APIToken) {
+	if e == nil {
+		return
+	}
+	e.authenticated = true
+	if user != nil {
+		e.UserID = user.UserID
+	}
+	if provider != nil {
+		e.Provider = provider.Name()
+	}
+	if token != nil && token.ID != nil {
+		e.APITokenID = token.ID.String()
+	}
+}
+
+// AddK8sContexts records the kubernetes contexts the operation targets, the event can be nil
+func (e *AuditEvent) AddK8sContexts(ids ...string) {
+	if e != nil {
+		e.K8sContexts = appendUnique(e.K8sContexts, ids...)
+	}
+}
+
+// AddPatterns records the patterns the operation targets, the event can be nil
+func (e *AuditEvent) AddPatterns(ids ...string) {
+	if e != nil {
+		e.PatternIDs = appendUnique(e.PatternIDs, ids...)
+	}
+}
+
+// AddAdapters records the locations of the adapters the operation targets, the event can be nil
+func (e *AuditEvent) AddAdapters(locations ...string) {
+	if e != nil {
+		e.AdapterIDs = appendUnique(e.AdapterIDs, locations...)
+	}
+}
+
+// SetAdapter records the identity of the adapter which performed the operation, the event can be nil
+func (e *AuditEvent) SetAdapter(identity string) {
+	if e != nil {
+		e.authenticated = true
+		e.AddAdapters(identity)
+	}
+}
+
+// Authenticated returns true if the caller of the operation was identified
+func (e *AuditEvent) Authenticated() bool {
+	return e.authenticated
+}
+
+// SetStatus records the outcome of the API call from the status of its response
+func (e *AuditEvent) SetStatus(status int) {
+	e.Status = status
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		e.Outcome = AuditDenied
+	case status >= http.StatusBadRequest:
+		e.Outcome = AuditFailure
+	default:
+		e.Outcome = AuditSuccess
+	}
+}
+
+// computeHash returns the hash of the event chained to the previous one
+func (e *AuditEvent) computeHash() string {
+	record, _ := json.Marshal([]interface{}{
+		e.Timestamp.UTC().UnixNano(),
+		e.Kind,
+		e.UserID,
+		e.Provider,
+		e.APITokenID,
+		e.RemoteAddr,
+		e.Method,
+		e.Route,
+		e.Path,
+		nonNilStrings(e.K8sContexts),
+		nonNilStrings(e.PatternIDs),
+		nonNilStrings(e.AdapterIDs),
+		e.Outcome,
+		e.Status,
+		e.Detail,
+		e.RequestHash,
+		e.AnchorHash,
+		e.PrevHash,
+	})
+	sum := sha256.Sum256(record)
+	return hex.EncodeToString(sum[:])
+}
+
+// nonNilStrings makes the empty arrays hash the same before and after being stored
+func nonNilStrings(s pq.StringArray) []string {
+	if len(s) == 0 {
+		return []string{}
+	}
+
+	return s
+}
+
+func appendUnique(s pq.StringArray, values ...string) pq.StringArray {
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		found := false
+		for _, existing := range s {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			s = append(s, v)
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,447 @@
+package models
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"gorm.io/gorm"
+)
+
+const (
+	// auditBatchSize is the number of events read at once to export or verify the audit log
+	auditBatchSize = 500
+
+	// auditRetentionInterval is the interval at which the events older than the retention are deleted
+	auditRetentionInterval = time.Hour
+
+	// anonymousAuditInterval is the interval at which the calls of unauthenticated callers are recorded
+	anonymousAuditInterval = time.Minute
+
+	// AnonymousAuditRoute is the route of the events summing up the calls of unauthenticated callers
+	AnonymousAuditRoute = "unauthenticated"
+
+	// auditRetentionRoute is the route of the events recording the deletions of the retention
+	auditRetentionRoute = "audit.retention"
+)
+
+// AuditPersister is the persister of the append-only audit log, events can only be
+// appended and deleted by the retention
+type AuditPersister struct {
+	DB *database.Handler
+	// Key is the key the requests are hashed with, a random key is generated if empty
+	Key []byte
+	// HeadFile is the file the id and the hash of the last event are written to, outside of
+	// the database, so that the removal of the latest events can be detected
+	HeadFile string
+
+	mx      sync.Mutex
+	keyOnce sync.Once
+
+	// the calls of unauthenticated callers since the last record of them
+	anonymousCalls    map[AuditOutcome]int
+	anonymousRecorded time.Time
+}
+
+// AuditFilter filters the audit events, empty fields match every event
+type AuditFilter struct {
+	UserID     string
+	Provider   string
+	Kind       string
+	Route      string
+	Outcome    string
+	K8sContext string
+	PatternID  string
+	AdapterID  string
+	From       time.Time
+	To         time.Time
+}
+
+// AuditEventPage represents a page of audit events
+type AuditEventPage struct {
+	Page       uint64        `json:"page"`
+	PageSize   uint64        `json:"page_size"`
+	TotalCount int           `json:"total_count"`
+	Events     []*AuditEvent `json:"events"`
+}
+
+// AuditChainVerification is the result of the verification of the hash chain
+type AuditChainVerification struct {
+	Valid   bool `json:"valid"`
+	Checked int  `json:"checked"`
+	// FirstEventID is the oldest event, those before it were deleted by the retention
+	FirstEventID uint64 `json:"first_event_id,omitempty"`
+	LastHash     string `json:"last_hash,omitempty"`
+	// BrokenAt is the first event whose hash or link to the previous event is wrong
+	BrokenAt uint64 `json:"broken_at,omitempty"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// HashRequest returns the hash of the parts of a request keyed with the key of the audit log
+func (ap *AuditPersister) HashRequest(parts ...[]byte) string {
+	ap.keyOnce.Do(func() {
+		if len(ap.Key) == 0 {
+			ap.Key = make([]byte, auditKeySize)
+			_, _ = rand.Read(ap.Key)
+		}
+	})
+
+	return hashAuditRequest(ap.Key, parts...)
+}
+
+// AppendAuditEvent chains the event to the last one and appends it to the audit log
+func (ap *AuditPersister) AppendAuditEvent(event *AuditEvent) error {
+	ap.mx.Lock()
+	defer ap.mx.Unlock()
+
+	return ap.append(event)
+}
+
+// AppendAnonymousAuditEvent counts the API call of an unauthenticated caller. Those calls are
+// summed up in at most one event per minute, so that anyone who reaches the server can't
+// fill the audit log
+func (ap *AuditPersister) AppendAnonymousAuditEvent(event *AuditEvent) error {
+	ap.mx.Lock()
+	defer ap.mx.Unlock()
+
+	if ap.anonymousCalls == nil {
+		ap.anonymousCalls = map[AuditOutcome]int{}
+	}
+	ap.anonymousCalls[event.Outcome]++
+	if time.Since(ap.anonymousRecorded) < anonymousAuditInterval {
+		return nil
+	}
+
+	summary := &AuditEvent{
+		Kind:    AuditKindHTTP,
+		Route:   AnonymousAuditRoute,
+		Outcome: event.Outcome,
+	}
+	total := 0
+	for outcome, n := range ap.anonymousCalls {
+		total += n
+		// the calls of a summary with several outcomes didn't all succeed
+		if outcome != event.Outcome {
+			summary.Outcome = AuditFailure
+		}
+	}
+	summary.Detail = fmt.Sprintf("%d unauthenticated calls: %d denied, %d failed, %d succeeded, the last from %s to %s %s",
+		total, ap.anonymousCalls[AuditDenied], ap.anonymousCalls[AuditFailure], ap.anonymousCalls[AuditSuccess],
+		event.RemoteAddr, event.Method, event.Route)
+	if err := ap.append(summary); err != nil {
+		return err
+	}
+
+	ap.anonymousCalls = map[AuditOutcome]int{}
+	ap.anonymousRecorded = time.Now()
+	return nil
+}
+
+// append appends the event, the caller holds the lock
+func (ap *AuditPersister) append(event *AuditEvent) error {
+	if err := ap.DB.Transaction(func(tx *gorm.DB) error {
+		return appendAuditEvent(tx, event)
+	}); err != nil {
+		return err
+	}
+
+	return ap.writeHead(event)
+}
+
+// appendAuditEvent chains the event to the last one and creates it in the transaction
+func appendAuditEvent(tx *gorm.DB, event *AuditEvent) error {
+	last := []AuditEvent{}
+	if err := tx.Order("id desc").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+
+	event.ID = 0
+	event.PrevHash = ""
+	if len(last) > 0 {
+		event.PrevHash = last[0].Hash
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	// the precision of the timestamp survives every database
+	event.Timestamp = event.Timestamp.UTC().Truncate(time.Microsecond)
+	event.Hash = event.computeHash()
+
+	return tx.Create(event).Error
+}
+
+// writeHead writes the id and the hash of the last event to the head file, if any
+func (ap *AuditPersister) writeHead(event *AuditEvent) error {
+	if ap.HeadFile == "" {
+		return nil
+	}
+
+	tmp := ap.HeadFile + ".tmp"
+	if err := os.WriteFile(tmp, []byte(fmt.Sprintf("%d %s\n", event.ID, event.Hash)), 0600); err != nil {
+		return ErrAuditHead(err)
+	}
+	if err := os.Rename(tmp, ap.HeadFile); err != nil {
+		return ErrAuditHead(err)
+	}
+
+	return nil
+}
+
+// readHead returns the id and the hash of the last event written to the head file, the
+// id is 0 if the file doesn't exist yet
+func (ap *AuditPersister) readHead() (uint64, string, error) {
+	if ap.HeadFile == "" {
+		return 0, "", nil
+	}
+
+	data, err := os.ReadFile(ap.HeadFile)
+	if os.IsNotExist(err) {
+		return 0, "", nil
+	}
+	if err != nil {
+		return 0, "", ErrAuditHead(err)
+	}
+	id, hash := uint64(0), ""
+	if _, err := fmt.Sscanf(string(data), "%d %s", &id, &hash); err != nil {
+		return 0, "", ErrAuditHead(err)
+	}
+
+	return id, hash, nil
+}
+
+// GetAuditEvents returns a page of the events matching the filter, the latest first
+func (ap *AuditPersister) GetAuditEvents(filter AuditFilter, page, pageSize uint64) (*AuditEventPage, error) {
+	count := int64(0)
+	if err := filter.apply(ap.DB.Model(&AuditEvent{})).Count(&count).Error; err != nil {
+		return nil, err
+	}
+
+	events := []*AuditEvent{}
+	query := filter.apply(ap.DB.Order("id desc"))
+	if err := Paginate(uint(page), uint(pageSize))(query).Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return &AuditEventPage{
+		Page:       page,
+		PageSize:   pageSize,
+		TotalCount: int(count),
+		Events:     events,
+	}, nil
+}
+
+// ExportAuditEvents writes the events matching the filter as JSON Lines, the oldest first
+func (ap *AuditPersister) ExportAuditEvents(w io.Writer, filter AuditFilter) error {
+	enc := json.NewEncoder(w)
+	return ap.eachAuditEvent(filter, func(event *AuditEvent) error {
+		return enc.Encode(event)
+	})
+}
+
+// VerifyAuditChain checks the hash of every event and its link to the previous one
+func (ap *AuditPersister) VerifyAuditChain() (*AuditChainVerification, error) {
+	ap.mx.Lock()
+	defer ap.mx.Unlock()
+
+	// the oldest event is chained to the last event deleted by the latest retention,
+	// or it is the first event ever appended
+	anchor := ""
+	retention := []AuditEvent{}
+	err := ap.DB.Where("kind = ? AND route = ?", AuditKindSystem, auditRetentionRoute).Order("id desc").Limit(1).Find(&retention).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(retention) > 0 {
+		anchor = retention[0].AnchorHash
+	}
+
+	result := &AuditChainVerification{Valid: true}
+	var prev *AuditEvent
+	err = ap.eachAuditEvent(AuditFilter{}, func(event *AuditEvent) error {
+		if prev == nil {
+			result.FirstEventID = event.ID
+		}
+		result.Checked++
+
+		switch {
+		case event.computeHash() != event.Hash:
+			result.Reason = "the event was modified"
+		case prev == nil && event.PrevHash != anchor:
+			result.Reason = "the events before it were removed outside of the retention"
+		case prev != nil && event.PrevHash != prev.Hash:
+			result.Reason = "an event before it was removed or modified"
+		default:
+			prev = event
+			result.LastHash = event.Hash
+			return nil
+		}
+
+		result.Valid = false
+		result.BrokenAt = event.ID
+		return errStopAuditIteration
+	})
+	if err != nil && err != errStopAuditIteration {
+		return nil, err
+	}
+	if !result.Valid {
+		return result, nil
+	}
+
+	// the head file holds the last event appended, the latest events can't be removed unnoticed
+	headID, headHash, err := ap.readHead()
+	if err != nil {
+		return nil, err
+	}
+	lastID := uint64(0)
+	if prev != nil {
+		lastID = prev.ID
+	}
+	if headID != 0 && (headID != lastID || headHash != result.LastHash) {
+		result.Valid = false
+		result.BrokenAt = headID
+		result.Reason = fmt.Sprintf("the last event is %d, event %d was appended last", lastID, headID)
+	}
+
+	return result, nil
+}
+
+// PruneAuditEvents deletes the events older than the given time. The deletion is itself
+// recorded in the chain along with the hash of the last deleted event
+func (ap *AuditPersister) PruneAuditEvents(before time.Time) (int64, error) {
+	ap.mx.Lock()
+	defer ap.mx.Unlock()
+
+	last := []AuditEvent{}
+	if err := ap.DB.Where("timestamp < ?", before.UTC()).Order("id desc").Limit(1).Find(&last).Error; err != nil {
+		return 0, err
+	}
+	if len(last) == 0 {
+		return 0, nil
+	}
+
+	// the retention event is appended before the deletion, so that it always remains
+	// and anchors the oldest remaining event
+	deleted := int64(0)
+	event := &AuditEvent{
+		Kind:       AuditKindSystem,
+		Route:      auditRetentionRoute,
+		Outcome:    AuditSuccess,
+		Detail:     fmt.Sprintf("deleted the events up to event %d older than %s", last[0].ID, before.UTC().Format(time.RFC3339)),
+		AnchorHash: last[0].Hash,
+	}
+	err := ap.DB.Transaction(func(tx *gorm.DB) error {
+		if err := appendAuditEvent(tx, event); err != nil {
+			return err
+		}
+		res := tx.Where("id <= ?", last[0].ID).Delete(&AuditEvent{})
+		deleted = res.RowsAffected
+		return res.Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, ap.writeHead(event)
+}
+
+// StartRetention deletes the events older than the retention at startup and then every
+// hour, the loop stops when the context is cancelled
+func (ap *AuditPersister) StartRetention(ctx context.Context, log logger.Handler, retention time.Duration) {
+	prune := func() {
+		n, err := ap.PruneAuditEvents(time.Now().Add(-retention))
+		if err != nil {
+			log.Error(ErrAuditRetention(err))
+		} else if n > 0 {
+			log.Info("Deleted the audit events older than ", retention, ": ", n)
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(auditRetentionInterval)
+		defer ticker.Stop()
+
+		prune()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				prune()
+			}
+		}
+	}()
+}
+
+var errStopAuditIteration = fmt.Errorf("stop")
+
+// eachAuditEvent calls fn with the events matching the filter, the oldest first
+func (ap *AuditPersister) eachAuditEvent(filter AuditFilter, fn func(*AuditEvent) error) error {
+	after := uint64(0)
+	for {
+		events := []*AuditEvent{}
+		err := filter.apply(ap.DB.Where("id > ?", after)).Order("id asc").Limit(auditBatchSize).Find(&events).Error
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+			after = event.ID
+		}
+		if len(events) < auditBatchSize {
+			return nil
+		}
+	}
+}
+
+// apply adds the conditions of the filter to the query
+func (f *AuditFilter) apply(query *gorm.DB) *gorm.DB {
+	for column, value := range map[string]string{
+		"user_id":  f.UserID,
+		"provider": f.Provider,
+		"kind":     f.Kind,
+		"route":    f.Route,
+		"outcome":  f.Outcome,
+	} {
+		if value != "" {
+			query = query.Where(column+" = ?", value)
+		}
+	}
+	for column, value := range map[string]string{
+		"k8s_contexts": f.K8sContext,
+		"pattern_ids":  f.PatternID,
+		"adapter_ids":  f.AdapterID,
+	} {
+		if value != "" {
+			query = whereArrayContains(query, column, value)
+		}
+	}
+	if !f.From.IsZero() {
+		query = query.Where("timestamp >= ?", f.From.UTC())
+	}
+	if !f.To.IsZero() {
+		query = query.Where("timestamp <= ?", f.To.UTC())
+	}
+
+	return query
+}
+
+// whereArrayContains matches the rows whose array column holds the value. Without array
+// types the databases store the arrays as written by pq, every element being quoted
+func whereArrayContains(query *gorm.DB, column, value string) *gorm.DB {
+	element := `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(element)
+	return query.Where(
+		fmt.Sprintf(`(%[1]s LIKE ? ESCAPE '\' OR %[1]s LIKE ? ESCAPE '\')`, column),
+		"{"+escaped+"%", "%,"+escaped+"%",
+	)
+}
@@ -0,0 +1,208 @@
+package models
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func newTestAuditPersister(t *testing.T) *AuditPersister {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&AuditEvent{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &AuditPersister{DB: &db}
+}
+
+func TestAuditChain(t *testing.T) {
+	ap := newTestAuditPersister(t)
+	for _, event := range []*AuditEvent{
+		{Kind: AuditKindHTTP, UserID: "alice", Method: "POST", Route: "/api/pattern/deploy", K8sContexts: []string{"a", "b"}, PatternIDs: []string{"p_1"}, Outcome: AuditSuccess},
+		{Kind: AuditKindHTTP, UserID: "bob", Method: "DELETE", Route: "/api/pattern/{id}", PatternIDs: []string{"p_10"}, Outcome: AuditDenied},
+		{Kind: AuditKindGraphQL, UserID: "alice", Route: "mutation changeOperatorStatus", K8sContexts: []string{"b"}, Outcome: AuditFailure},
+	} {
+		if err := ap.AppendAuditEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if result, err := ap.VerifyAuditChain(); err != nil || !result.Valid || result.Checked != 3 {
+		t.Fatalf("verification %+v: %v", result, err)
+	}
+
+	for _, tc := range []struct {
+		filter AuditFilter
+		count  int
+	}{
+		{AuditFilter{}, 3},
+		{AuditFilter{UserID: "alice"}, 2},
+		{AuditFilter{Outcome: string(AuditDenied)}, 1},
+		{AuditFilter{K8sContext: "b"}, 2},
+		{AuditFilter{K8sContext: "c"}, 0},
+		// the pattern ids are matched whole
+		{AuditFilter{PatternID: "p_1"}, 1},
+		{AuditFilter{PatternID: "p%"}, 0},
+		{AuditFilter{From: time.Now().Add(time.Hour)}, 0},
+	} {
+		page, err := ap.GetAuditEvents(tc.filter, 0, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if page.TotalCount != tc.count || len(page.Events) != tc.count {
+			t.Errorf("filter %+v matches %d events, want %d", tc.filter, page.TotalCount, tc.count)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := ap.ExportAuditEvents(buf, AuditFilter{}); err != nil {
+		t.Fatal(err)
+	}
+	lines := 0
+	for scanner := bufio.NewScanner(buf); scanner.Scan(); lines++ {
+		event := AuditEvent{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || event.ID != uint64(lines+1) {
+			t.Errorf("exported line %d: %s", lines, scanner.Text())
+		}
+	}
+	if lines != 3 {
+		t.Errorf("%d exported events, want 3", lines)
+	}
+
+	// an event modified behind the back of the persister breaks the chain
+	if err := ap.DB.Model(&AuditEvent{}).Where("id = ?", 2).Update("outcome", AuditSuccess).Error; err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || result.Valid || result.BrokenAt != 2 {
+		t.Errorf("verification of a modified event %+v: %v", result, err)
+	}
+}
+
+func TestAuditChainRemovedEvent(t *testing.T) {
+	ap := newTestAuditPersister(t)
+	for i := 0; i < 3; i++ {
+		if err := ap.AppendAuditEvent(&AuditEvent{Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ap.DB.Where("id = ?", 2).Delete(&AuditEvent{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || result.Valid || result.BrokenAt != 3 {
+		t.Errorf("verification of a removed event %+v: %v", result, err)
+	}
+}
+
+func TestPruneAuditEvents(t *testing.T) {
+	ap := newTestAuditPersister(t)
+	old := time.Now().Add(-48 * time.Hour)
+	for _, event := range []*AuditEvent{
+		{Timestamp: old, Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess},
+		{Timestamp: old, Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess},
+		{Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess},
+	} {
+		if err := ap.AppendAuditEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, err := ap.PruneAuditEvents(time.Now().Add(-24 * time.Hour)); err != nil || n != 2 {
+		t.Fatalf("%d events deleted: %v", n, err)
+	}
+	page, err := ap.GetAuditEvents(AuditFilter{Kind: string(AuditKindSystem)}, 0, 10)
+	if err != nil || page.TotalCount != 1 {
+		t.Fatalf("retention events %+v: %v", page, err)
+	}
+
+	// the remaining events still form a chain, anchored by the retention event
+	result, err := ap.VerifyAuditChain()
+	if err != nil || !result.Valid || result.Checked != 2 || result.FirstEventID != 3 {
+		t.Errorf("verification after the retention %+v: %v", result, err)
+	}
+
+	// the oldest events can only be deleted by the retention
+	if err := ap.DB.Where("id = ?", 3).Delete(&AuditEvent{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || result.Valid || result.BrokenAt != 4 {
+		t.Errorf("verification without the oldest event %+v: %v", result, err)
+	}
+}
+
+func TestAuditChainRemovedPrefix(t *testing.T) {
+	ap := newTestAuditPersister(t)
+	for i := 0; i < 3; i++ {
+		if err := ap.AppendAuditEvent(&AuditEvent{Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ap.DB.Where("id <= ?", 2).Delete(&AuditEvent{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || result.Valid || result.BrokenAt != 3 {
+		t.Errorf("verification without the first events %+v: %v", result, err)
+	}
+}
+
+func TestAuditChainRemovedTail(t *testing.T) {
+	ap := newTestAuditPersister(t)
+	ap.HeadFile = filepath.Join(t.TempDir(), "audit-log.head")
+	for i := 0; i < 3; i++ {
+		if err := ap.AppendAuditEvent(&AuditEvent{Kind: AuditKindHTTP, Route: "/api/pattern", Outcome: AuditSuccess}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || !result.Valid {
+		t.Fatalf("verification %+v: %v", result, err)
+	}
+
+	if err := ap.DB.Where("id = ?", 3).Delete(&AuditEvent{}).Error; err != nil {
+		t.Fatal(err)
+	}
+	if result, err := ap.VerifyAuditChain(); err != nil || result.Valid || result.BrokenAt != 3 {
+		t.Errorf("verification without the last event %+v: %v", result, err)
+	}
+}
+
+func TestAuditRequestHash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit-log.key")
+	key, err := LoadAuditKey("", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := LoadAuditKey("", path); err != nil || !bytes.Equal(key, reloaded) {
+		t.Errorf("key is not kept across restarts: %v", err)
+	}
+	if _, err := LoadAuditKey("c2hvcnQ=", path); err == nil {
+		t.Error("short key is accepted")
+	}
+
+	body := []byte("user_name=alice&password=correct+horse")
+	ap := &AuditPersister{Key: key}
+	other := &AuditPersister{}
+	hash := ap.HashRequest([]byte("POST"), body)
+	if hash != ap.HashRequest([]byte("POST"), body) {
+		t.Error("the hash of a request is not stable")
+	}
+	if hash == other.HashRequest([]byte("POST"), body) || hash == hashAuditRequest(nil, []byte("POST"), body) {
+		t.Error("the hash of a request doesn't depend on the key")
+	}
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// backgroundWorker is the loop shared by the workers which run persisted jobs, such
+// as the performance scheduler and the pattern sync worker. At every tick the jobs
+// which are due are dispatched, the jobs are reloaded from the database beforehand
+// whenever the reload interval has passed or a change was signalled.
+type backgroundWorker struct {
+	tickInterval   time.Duration
+	reloadInterval time.Duration
+
+	// mu guards the running jobs along with the entries of the embedding worker
+	mu      sync.Mutex
+	running map[uuid.UUID]bool
+
+	refreshCh chan struct{}
+	wg        sync.WaitGroup
+}
+
+func newBackgroundWorker(tickInterval, reloadInterval time.Duration) backgroundWorker {
+	return backgroundWorker{
+		tickInterval:   tickInterval,
+		reloadInterval: reloadInterval,
+		running:        map[uuid.UUID]bool{},
+		refreshCh:      make(chan struct{}, 1),
+	}
+}
+
+// refresh signals the loop to reload the jobs at the next tick
+func (bw *backgroundWorker) refresh() {
+	select {
+	case bw.refreshCh <- struct{}{}:
+	default:
+	}
+}
+
+// loop reloads and dispatches the jobs until the context is cancelled
+func (bw *backgroundWorker) loop(ctx context.Context, reload func(now time.Time), dispatch func(ctx context.Context, now time.Time)) {
+	ticker := time.NewTicker(bw.tickInterval)
+	defer ticker.Stop()
+
+	lastReload := time.Time{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-bw.refreshCh:
+			lastReload = time.Time{}
+		case now := <-ticker.C:
+			if now.Sub(lastReload) >= bw.reloadInterval {
+				reload(now)
+				lastReload = now
+			}
+			dispatch(ctx, now)
+		}
+	}
+}
+
+// start runs the job with the given id unless it is still running, in which case
+// false is returned. The caller holds mu.
+func (bw *backgroundWorker) start(id uuid.UUID, job func()) bool {
+	if bw.running[id] {
+		return false
+	}
+
+	bw.running[id] = true
+	bw.wg.Add(1)
+	go func() {
+		defer bw.wg.Done()
+		defer func() {
+			bw.mu.Lock()
+			delete(bw.running, id)
+			bw.mu.Unlock()
+		}()
+
+		job()
+	}()
+
+	return true
+}
+
+// wait blocks until all the running jobs have finished
+func (bw *backgroundWorker) wait() {
+	bw.wg.Wait()
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+func TestBackgroundWorkerStart(t *testing.T) {
+	bw := newBackgroundWorker(time.Second, time.Minute)
+	id, _ := uuid.NewV4()
+
+	release := make(chan struct{})
+	bw.mu.Lock()
+	if !bw.start(id, func() { <-release }) {
+		t.Fatal("expected the job to be started")
+	}
+	if bw.start(id, func() {}) {
+		t.Fatal("expected the job not to be started while it is still running")
+	}
+	bw.mu.Unlock()
+
+	close(release)
+	bw.wait()
+
+	bw.mu.Lock()
+	defer bw.mu.Unlock()
+	if bw.running[id] {
+		t.Fatal("expected the job to be removed from the running jobs once it finished")
+	}
+	done := make(chan struct{})
+	if !bw.start(id, func() { close(done) }) {
+		t.Fatal("expected the job to be started again once it finished")
+	}
+	<-done
+}
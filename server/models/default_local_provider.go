@@ -17,6 +17,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshkit/database"
@@ -28,6 +29,7 @@ import (
 	SMP "github.com/layer5io/service-mesh-performance/spec"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 // DefaultLocalProvider - represents a local provider
@@ -41,11 +43,26 @@ type DefaultLocalProvider struct {
 	PerformanceProfilesPersister    *PerformanceProfilePersister
 	MesheryPatternPersister         *MesheryPatternPersister
 	MesheryPatternResourcePersister *PatternResourcePersister
+	PatternDeploymentPersister      *PatternDeploymentPersister
 	MesheryApplicationPersister     *MesheryApplicationPersister
 	MesheryFilterPersister          *MesheryFilterPersister
 	MesheryK8sContextPersister      *MesheryK8sContextPersister
+	SchedulePersister               *SchedulePersister
+	PerformanceScheduler            *PerformanceScheduler
+	PatternSyncPersister            *PatternSyncPersister
+	PatternSyncWorker               *PatternSyncWorker
 	GenericPersister                *database.Handler
 	KubeClient                      *mesherykube.Client
+
+	// MultiUser enables the login of the users of LocalUserPersister, with
+	// their password or through OIDC, and the enforcement of their roles.
+	// Otherwise every request is made by the single admin user "meshery"
+	MultiUser          bool
+	LocalUserPersister *LocalUserPersister
+	// SessionDuration is the lifetime of the sessions, DefaultLocalSessionDuration if not set
+	SessionDuration time.Duration
+	// OIDC enables the login through an OIDC identity provider in multi-user mode
+	OIDC *LocalOIDCConfig
 }
 
 // Initialize will initialize the local provider
@@ -57,14 +74,20 @@ func (l *DefaultLocalProvider) Initialize() {
 		"No performance or conformance test result history",
 		"Free Use",
 	}
+	if l.MultiUser {
+		l.ProviderDescription[0] = "Multi-user sessions with roles"
+	}
 	l.ProviderType = LocalProviderType
 	l.PackageVersion = viper.GetString("BUILD")
 	l.PackageURL = ""
 	l.Extensions = Extensions{}
 	l.Capabilities = Capabilities{
 		{Feature: PersistMesheryPatterns},
+		{Feature: PersistMesheryPatternDeployments},
+		{Feature: PersistPatternSyncSources},
 		{Feature: PersistMesheryApplications},
 		{Feature: PersistMesheryFilters},
+		{Feature: PersistSchedules},
 	}
 }
 
@@ -102,9 +125,57 @@ func (l *DefaultLocalProvider) GetProviderCapabilities(w http.ResponseWriter, r
 	}
 }
 
+// InitializeUsers prepares the multi-user mode, the admin with the given credentials is
+// created if there is no admin yet
+func (l *DefaultLocalProvider) InitializeUsers(adminUserName, adminPassword string) error {
+	if !l.MultiUser {
+		return nil
+	}
+
+	if err := l.LocalUserPersister.DeleteExpiredSessions(); err != nil {
+		return err
+	}
+
+	admins, err := l.LocalUserPersister.CountUsers(RoleAdmin)
+	if err != nil {
+		return err
+	}
+	if admins > 0 {
+		return nil
+	}
+	if adminUserName == "" || adminPassword == "" {
+		if l.OIDC == nil {
+			return fmt.Errorf("there is no admin, set MESHERY_ADMIN_USERNAME and MESHERY_ADMIN_PASSWORD to create one")
+		}
+		return nil
+	}
+
+	admin, err := l.LocalUserPersister.GetUserByName(adminUserName)
+	if err != nil {
+		admin = &LocalUser{UserName: adminUserName}
+	}
+	admin.Role = RoleAdmin
+	if err := admin.SetPassword(adminPassword); err != nil {
+		return err
+	}
+
+	return l.LocalUserPersister.SaveUser(admin)
+}
+
 // InitiateLogin - initiates login flow and returns a true to indicate the handler to "return" or false to continue
+//
+// In multi-user mode the login form is served, or the user is redirected to the OIDC
+// identity provider if one is configured
 func (l *DefaultLocalProvider) InitiateLogin(w http.ResponseWriter, r *http.Request, fromMiddleWare bool) {
-	// l.issueSession(w, r, fromMiddleWare)
+	if !l.MultiUser {
+		return
+	}
+
+	if l.OIDC != nil && r.URL.Query().Get("method") != "password" {
+		l.initiateOIDCLogin(w, r)
+		return
+	}
+	l.serveLoginForm(w, r)
 }
 
 // issueSession issues a cookie session after successful login
@@ -124,26 +195,102 @@ func (l *DefaultLocalProvider) fetchUserDetails() *User {
 		FirstName: "Meshery",
 		LastName:  "Meshery",
 		AvatarURL: "",
+		Role:      string(RoleAdmin),
+	}
+}
+
+// apiTokenUser returns the user the requests made with the API token act as
+func (l *DefaultLocalProvider) apiTokenUser(token *APIToken) (*User, error) {
+	if token.ServiceAccount != "" {
+		return token.ServiceAccountUser(), nil
+	}
+	if !l.MultiUser {
+		return l.fetchUserDetails(), nil
+	}
+
+	user, err := l.LocalUserPersister.GetUserByName(token.UserID)
+	if err != nil {
+		return nil, ErrAPIToken(fmt.Errorf("the user %s of the token no longer exists", token.UserID))
 	}
+
+	return user.ToUser(), nil
+}
+
+// scheduledRunUser returns the owner of the schedule a background run is performed for,
+// the requests of the runs have no session
+func (l *DefaultLocalProvider) scheduledRunUser(userID string) (*User, error) {
+	if !l.MultiUser {
+		return l.fetchUserDetails(), nil
+	}
+	if userID == "" {
+		return nil, ErrScheduledRun(fmt.Errorf("the schedule has no owner, save it again to run it as its owner"), "unknown")
+	}
+
+	user, err := l.LocalUserPersister.GetUserByName(userID)
+	if err != nil {
+		return nil, ErrScheduledRun(fmt.Errorf("the owner %s of the schedule no longer exists", userID), "unknown")
+	}
+
+	return user.ToUser(), nil
 }
 
 // GetUserDetails - returns the user details
 func (l *DefaultLocalProvider) GetUserDetails(req *http.Request) (*User, error) {
-	return l.fetchUserDetails(), nil
+	if token := APITokenFromContext(req.Context()); token != nil {
+		return l.apiTokenUser(token)
+	}
+	if userID, ok := req.Context().Value(ScheduledRunUserCtxKey).(string); ok {
+		return l.scheduledRunUser(userID)
+	}
+	if !l.MultiUser {
+		return l.fetchUserDetails(), nil
+	}
+
+	user, err := l.sessionUser(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return user.ToUser(), nil
 }
 
 // GetSession - returns the session
 func (l *DefaultLocalProvider) GetSession(req *http.Request) error {
-	return nil
+	if token := APITokenFromContext(req.Context()); token != nil {
+		_, err := l.apiTokenUser(token)
+		return err
+	}
+	if userID, ok := req.Context().Value(ScheduledRunUserCtxKey).(string); ok {
+		_, err := l.scheduledRunUser(userID)
+		return err
+	}
+	if !l.MultiUser {
+		return nil
+	}
+
+	_, err := l.sessionUser(req)
+	return err
 }
 
 // GetProviderToken - returns provider token
 func (l *DefaultLocalProvider) GetProviderToken(req *http.Request) (string, error) {
-	return "", nil
+	if !l.MultiUser {
+		return "", nil
+	}
+
+	return localSessionToken(req), nil
 }
 
 // Logout - logout from provider backend
 func (l *DefaultLocalProvider) Logout(w http.ResponseWriter, req *http.Request) {
+	if l.MultiUser {
+		if token := localSessionToken(req); token != "" {
+			if err := l.LocalUserPersister.DeleteSession(token); err != nil {
+				logrus.Error(ErrLocalSession(err))
+			}
+		}
+		http.SetCookie(w, &http.Cookie{Name: tokenName, Path: "/", MaxAge: -1})
+	}
 	http.Redirect(w, req, "/user/login", http.StatusFound)
 }
 
@@ -280,8 +427,14 @@ func (l *DefaultLocalProvider) PublishResults(req *http.Request, result *Meshery
 	if err != nil {
 		return "", ErrMarshal(err, "meshery result for shipping")
 	}
-	user, _ := l.GetUserDetails(req)
-	pref, _ := l.ReadFromPersister(user.UserID)
+	user, err := l.GetUserDetails(req)
+	if err != nil {
+		return "", err
+	}
+	pref, err := l.ReadFromPersister(user.UserID)
+	if err != nil {
+		return "", err
+	}
 	if !pref.AnonymousPerfResults {
 		return "", nil
 	}
@@ -431,14 +584,22 @@ func (l *DefaultLocalProvider) UpdateToken(http.ResponseWriter, *http.Request) s
 }
 
 // TokenHandler - specific to remote auth
+//
+// In multi-user mode it completes the login through the OIDC identity provider
 func (l *DefaultLocalProvider) TokenHandler(w http.ResponseWriter, r *http.Request, fromMiddleWare bool) {
+	if !l.MultiUser || l.OIDC == nil {
+		return
+	}
+
+	l.completeOIDCLogin(w, r)
 }
 
 // ExtractToken - Returns the auth token and the provider type
 func (l *DefaultLocalProvider) ExtractToken(w http.ResponseWriter, r *http.Request) {
+	token, _ := l.GetProviderToken(r)
 	resp := map[string]interface{}{
 		"meshery-provider": l.Name(),
-		tokenName:          "",
+		tokenName:          token,
 	}
 	logrus.Debugf("encoded response : %v", resp)
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -539,6 +700,123 @@ func (l *DefaultLocalProvider) DeleteMesheryPatternResource(token, resourceID st
 	return l.MesheryPatternResourcePersister.DeletePatternResource(id)
 }
 
+// SavePatternDeployment records a deployment of a pattern
+func (l *DefaultLocalProvider) SavePatternDeployment(tokenString string, deployment *PatternDeployment) ([]byte, error) {
+	return l.PatternDeploymentPersister.SavePatternDeployment(deployment)
+}
+
+// GetPatternDeployments returns the recorded pattern deployments, optionally only of the given pattern
+func (l *DefaultLocalProvider) GetPatternDeployments(tokenString, page, pageSize, order, patternID string) ([]byte, error) {
+	if page == "" {
+		page = "0"
+	}
+	if pageSize == "" {
+		pageSize = "10"
+	}
+
+	pg, err := strconv.ParseUint(page, 10, 32)
+	if err != nil {
+		return nil, ErrPageNumber(err)
+	}
+
+	pgs, err := strconv.ParseUint(pageSize, 10, 32)
+	if err != nil {
+		return nil, ErrPageSize(err)
+	}
+
+	return l.PatternDeploymentPersister.GetPatternDeployments(patternID, order, pg, pgs)
+}
+
+// GetPatternDeployment returns the pattern deployment with the given id
+func (l *DefaultLocalProvider) GetPatternDeployment(tokenString, deploymentID string) ([]byte, error) {
+	id := uuid.FromStringOrNil(deploymentID)
+	return l.PatternDeploymentPersister.GetPatternDeployment(id)
+}
+
+// SavePatternSyncSource saves a pattern sync source and schedules it with the sync worker
+func (l *DefaultLocalProvider) SavePatternSyncSource(tokenString string, source *PatternSyncSource) ([]byte, error) {
+	if err := source.Validate(); err != nil {
+		return nil, err
+	}
+	if source.ID != nil {
+		// the source of another user is not overwritten
+		existing, err := l.PatternSyncPersister.GetPatternSyncSource(*source.ID)
+		if err == nil && existing.UserID != source.UserID {
+			return nil, gorm.ErrRecordNotFound
+		}
+	}
+
+	data, err := l.PatternSyncPersister.SavePatternSyncSource(source)
+	if err != nil {
+		return nil, err
+	}
+
+	l.PatternSyncWorker.Refresh()
+	return data, nil
+}
+
+// GetPatternSyncSources returns the pattern sync sources of the user
+func (l *DefaultLocalProvider) GetPatternSyncSources(tokenString, userID, page, pageSize, order string) ([]byte, error) {
+	if page == "" {
+		page = "0"
+	}
+	if pageSize == "" {
+		pageSize = "10"
+	}
+
+	pg, err := strconv.ParseUint(page, 10, 32)
+	if err != nil {
+		return nil, ErrPageNumber(err)
+	}
+
+	pgs, err := strconv.ParseUint(pageSize, 10, 32)
+	if err != nil {
+		return nil, ErrPageSize(err)
+	}
+
+	return l.PatternSyncPersister.GetPatternSyncSources(userID, order, pg, pgs)
+}
+
+// GetPatternSyncSource returns the pattern sync source of the user with the given id along with its recent syncs
+func (l *DefaultLocalProvider) GetPatternSyncSource(tokenString, userID, sourceID string) ([]byte, error) {
+	source, err := l.userPatternSyncSource(userID, uuid.FromStringOrNil(sourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalPatternSyncSource(source), nil
+}
+
+// DeletePatternSyncSource deletes the pattern sync source of the user with the given id
+func (l *DefaultLocalProvider) DeletePatternSyncSource(tokenString, userID, sourceID string) ([]byte, error) {
+	id := uuid.FromStringOrNil(sourceID)
+	if _, err := l.userPatternSyncSource(userID, id); err != nil {
+		return nil, err
+	}
+
+	data, err := l.PatternSyncPersister.DeletePatternSyncSource(id)
+	if err != nil {
+		return nil, err
+	}
+
+	l.PatternSyncWorker.Refresh()
+	return data, nil
+}
+
+// userPatternSyncSource returns the pattern sync source with the given id, the
+// sources of other users are reported as not found
+func (l *DefaultLocalProvider) userPatternSyncSource(userID string, id uuid.UUID) (*PatternSyncSource, error) {
+	source, err := l.PatternSyncPersister.GetPatternSyncSource(id)
+	if err != nil {
+		return nil, err
+	}
+	if source.UserID != userID {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return source, nil
+}
+
 // SaveMesheryPattern saves given pattern with the provider
 func (l *DefaultLocalProvider) SaveMesheryPattern(tokenString string, pattern *MesheryPattern) ([]byte, error) {
 	return l.MesheryPatternPersister.SaveMesheryPattern(pattern)
@@ -824,7 +1102,12 @@ func (l *DefaultLocalProvider) SavePerformanceProfile(tokenString string, perfor
 		return nil, ErrMarshal(err, "Perf Profile for persisting")
 	}
 
-	return data, l.PerformanceProfilesPersister.SavePerformanceProfile(uid, performanceProfile)
+	if err := l.PerformanceProfilesPersister.SavePerformanceProfile(uid, performanceProfile); err != nil {
+		return nil, err
+	}
+
+	l.PerformanceScheduler.Refresh()
+	return data, nil
 }
 
 // GetPerformanceProfiles gives the performance profiles stored with the provider
@@ -876,27 +1159,64 @@ func (l *DefaultLocalProvider) DeletePerformanceProfile(req *http.Request, perfo
 		return nil, ErrPerfID(err)
 	}
 
-	return l.PerformanceProfilesPersister.DeletePerformanceProfile(uid)
+	data, err := l.PerformanceProfilesPersister.DeletePerformanceProfile(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	l.PerformanceScheduler.Refresh()
+	return data, nil
 }
 
 // SaveSchedule saves a schedule
 func (l *DefaultLocalProvider) SaveSchedule(tokenString string, schedule *Schedule) ([]byte, error) {
-	return []byte{}, ErrLocalProviderSupport
+	data, err := l.SchedulePersister.SaveSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	l.PerformanceScheduler.Refresh()
+	return data, nil
 }
 
 // GetSchedules gets the schedules stored by the current user
 func (l *DefaultLocalProvider) GetSchedules(req *http.Request, page, pageSize, order string) ([]byte, error) {
-	return []byte{}, ErrLocalProviderSupport
+	if page == "" {
+		page = "0"
+	}
+	if pageSize == "" {
+		pageSize = "10"
+	}
+
+	pg, err := strconv.ParseUint(page, 10, 32)
+	if err != nil {
+		return nil, ErrPageNumber(err)
+	}
+
+	pgs, err := strconv.ParseUint(pageSize, 10, 32)
+	if err != nil {
+		return nil, ErrPageSize(err)
+	}
+
+	return l.SchedulePersister.GetSchedules(order, pg, pgs)
 }
 
 // GetSchedule gets a schedule with the given id
 func (l *DefaultLocalProvider) GetSchedule(req *http.Request, scheduleID string) ([]byte, error) {
-	return []byte{}, ErrLocalProviderSupport
+	id := uuid.FromStringOrNil(scheduleID)
+	return l.SchedulePersister.GetSchedule(id)
 }
 
 // DeleteSchedule deletes a schedule with the given id
 func (l *DefaultLocalProvider) DeleteSchedule(req *http.Request, scheduleID string) ([]byte, error) {
-	return []byte{}, ErrLocalProviderSupport
+	id := uuid.FromStringOrNil(scheduleID)
+	data, err := l.SchedulePersister.DeleteSchedule(id)
+	if err != nil {
+		return nil, err
+	}
+
+	l.PerformanceScheduler.Refresh()
+	return data, nil
 }
 
 // RecordMeshSyncData records the mesh sync data
@@ -87,6 +87,23 @@ const (
 	ErrBrokerSubscriptionCode             = "2238"
 	ErrContextAlreadyPersistedCode        = "2241"
 	ErrGetPackageCode                     = "2252"
+	ErrCronExpressionCode                 = "2255"
+	ErrScheduledRunCode                   = "2256"
+	ErrPatternSyncCode                    = "2264"
+	ErrUnsupportedRunTypeCode             = "2274"
+	ErrLoadTestRunningCode                = "2275"
+	ErrReadMeshMetricQueriesCode          = "2279"
+	ErrLocalSessionCode                   = "2281"
+	ErrInvalidCredentialsCode             = "2282"
+	ErrOIDCLoginCode                      = "2283"
+	ErrAPITokenCode                       = "2288"
+	ErrK8sContextEncryptionCode           = "2291"
+	ErrKMSKeyringCode                     = "2292"
+	ErrAuditRetentionCode                 = "2297"
+	ErrAdapterAuthCode                    = "2298"
+	ErrAuditKeyCode                       = "2301"
+	ErrAuditHeadCode                      = "2303"
+	ErrTarballTooLargeCode                = "2304"
 )
 
 var (
@@ -101,6 +118,7 @@ var (
 	ErrNilConfigData           = errors.New(ErrNilConfigDataCode, errors.Alert, []string{"Given config data is nil"}, []string{}, []string{}, []string{})
 	ErrNilJWKs                 = errors.New(ErrNilJWKsCode, errors.Alert, []string{"Invalid JWks"}, []string{"Value of JWKs is nil"}, []string{}, []string{})
 	ErrNilKeys                 = errors.New(ErrNilKeysCode, errors.Alert, []string{"Key not found"}, []string{"JWK not found for the given KeyID"}, []string{}, []string{})
+	ErrInvalidCredentials      = errors.New(ErrInvalidCredentialsCode, errors.Alert, []string{"Invalid user name or password"}, []string{"The user does not exist or the password does not match"}, []string{}, []string{"Check the user name and the password", "Ask an admin of Meshery to reset the password"})
 	ErrTokenExpired            = errors.New(ErrTokenExpiredCode, errors.Alert, []string{"Token has expired"}, []string{"Token is invalid, it has expired"}, []string{}, []string{})
 	ErrTokenClaims             = errors.New(ErrTokenClaimsCode, errors.Alert, []string{"Error occurred while prasing claims"}, []string{}, []string{}, []string{})
 	ErrValidURL                = errors.New(ErrValidURLCode, errors.Alert, []string{"Enter valid URLs"}, []string{}, []string{}, []string{})
@@ -328,3 +346,67 @@ func ErrSavingSeededComponents(err error, content string) error {
 func ErrDownloadingSeededComponents(err error, content string) error {
 	return errors.New(ErrDownloadingSeededComponentsCode, errors.Alert, []string{"Could not download seed content for" + content}, []string{err.Error()}, []string{"The content is not present at the specified url endpoint", "HTTP requests failed"}, []string{"Make sure the content is available at the endpoints", "Make sure that Github is reachable and the http requests are not failing"})
 }
+
+func ErrCronExpression(err error, expr string) error {
+	return errors.New(ErrCronExpressionCode, errors.Alert, []string{"Invalid cron expression: ", expr}, []string{err.Error()}, []string{"The cron expression is not a valid quartz expression"}, []string{"Make sure the expression has seconds, minutes, hours, day of month, month, day of week and an optional year field, for example: 0 15 5 ? * WED,SUN *"})
+}
+
+func ErrScheduledRun(err error, profileID string) error {
+	return errors.New(ErrScheduledRunCode, errors.Alert, []string{"Scheduled run of performance profile ", profileID, " failed"}, []string{err.Error()}, []string{"Load test endpoint could be not reachable", "No kubernetes context is available to persist the results"}, []string{"Make sure load test endpoint is reachable", "Make sure at least one kubernetes context is connected to Meshery"})
+}
+
+func ErrPatternSync(err error, sourceID string) error {
+	return errors.New(ErrPatternSyncCode, errors.Alert, []string{"Sync of pattern sync source ", sourceID, " failed"}, []string{err.Error()}, []string{"Git repository could be not reachable or the branch doesn't exist", "Pattern files could be invalid", "No kubernetes context is available to deploy the patterns"}, []string{"Make sure the git repository is reachable and the branch exists", "Make sure the pattern files are valid", "Make sure the kubernetes contexts of the sync source are connected to Meshery"})
+}
+
+func ErrUnsupportedRunType(runType string) error {
+	return errors.New(ErrUnsupportedRunTypeCode, errors.Alert, []string{"Results of ", runType, " load tests can't be converted to SMP"}, []string{"Only the results of HTTP, TCP and UDP load tests can be converted"}, []string{"The result was not produced by a supported load test"}, []string{})
+}
+
+func ErrLoadTestRunning(testID string) error {
+	return errors.New(ErrLoadTestRunningCode, errors.Alert, []string{"Load test ", testID, " is already running"}, []string{"A load test with the same test id is running"}, []string{"The test id was reused for another load test"}, []string{"Wait for the load test to complete, cancel it or use another test id"})
+}
+
+func ErrReadMeshMetricQueries(err error, path string) error {
+	return errors.New(ErrReadMeshMetricQueriesCode, errors.Alert, []string{"Unable to read the mesh metric queries from ", path}, []string{err.Error()}, []string{"The file does not exist or is not readable", "The file is not a valid YAML or JSON map of the meshes to their queries"}, []string{"Make sure that MESH_METRICS_CONFIG points to a valid file of queries", "Give every query a name and a PromQL query"})
+}
+
+func ErrLocalSession(err error) error {
+	return errors.New(ErrLocalSessionCode, errors.Alert, []string{"Invalid session of the local provider"}, []string{err.Error()}, []string{"The session token is missing, unknown or has expired", "The user of the session was deleted"}, []string{"Log in again"})
+}
+
+func ErrOIDCLogin(err error) error {
+	return errors.New(ErrOIDCLoginCode, errors.Alert, []string{"Login through the OIDC identity provider failed"}, []string{err.Error()}, []string{"The identity provider is not reachable or its issuer URL is wrong", "The client id, secret or redirect URL do not match the client registered at the identity provider", "The login was denied or took too long"}, []string{"Make sure LOCAL_PROVIDER_OIDC_ISSUER points to the identity provider", "Make sure the redirect URL of the client is the /api/user/token endpoint of Meshery", "Retry the login"})
+}
+
+func ErrAPIToken(err error) error {
+	return errors.New(ErrAPITokenCode, errors.Alert, []string{"Invalid API token"}, []string{err.Error()}, []string{"The token is unknown, was revoked or has expired", "The token was issued under another provider", "The user of the token was deleted"}, []string{"Create a new token with mesheryctl system token create"})
+}
+
+func ErrK8sContextEncryption(err error) error {
+	return errors.New(ErrK8sContextEncryptionCode, errors.Alert, []string{"Error encrypting or decrypting the credentials of the kubernetes context"}, []string{err.Error()}, []string{"The key the credentials were encrypted with is not in the keyring anymore", "The credentials were tampered with"}, []string{"Start Meshery with the keyring or the keys the credentials were encrypted with", "Upload the kubeconfig again"})
+}
+
+func ErrKMSKeyring(err error) error {
+	return errors.New(ErrKMSKeyringCode, errors.Alert, []string{"Invalid keyring of the kubeconfig encryption"}, []string{err.Error()}, []string{"KUBECONFIG_ENCRYPTION_KEY is not a base64 encoded 32 bytes key", "The keyring file is corrupted or not readable"}, []string{"Generate a key with `openssl rand -base64 32`", "Restore the keyring file from a backup"})
+}
+
+func ErrAuditRetention(err error) error {
+	return errors.New(ErrAuditRetentionCode, errors.Alert, []string{"Unable to delete the expired events of the audit log"}, []string{err.Error()}, []string{"The database of Meshery is not reachable"}, []string{"Check the logs of Meshery and the database, the events are deleted again in an hour"})
+}
+
+func ErrAdapterAuth(err error) error {
+	return errors.New(ErrAdapterAuthCode, errors.Alert, []string{"The adapter is not allowed to register its capabilities"}, []string{err.Error()}, []string{"The adapter connects from a network outside of ADAPTER_TRUSTED_CIDRS", "The adapter sends no or a wrong ADAPTER_REGISTRATION_TOKEN", "The client certificate of the adapter is not signed by ADAPTER_CLIENT_CA or its name is not in ADAPTER_TLS_IDENTITIES", "ADAPTER_TRUSTED_CIDRS is not a list of CIDRs"}, []string{"Add the network of the adapter to ADAPTER_TRUSTED_CIDRS", "Pass the registration token to the adapter as a bearer token", "Issue the certificate of the adapter with the client CA of Meshery"})
+}
+
+func ErrAuditKey(err error) error {
+	return errors.New(ErrAuditKeyCode, errors.Alert, []string{"Invalid key of the audit log"}, []string{err.Error()}, []string{"AUDIT_LOG_KEY is not a base64 encoded key of at least 32 bytes", "The key file of the audit log is corrupted or not readable"}, []string{"Generate a key with `openssl rand -base64 32`", "Restore the key file from a backup"})
+}
+
+func ErrAuditHead(err error) error {
+	return errors.New(ErrAuditHeadCode, errors.Alert, []string{"Unable to keep the head of the audit log"}, []string{err.Error()}, []string{"The head file of the audit log is not writable or corrupted"}, []string{"Make sure that AUDIT_LOG_HEAD_FILE is in a folder writable by Meshery Server"})
+}
+
+func ErrTarballTooLarge(obj string, limit int64) error {
+	return errors.New(ErrTarballTooLargeCode, errors.Alert, []string{"The tarball is too large"}, []string{fmt.Sprintf("%s exceeds the limit of %d bytes", obj, limit)}, []string{"The chart or the kustomization contains large files", "The tarball is a decompression bomb"}, []string{"Remove the large files from the chart or the kustomization"})
+}
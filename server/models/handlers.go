@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"net/http"
 
 	"time"
@@ -18,6 +19,8 @@ type HandlerInterface interface {
 	KubernetesMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
 	MesheryControllersMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
 	SessionInjectorMiddleware(func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)) http.Handler
+	AuditMiddleware(http.Handler) http.Handler
+	AdapterAuthMiddleware(http.Handler) http.Handler
 	GraphqlMiddleware(http.Handler) func(http.ResponseWriter, *http.Request, *Preference, *User, Provider)
 
 	ProviderHandler(w http.ResponseWriter, r *http.Request)
@@ -30,6 +33,16 @@ type HandlerInterface interface {
 	LoginHandler(w http.ResponseWriter, r *http.Request, provider Provider, fromMiddleWare bool)
 	LogoutHandler(w http.ResponseWriter, req *http.Request, provider Provider)
 	UserHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	LocalLoginHandler(w http.ResponseWriter, r *http.Request, provider Provider)
+	GetLocalUsersHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SaveLocalUserHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	UpdateLocalUserHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteLocalUserHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetAPITokensHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SaveAPITokenHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeleteAPITokenHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetAuditEventsHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	VerifyAuditLogHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	K8SConfigHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetContextsFromK8SConfig(w http.ResponseWriter, req *http.Request)
@@ -43,8 +56,14 @@ type HandlerInterface interface {
 
 	LoadTestHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	LoadTestUsingSMPHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetLoadTestRunsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	CancelLoadTestHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	CollectStaticMetrics(config *SubmitMetricsConfig) error
+	RunScheduledLoadTest(ctx context.Context, provider Provider, schedule *Schedule, profile *PerformanceProfile) ([]string, error)
+	DeploySyncedPatterns(ctx context.Context, provider Provider, source *PatternSyncSource, patterns []MesheryPattern) (string, error)
 	FetchResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	ExportResultHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
+	ExportProfileResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	FetchAllResultsHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetResultHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetSMPServiceMeshes(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -87,13 +106,15 @@ type HandlerInterface interface {
 	GetPerformanceProfilesHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetPerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	DeletePerformanceProfileHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ComparePerformanceResultsHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	MeshOverheadBenchmarkHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	SessionSyncHandler(w http.ResponseWriter, req *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	PatternFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	OAMRegisterHandler(rw http.ResponseWriter, r *http.Request)
-	ValidationHandler(rw http.ResponseWriter, r *http.Request)
-	ComponentGenerationHandler(rw http.ResponseWriter, r *http.Request)
+	ValidationHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ComponentGenerationHandler(w http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	OAMComponentDetailsHandler(rw http.ResponseWriter, r *http.Request)
 	OAMComponentDetailByIDHandler(rw http.ResponseWriter, r *http.Request)
 	PatternFileRequestHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -102,6 +123,15 @@ type HandlerInterface interface {
 	DeleteMultiMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetCatalogMesheryPatternsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryPatternHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetMesheryPatternExportHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternDeploymentsHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternDeploymentHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternDriftHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	ReconcilePatternDeploymentHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	SavePatternSyncSourceHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternSyncSourcesHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	GetPatternSyncSourceHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
+	DeletePatternSyncSourceHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 
 	FilterFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
 	GetMesheryFilterFileHandler(rw http.ResponseWriter, r *http.Request, prefObj *Preference, user *User, provider Provider)
@@ -163,6 +193,22 @@ type HandlerConfig struct {
 	PerformanceChannel       chan struct{}
 	PerformanceResultChannel chan struct{}
 
+	// LoadTestWorkers are the urls of the workers load tests can be fanned out to
+	LoadTestWorkers     []string
+	LoadTestWorkerToken string
+	// LoadTestRuns keeps track of the load tests running on the server
+	LoadTestRuns *LoadTestRegistry
+	// MeshMetricQueries are the queries of the metrics of the service meshes stored along with the results
+	MeshMetricQueries MeshMetricQueries
+	// APITokenPersister persists the API tokens, which are accepted whatever the provider
+	APITokenPersister *APITokenPersister
+	// AuditPersister persists the audit log of the mutating API calls and GraphQL mutations
+	AuditPersister *AuditPersister
+	// AdapterAuth authenticates the adapters registering their capabilities
+	AdapterAuth *AdapterAuth
+	// ComponentGenerationLimiter limits the rate at which every user generates components
+	ComponentGenerationLimiter *RateLimiter
+
 	ConfigurationChannel *ConfigurationChannel
 
 	DashboardK8sResourcesChan *DashboardK8sResourcesChan
@@ -0,0 +1,241 @@
+package models
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/strvals"
+)
+
+// helmValuesDir is the directory of the chart archive in which the values the
+// chart is rendered with are stored, next to the Chart.yaml
+const helmValuesDir = "meshery-values"
+
+// HelmChartValues are the values a helm chart is rendered with on top of the
+// default values of the chart, the same way as helm's --values and --set flags
+type HelmChartValues struct {
+	// Files are the contents of the values files, later files take precedence
+	Files []string `json:"values,omitempty"`
+	// Set are the <key>=<value> overrides, applied after the values files
+	Set []string `json:"set,omitempty"`
+}
+
+// IsEmpty checks if no values are given
+func (hv HelmChartValues) IsEmpty() bool {
+	return len(hv.Files) == 0 && len(hv.Set) == 0
+}
+
+// merge merges the values files and the overrides into a single map
+func (hv HelmChartValues) merge() (map[string]interface{}, error) {
+	base := map[string]interface{}{}
+	for i, f := range hv.Files {
+		current := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(f), &current); err != nil {
+			return nil, fmt.Errorf("failed to parse values file %d: %s", i+1, err)
+		}
+		base = mergeHelmValues(base, current)
+	}
+
+	for _, s := range hv.Set {
+		if err := strvals.ParseInto(s, base); err != nil {
+			return nil, fmt.Errorf("failed to parse --set %q: %s", s, err)
+		}
+	}
+
+	return base, nil
+}
+
+// mergeHelmValues deep merges b into a, same as helm does for the values files
+func mergeHelmValues(a, b map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(a))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		if v, ok := v.(map[string]interface{}); ok {
+			if bv, ok := out[k]; ok {
+				if bv, ok := bv.(map[string]interface{}); ok {
+					out[k] = mergeHelmValues(bv, v)
+					continue
+				}
+			}
+		}
+		out[k] = v
+	}
+
+	return out
+}
+
+// RenderHelmChart renders the chart archive with the given values into a kubernetes
+// manifest, the chart is rendered client side without reaching out to a cluster
+func RenderHelmChart(chartArchive []byte, values HelmChartValues) (string, error) {
+	chart, err := loader.LoadArchive(bytes.NewReader(chartArchive))
+	if err != nil {
+		return "", err
+	}
+
+	vals, err := values.merge()
+	if err != nil {
+		return "", err
+	}
+
+	act := action.NewInstall(new(action.Configuration))
+	act.ReleaseName = chart.Metadata.Name
+	act.Namespace = "default"
+	act.CreateNamespace = true
+	act.DryRun = true
+	act.IncludeCRDs = true
+	act.ClientOnly = true
+
+	rel, err := act.Run(chart, vals)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(rel.Manifest) + "\n", nil
+}
+
+// AttachHelmValues stores the values in the chart archive so that the render can be
+// reproduced from the archive alone, the values stored before are replaced
+func AttachHelmValues(chartArchive []byte, values HelmChartValues) ([]byte, error) {
+	files, err := readTarball(chartArchive)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("chart archive is empty")
+	}
+
+	// Charts are archived within a directory named after the chart
+	root := strings.SplitN(strings.TrimPrefix(files[0].Path, "/"), "/", 2)[0]
+	valuesDir := path.Join(root, helmValuesDir)
+
+	res := []RepoFile{}
+	for _, f := range files {
+		if !strings.HasPrefix(path.Clean(f.Path), valuesDir+"/") {
+			res = append(res, f)
+		}
+	}
+	for i, content := range values.Files {
+		res = append(res, RepoFile{
+			Path:    path.Join(valuesDir, fmt.Sprintf("values-%d.yaml", i)),
+			Content: []byte(content),
+		})
+	}
+	if len(values.Set) > 0 {
+		res = append(res, RepoFile{
+			Path:    path.Join(valuesDir, "set"),
+			Content: []byte(strings.Join(values.Set, "\n") + "\n"),
+		})
+	}
+
+	return PackTarball(res)
+}
+
+// GetHelmValues returns the values stored in the chart archive
+func GetHelmValues(chartArchive []byte) (HelmChartValues, error) {
+	values := HelmChartValues{}
+
+	files, err := readTarball(chartArchive)
+	if err != nil {
+		return values, err
+	}
+
+	valueFiles := map[string]string{}
+	for _, f := range files {
+		dir, name := path.Split(path.Clean(f.Path))
+		if path.Base(dir) != helmValuesDir {
+			continue
+		}
+
+		if name == "set" {
+			for _, s := range strings.Split(string(f.Content), "\n") {
+				if s = strings.TrimSpace(s); s != "" {
+					values.Set = append(values.Set, s)
+				}
+			}
+			continue
+		}
+		valueFiles[name] = string(f.Content)
+	}
+
+	// values-<n>.yaml are ordered by their index
+	names := make([]string, 0, len(valueFiles))
+	for name := range valueFiles {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		values.Files = append(values.Files, valueFiles[name])
+	}
+
+	return values, nil
+}
+
+const (
+	// maxTarballSize is the maximum size of the decompressed tarball
+	maxTarballSize = 64 << 20
+	// maxTarballFileSize is the maximum size of a file of the tarball
+	maxTarballFileSize = 16 << 20
+)
+
+// readTarball returns the regular files of the gzipped tarball in their order, an
+// error is returned once the decompressed tarball or one of its files exceeds its limit
+func readTarball(tarball []byte) ([]RepoFile, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(tarball))
+	if err != nil {
+		return nil, err
+	}
+	defer SafeClose(gr)
+
+	files := []RepoFile{}
+	lr := &io.LimitedReader{R: gr, N: maxTarballSize}
+	tr := tar.NewReader(lr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			// The limit may cut the tarball right before a header
+			if lr.N <= 0 {
+				return nil, ErrTarballTooLarge("the tarball", maxTarballSize)
+			}
+			break
+		}
+		if err != nil {
+			if lr.N <= 0 {
+				return nil, ErrTarballTooLarge("the tarball", maxTarballSize)
+			}
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(io.LimitReader(tr, maxTarballFileSize+1))
+		if err != nil {
+			if lr.N <= 0 {
+				return nil, ErrTarballTooLarge("the tarball", maxTarballSize)
+			}
+			return nil, err
+		}
+		if len(content) > maxTarballFileSize {
+			return nil, ErrTarballTooLarge(hdr.Name, maxTarballFileSize)
+		}
+		files = append(files, RepoFile{Path: hdr.Name, Content: content})
+	}
+
+	return files, nil
+}
@@ -0,0 +1,188 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testHelmChart(t *testing.T) []byte {
+	t.Helper()
+
+	chart, err := PackTarball([]RepoFile{
+		{Path: "web/Chart.yaml", Content: []byte("apiVersion: v2\nname: web\nversion: 0.1.0\n")},
+		{Path: "web/values.yaml", Content: []byte("replicas: 1\nimage:\n  repository: nginx\n  tag: latest\n")},
+		{Path: "web/templates/deployment.yaml", Content: []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicas }}
+  template:
+    spec:
+      containers:
+      - name: web
+        image: {{ .Values.image.repository }}:{{ .Values.image.tag }}
+`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return chart
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	chart := testHelmChart(t)
+
+	tests := []struct {
+		name    string
+		values  HelmChartValues
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "default values",
+			want: []string{"name: web", "replicas: 1", "image: nginx:latest"},
+		},
+		{
+			name: "values files are merged in order",
+			values: HelmChartValues{Files: []string{
+				"replicas: 2\nimage:\n  tag: \"1.23\"\n",
+				"replicas: 3\n",
+			}},
+			want: []string{"replicas: 3", "image: nginx:1.23"},
+		},
+		{
+			name: "overrides take precedence over the values files",
+			values: HelmChartValues{
+				Files: []string{"replicas: 2\n"},
+				Set:   []string{"replicas=5", "image.repository=httpd"},
+			},
+			want: []string{"replicas: 5", "image: httpd:latest"},
+		},
+		{
+			name:    "invalid override",
+			values:  HelmChartValues{Set: []string{"replicas"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderHelmChart(chart, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderHelmChart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("RenderHelmChart() = %s, want it to contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAttachHelmValues(t *testing.T) {
+	chart := testHelmChart(t)
+	values := HelmChartValues{
+		Files: []string{"replicas: 2\n", "replicas: 3\n"},
+		Set:   []string{"image.tag=1.23"},
+	}
+
+	withValues, err := AttachHelmValues(chart, values)
+	if err != nil {
+		t.Fatalf("AttachHelmValues() error = %v", err)
+	}
+
+	got, err := GetHelmValues(withValues)
+	if err != nil {
+		t.Fatalf("GetHelmValues() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("GetHelmValues() = %+v, want %+v", got, values)
+	}
+
+	// The archive is still a valid chart which renders with the stored values
+	manifest, err := RenderHelmChart(withValues, got)
+	if err != nil {
+		t.Fatalf("RenderHelmChart() error = %v", err)
+	}
+	if !strings.Contains(manifest, "replicas: 3") || !strings.Contains(manifest, "image: nginx:1.23") {
+		t.Errorf("RenderHelmChart() = %s, want the stored values applied", manifest)
+	}
+
+	// The stored values are replaced
+	replaced, err := AttachHelmValues(withValues, HelmChartValues{Set: []string{"replicas=4"}})
+	if err != nil {
+		t.Fatalf("AttachHelmValues() error = %v", err)
+	}
+	got, err = GetHelmValues(replaced)
+	if err != nil {
+		t.Fatalf("GetHelmValues() error = %v", err)
+	}
+	if want := (HelmChartValues{Set: []string{"replicas=4"}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetHelmValues() = %+v, want %+v", got, want)
+	}
+}
+
+func TestReadTarballLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   []RepoFile
+		wantErr bool
+	}{
+		{
+			name:  "within the limits",
+			files: []RepoFile{{Path: "web/values.yaml", Content: make([]byte, maxTarballFileSize)}},
+		},
+		{
+			name:    "file exceeds its limit",
+			files:   []RepoFile{{Path: "web/values.yaml", Content: make([]byte, maxTarballFileSize+1)}},
+			wantErr: true,
+		},
+		{
+			name: "tarball exceeds its limit",
+			files: []RepoFile{
+				{Path: "web/a", Content: make([]byte, maxTarballFileSize)},
+				{Path: "web/b", Content: make([]byte, maxTarballFileSize)},
+				{Path: "web/c", Content: make([]byte, maxTarballFileSize)},
+				{Path: "web/d", Content: make([]byte, maxTarballFileSize)},
+			},
+			wantErr: true,
+		},
+		{
+			// Every entry takes exactly a quarter of the limit, the limit cuts the
+			// tarball right before the header of the last file
+			name: "tarball exceeds its limit on a header boundary",
+			files: []RepoFile{
+				{Path: "web/a", Content: make([]byte, maxTarballSize/4-512)},
+				{Path: "web/b", Content: make([]byte, maxTarballSize/4-512)},
+				{Path: "web/c", Content: make([]byte, maxTarballSize/4-512)},
+				{Path: "web/d", Content: make([]byte, maxTarballSize/4-512)},
+				{Path: "web/values.yaml", Content: []byte("replicas: 1\n")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tarball, err := PackTarball(tt.files)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			files, err := readTarball(tarball)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readTarball() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), "exceeds the limit") {
+				t.Errorf("readTarball() error = %v, want the limit to be exceeded", err)
+			}
+			if !tt.wantErr && len(files) != len(tt.files) {
+				t.Errorf("readTarball() returned %d files, want %d", len(files), len(tt.files))
+			}
+		})
+	}
+}
@@ -253,8 +253,14 @@ func K8sContextGenerateID(kc K8sContext) (string, error) {
 }
 
 // GenerateKubeConfig will generate a kubeconfig from the context object
-// and will set the "current-context" to the current context's name
+// and will set the "current-context" to the current context's name.
+// Encrypted credentials are decrypted with the KMS of the contexts
 func (kc K8sContext) GenerateKubeConfig() ([]byte, error) {
+	kc, err := kc.Open(GetK8sContextKMS())
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := map[string]interface{}{
 		"apiVersion": "v1",
 		"clusters": []map[string]interface{}{
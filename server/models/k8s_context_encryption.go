@@ -0,0 +1,498 @@
+package models
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/layer5io/meshery/server/internal/sql"
+)
+
+// sealedField is the key under which the credentials of a kubernetes context are
+// stored once encrypted, the name of the cluster and of the user stay in clear
+const sealedField = "sealed"
+
+// KMS wraps and unwraps the data keys the credentials of the kubernetes contexts
+// are encrypted with (envelope encryption). Only the wrapped data keys are
+// persisted along with the contexts
+type KMS interface {
+	// PrimaryKeyID returns the id of the key new data keys are wrapped with
+	PrimaryKeyID() string
+	// Encrypt wraps the data key with the primary key and returns the id of that key
+	Encrypt(dataKey []byte) (keyID string, wrapped []byte, err error)
+	// Decrypt unwraps the data key wrapped with the key of the given id
+	Decrypt(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// LocalKMS is a KMS holding its keys in memory, loaded from a keyring file or from
+// environment variables
+type LocalKMS struct {
+	path    string
+	modTime time.Time
+	primary string
+	keys    map[string][]byte
+	mx      sync.RWMutex
+}
+
+// localKeyring is the format of the keyring file of the LocalKMS
+type localKeyring struct {
+	Primary string            `json:"primary"`
+	Keys    map[string]string `json:"keys"`
+}
+
+// sealedMap is the envelope of the encrypted credentials
+type sealedMap struct {
+	KeyID   string `json:"key_id"`
+	DataKey string `json:"data_key"`
+	Data    string `json:"data"`
+}
+
+var (
+	k8sContextKMS   KMS
+	k8sContextKMSMx sync.RWMutex
+)
+
+// SetK8sContextKMS sets the KMS the credentials of the kubernetes contexts persisted
+// by Meshery are encrypted with. Without KMS the credentials are stored in clear
+func SetK8sContextKMS(kms KMS) {
+	k8sContextKMSMx.Lock()
+	defer k8sContextKMSMx.Unlock()
+	k8sContextKMS = kms
+}
+
+// GetK8sContextKMS returns the KMS of the kubernetes contexts, if any
+func GetK8sContextKMS() KMS {
+	k8sContextKMSMx.RLock()
+	defer k8sContextKMSMx.RUnlock()
+	return k8sContextKMS
+}
+
+// NewLocalKMSFromKeys returns a KMS wrapping the data keys with the primary key, the
+// previous keys are kept to unwrap the data keys until they are rotated. The keys are
+// 32 bytes long and base64 encoded
+func NewLocalKMSFromKeys(primary string, previous ...string) (*LocalKMS, error) {
+	kms := &LocalKMS{keys: map[string][]byte{}}
+	for i, encoded := range append([]string{primary}, previous...) {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != 32 {
+			return nil, ErrKMSKeyring(fmt.Errorf("the key %d is not a base64 encoded 32 bytes key", i))
+		}
+		id := localKeyID(key)
+		kms.keys[id] = key
+		if i == 0 {
+			kms.primary = id
+		}
+	}
+
+	return kms, nil
+}
+
+// NewLocalKMSFromFile returns a KMS whose keys are stored in the keyring file, the
+// file is created with a new key if it does not exist
+func NewLocalKMSFromFile(path string) (*LocalKMS, error) {
+	kms := &LocalKMS{path: path}
+	if err := kms.load(); err != nil {
+		if !os.IsNotExist(err) {
+			return nil, ErrKMSKeyring(err)
+		}
+		kms.keys = map[string][]byte{}
+		if err := kms.RotateKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	return kms, nil
+}
+
+// NewLocalKMS returns the KMS of the key passed through the environment if any, along
+// with the previous keys, or the KMS of the keyring file otherwise
+func NewLocalKMS(key string, previousKeys []string, keyringPath string) (*LocalKMS, error) {
+	if key != "" {
+		return NewLocalKMSFromKeys(key, previousKeys...)
+	}
+
+	return NewLocalKMSFromFile(keyringPath)
+}
+
+// PrimaryKeyID returns the id of the key new data keys are wrapped with
+func (k *LocalKMS) PrimaryKeyID() string {
+	k.mx.RLock()
+	defer k.mx.RUnlock()
+	return k.primary
+}
+
+// Encrypt wraps the data key with the primary key, the keyring file is reloaded if it
+// changed so that a key rotated by another process is used right away
+func (k *LocalKMS) Encrypt(dataKey []byte) (string, []byte, error) {
+	if k.path != "" {
+		k.mx.RLock()
+		modTime := k.modTime
+		k.mx.RUnlock()
+		if info, err := os.Stat(k.path); err == nil && !info.ModTime().Equal(modTime) {
+			if err := k.load(); err != nil {
+				return "", nil, ErrKMSKeyring(err)
+			}
+		}
+	}
+
+	k.mx.RLock()
+	id, key := k.primary, k.keys[k.primary]
+	k.mx.RUnlock()
+
+	wrapped, err := seal(key, dataKey)
+	return id, wrapped, err
+}
+
+// Decrypt unwraps the data key wrapped with the key of the given id, the keyring file
+// is reloaded if the key is unknown as it might have been rotated in the meantime
+func (k *LocalKMS) Decrypt(keyID string, wrapped []byte) ([]byte, error) {
+	k.mx.RLock()
+	key, ok := k.keys[keyID]
+	k.mx.RUnlock()
+	if !ok && k.path != "" {
+		if err := k.load(); err != nil {
+			return nil, ErrKMSKeyring(err)
+		}
+		k.mx.RLock()
+		key, ok = k.keys[keyID]
+		k.mx.RUnlock()
+	}
+	if !ok {
+		return nil, ErrKMSKeyring(fmt.Errorf("unknown key %s", keyID))
+	}
+
+	return open(key, wrapped)
+}
+
+// RotateKey adds a new primary key to the keyring file, the previous keys are kept to
+// unwrap the data keys which were not rewrapped yet
+func (k *LocalKMS) RotateKey() error {
+	if k.path == "" {
+		return ErrKMSKeyring(fmt.Errorf("keys passed through the environment are rotated by changing the primary key and passing the previous one along"))
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return ErrKMSKeyring(err)
+	}
+
+	k.mx.Lock()
+	defer k.mx.Unlock()
+	keys := map[string][]byte{localKeyID(key): key}
+	for id, key := range k.keys {
+		keys[id] = key
+	}
+
+	return k.save(localKeyID(key), keys)
+}
+
+// RetirePreviousKeys removes all but the primary key from the keyring file, the data
+// keys wrapped by the previous keys can't be unwrapped anymore
+func (k *LocalKMS) RetirePreviousKeys() error {
+	if k.path == "" {
+		return nil
+	}
+
+	k.mx.Lock()
+	defer k.mx.Unlock()
+	return k.save(k.primary, map[string][]byte{k.primary: k.keys[k.primary]})
+}
+
+// save writes the keys to the keyring file, the caller holds the lock
+func (k *LocalKMS) save(primary string, keys map[string][]byte) error {
+	keyring := localKeyring{Primary: primary, Keys: map[string]string{}}
+	for id, key := range keys {
+		keyring.Keys[id] = base64.StdEncoding.EncodeToString(key)
+	}
+
+	data, err := json.MarshalIndent(&keyring, "", "  ")
+	if err != nil {
+		return ErrKMSKeyring(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(k.path), 0700); err != nil {
+		return ErrKMSKeyring(err)
+	}
+	// the keyring is replaced atomically so that a running server never reads half of it
+	tmp := k.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return ErrKMSKeyring(err)
+	}
+	if err := os.Rename(tmp, k.path); err != nil {
+		return ErrKMSKeyring(err)
+	}
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return ErrKMSKeyring(err)
+	}
+
+	k.keys, k.primary, k.modTime = keys, primary, info.ModTime()
+	return nil
+}
+
+// load reads the keys of the keyring file
+func (k *LocalKMS) load() error {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(k.path)
+	if err != nil {
+		return err
+	}
+	keyring := localKeyring{}
+	if err := json.Unmarshal(data, &keyring); err != nil {
+		return err
+	}
+
+	keys := map[string][]byte{}
+	for id, encoded := range keyring.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != 32 {
+			return fmt.Errorf("the key %s of %s is not a base64 encoded 32 bytes key", id, k.path)
+		}
+		keys[id] = key
+	}
+	if _, ok := keys[keyring.Primary]; !ok {
+		return fmt.Errorf("the primary key %s is missing from %s", keyring.Primary, k.path)
+	}
+
+	k.mx.Lock()
+	defer k.mx.Unlock()
+	k.keys, k.primary, k.modTime = keys, keyring.Primary, info.ModTime()
+	return nil
+}
+
+// localKeyID identifies a key without revealing it
+func localKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// SealedWith returns true if the credentials of the context are encrypted with data
+// keys wrapped by the primary key of the KMS
+func (kc *K8sContext) SealedWith(kms KMS) bool {
+	for _, m := range []sql.Map{kc.Auth, kc.Cluster} {
+		if len(m) == 0 {
+			continue
+		}
+		envelope, ok := sealedEnvelope(m)
+		if !ok || envelope.KeyID != kms.PrimaryKeyID() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Seal encrypts the credentials of the context, each with its own data key wrapped
+// by the KMS. Sealed credentials are rewrapped if their key is not the primary key
+func (kc *K8sContext) Seal(kms KMS) error {
+	auth, err := sealMap(kms, kc.Auth)
+	if err != nil {
+		return ErrK8sContextEncryption(err)
+	}
+	cluster, err := sealMap(kms, kc.Cluster)
+	if err != nil {
+		return ErrK8sContextEncryption(err)
+	}
+
+	kc.Auth, kc.Cluster = auth, cluster
+	return nil
+}
+
+// Open returns the context with its credentials decrypted
+func (kc K8sContext) Open(kms KMS) (K8sContext, error) {
+	if !isSealedMap(kc.Auth) && !isSealedMap(kc.Cluster) {
+		return kc, nil
+	}
+	if kms == nil {
+		return kc, ErrK8sContextEncryption(fmt.Errorf("the credentials of the context %s are encrypted but no KMS is configured", kc.Name))
+	}
+
+	auth, err := openMap(kms, kc.Auth)
+	if err != nil {
+		return kc, ErrK8sContextEncryption(err)
+	}
+	cluster, err := openMap(kms, kc.Cluster)
+	if err != nil {
+		return kc, ErrK8sContextEncryption(err)
+	}
+
+	kc.Auth, kc.Cluster = auth, cluster
+	return kc, nil
+}
+
+func isSealedMap(m sql.Map) bool {
+	_, ok := m[sealedField]
+	return ok
+}
+
+// sealMap encrypts the map, keeping its name in clear
+func sealMap(kms KMS, m sql.Map) (sql.Map, error) {
+	if envelope, ok := sealedEnvelope(m); ok {
+		if envelope.KeyID == kms.PrimaryKeyID() {
+			return m, nil
+		}
+		return rewrapMap(kms, m, envelope)
+	}
+	if len(m) == 0 {
+		return m, nil
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	sealed, err := seal(dataKey, data)
+	if err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := kms.Encrypt(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSealedMap(m["name"], &sealedMap{
+		KeyID:   keyID,
+		DataKey: base64.StdEncoding.EncodeToString(wrapped),
+		Data:    base64.StdEncoding.EncodeToString(sealed),
+	})
+}
+
+// rewrapMap wraps the data key of the map with the primary key, the data itself is
+// not encrypted again
+func rewrapMap(kms KMS, m sql.Map, envelope *sealedMap) (sql.Map, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(envelope.DataKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := kms.Decrypt(envelope.KeyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := kms.Encrypt(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSealedMap(m["name"], &sealedMap{
+		KeyID:   keyID,
+		DataKey: base64.StdEncoding.EncodeToString(wrapped),
+		Data:    envelope.Data,
+	})
+}
+
+// openMap decrypts the map
+func openMap(kms KMS, m sql.Map) (sql.Map, error) {
+	envelope, ok := sealedEnvelope(m)
+	if !ok {
+		return m, nil
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(envelope.DataKey)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(envelope.Data)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := kms.Decrypt(envelope.KeyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	data, err := open(dataKey, sealed)
+	if err != nil {
+		return nil, err
+	}
+
+	opened := sql.Map{}
+	return opened, json.Unmarshal(data, &opened)
+}
+
+// sealedEnvelope returns the envelope of the map, the second value is false if the
+// map is not encrypted
+func sealedEnvelope(m sql.Map) (*sealedMap, bool) {
+	raw, ok := m[sealedField]
+	if !ok {
+		return nil, false
+	}
+
+	// the envelope is a struct when sealed in memory and a map once read back
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, false
+	}
+	envelope := &sealedMap{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, false
+	}
+
+	return envelope, true
+}
+
+func newSealedMap(name interface{}, envelope *sealedMap) (sql.Map, error) {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	m := sql.Map{sealedField: fields}
+	if name != nil {
+		m["name"] = name
+	}
+	return m, nil
+}
+
+// seal encrypts the data with AES-GCM, the nonce prefixes the result
+func seal(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// open decrypts the data sealed with seal
+func open(key, sealed []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("the sealed data is truncated")
+	}
+
+	return gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/internal/sql"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func newTestK8sContext() K8sContext {
+	instanceID := uuid.Must(uuid.NewV4())
+	return K8sContext{
+		MesheryInstanceID: &instanceID,
+		Name:              "kind-meshery",
+		Auth: sql.Map{
+			"name": "kind-meshery",
+			"user": map[string]interface{}{"token": "secret-token"},
+		},
+		Cluster: sql.Map{
+			"name":    "kind-meshery",
+			"cluster": map[string]interface{}{"server": "https://127.0.0.1:6443"},
+		},
+		Server: "https://127.0.0.1:6443",
+	}
+}
+
+func newTestKey(t *testing.T) string {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func TestK8sContextSeal(t *testing.T) {
+	kms, err := NewLocalKMSFromKeys(newTestKey(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kc := newTestK8sContext()
+	if err := kc.Seal(kms); err != nil {
+		t.Fatal(err)
+	}
+	if !kc.SealedWith(kms) || kc.Cluster["name"] != "kind-meshery" {
+		t.Errorf("sealed context %+v", kc)
+	}
+	if sealed, _ := kc.Auth.Value(); strings.Contains(fmt.Sprint(sealed), "secret-token") {
+		t.Error("the token is stored in clear")
+	}
+
+	opened, err := kc.Open(kms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, _ := opened.Auth["user"].(map[string]interface{})
+	if user["token"] != "secret-token" {
+		t.Errorf("opened auth %v", opened.Auth)
+	}
+
+	other, _ := NewLocalKMSFromKeys(newTestKey(t))
+	if _, err := kc.Open(other); err == nil {
+		t.Error("context is opened with another key")
+	}
+
+	SetK8sContextKMS(kms)
+	defer SetK8sContextKMS(nil)
+	cfg, err := kc.GenerateKubeConfig()
+	if err != nil || !strings.Contains(string(cfg), "secret-token") {
+		t.Errorf("kubeconfig %s: %v", cfg, err)
+	}
+}
+
+func TestSealMesheryK8sContexts(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&K8sContext{}); err != nil {
+		t.Fatal(err)
+	}
+	mkcp := &MesheryK8sContextPersister{DB: &db}
+
+	// a context stored in clear by a previous version
+	kc, err := mkcp.SaveMesheryK8sContext(newTestK8sContext())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kms, err := NewLocalKMSFromFile(filepath.Join(t.TempDir(), "keyring.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := mkcp.SealMesheryK8sContexts(kms); err != nil || n != 1 {
+		t.Fatalf("%d contexts sealed: %v", n, err)
+	}
+
+	previous := kms.PrimaryKeyID()
+	if err := kms.RotateKey(); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := mkcp.SealMesheryK8sContexts(kms); err != nil || n != 1 {
+		t.Fatalf("%d contexts rewrapped: %v", n, err)
+	}
+	if err := kms.RetirePreviousKeys(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kms.Decrypt(previous, nil); err == nil {
+		t.Error("retired key is still known")
+	}
+
+	stored, err := mkcp.GetMesheryK8sContext(kc.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !stored.SealedWith(kms) {
+		t.Errorf("stored context %+v is not sealed with the primary key", stored)
+	}
+	if opened, err := stored.Open(kms); err != nil || opened.Cluster["cluster"] == nil {
+		t.Errorf("opened context %+v: %v", opened, err)
+	}
+}
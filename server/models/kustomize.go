@@ -0,0 +1,124 @@
+package models
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// IsTarballURL checks if the URL points to a gzipped tarball rather than a git repository
+func IsTarballURL(sourceURL string) bool {
+	p := strings.Split(sourceURL, "?")[0]
+	return strings.HasSuffix(p, ".tgz") || strings.HasSuffix(p, ".tar.gz")
+}
+
+// FetchKustomizeSource returns the kustomize source at the URL as a gzipped tarball.
+// URLs of tarballs are downloaded, any other URL is cloned as a git repository
+// at the given ref, the default branch is used if no ref is given. The repositories
+// are cloned over https or ssh only, as for the pattern sync sources.
+func FetchKustomizeSource(sourceURL, ref string) ([]byte, error) {
+	if IsTarballURL(sourceURL) {
+		resp, err := http.Get(sourceURL)
+		if err != nil {
+			return nil, err
+		}
+		defer SafeClose(resp.Body)
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to download %s: %s", sourceURL, resp.Status)
+		}
+
+		tarball, err := io.ReadAll(io.LimitReader(resp.Body, maxTarballSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(tarball) > maxTarballSize {
+			return nil, ErrTarballTooLarge(sourceURL, maxTarballSize)
+		}
+
+		return tarball, nil
+	}
+
+	if err := ValidateRepoURL(sourceURL); err != nil {
+		return nil, err
+	}
+	_, files, err := GetRepoFiles(sourceURL, ref, "**")
+	if err != nil {
+		return nil, err
+	}
+
+	return PackTarball(files)
+}
+
+// PackTarball packs the files into a gzipped tarball
+func PackTarball(files []RepoFile) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for _, f := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:     f.Path,
+			Mode:     0644,
+			Size:     int64(len(f.Content)),
+			Typeflag: tar.TypeReg,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderKustomization renders the kustomization in the overlay directory of the gzipped
+// tarball into a kubernetes manifest. The tarball is extracted in memory and the overlay
+// may refer to the bases anywhere in the tarball, remote bases are not supported.
+func RenderKustomization(tarball []byte, overlay string) (string, error) {
+	files, err := readTarball(tarball)
+	if err != nil {
+		return "", err
+	}
+
+	fSys := filesys.MakeFsInMemory()
+	for _, f := range files {
+		// Cleaning the path against the root keeps the entries inside the file system
+		if err := fSys.WriteFile(path.Join("/", f.Path), f.Content); err != nil {
+			return "", err
+		}
+	}
+
+	opts := krusty.MakeDefaultOptions()
+	opts.LoadRestrictions = types.LoadRestrictionsNone
+
+	resMap, err := krusty.MakeKustomizer(opts).Run(fSys, path.Join("/", overlay))
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := resMap.AsYaml()
+	if err != nil {
+		return "", err
+	}
+
+	return string(manifest), nil
+}
@@ -0,0 +1,138 @@
+package models
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var testKustomization = map[string]string{
+	"base/kustomization.yaml": `resources:
+- deployment.yaml
+`,
+	"base/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: web
+        image: nginx
+`,
+	"overlays/prod/kustomization.yaml": `namePrefix: prod-
+resources:
+- ../../base
+patches:
+- target:
+    kind: Deployment
+    name: web
+  patch: |-
+    - op: replace
+      path: /spec/replicas
+      value: 3
+`,
+}
+
+func TestRenderKustomization(t *testing.T) {
+	files := []RepoFile{}
+	for path, content := range testKustomization {
+		files = append(files, RepoFile{Path: path, Content: []byte(content)})
+	}
+	tarball, err := PackTarball(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		overlay string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "base",
+			overlay: "base",
+			want:    []string{"name: web", "replicas: 1"},
+		},
+		{
+			name:    "overlay referring to its base",
+			overlay: "overlays/prod",
+			want:    []string{"name: prod-web", "replicas: 3"},
+		},
+		{
+			name:    "missing kustomization",
+			overlay: "overlays/dev",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderKustomization(tarball, tt.overlay)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderKustomization() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("RenderKustomization() = %s, want it to contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestFetchKustomizeSource(t *testing.T) {
+	repo := newTestRepo(t)
+	repo.commit(testKustomization)
+
+	// Local repositories are cloned only once they are allowed
+	if _, err := FetchKustomizeSource(repo.url, ""); err == nil {
+		t.Fatal("FetchKustomizeSource() expected an error for a local repository")
+	}
+	allowLocalGitRepos(t)
+
+	tarball, err := FetchKustomizeSource(repo.url, "")
+	if err != nil {
+		t.Fatalf("FetchKustomizeSource() error = %v", err)
+	}
+
+	got, err := RenderKustomization(tarball, "overlays/prod")
+	if err != nil {
+		t.Fatalf("RenderKustomization() error = %v", err)
+	}
+	if !strings.Contains(got, "name: prod-web") {
+		t.Errorf("RenderKustomization() = %s, want the prod overlay", got)
+	}
+}
+
+func TestFetchKustomizeTarball(t *testing.T) {
+	tarball, err := PackTarball([]RepoFile{{Path: "base/kustomization.yaml", Content: []byte("resources: []\n")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/large.tgz" {
+			_, _ = w.Write(make([]byte, maxTarballSize+1))
+			return
+		}
+		_, _ = w.Write(tarball)
+	}))
+	defer srv.Close()
+
+	got, err := FetchKustomizeSource(srv.URL+"/base.tgz", "")
+	if err != nil {
+		t.Fatalf("FetchKustomizeSource() error = %v", err)
+	}
+	if !bytes.Equal(got, tarball) {
+		t.Error("FetchKustomizeSource() didn't return the downloaded tarball")
+	}
+
+	if _, err := FetchKustomizeSource(srv.URL+"/large.tgz", ""); err == nil || !strings.Contains(err.Error(), "exceeds the limit") {
+		t.Errorf("FetchKustomizeSource() error = %v, want the limit to be exceeded", err)
+	}
+}
@@ -1,13 +1,16 @@
 package models
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
+	"strings"
 	"time"
 
 	"fortio.org/fortio/fhttp"
 	"fortio.org/fortio/periodic"
 	"github.com/gofrs/uuid"
+	SMP "github.com/layer5io/service-mesh-performance/spec"
 	"github.com/sirupsen/logrus"
 )
 
@@ -42,8 +45,24 @@ const (
 
 	// TCP Load Test
 	TCP SupportedLoadTestMethods = 3
+
+	// UDP Load Test
+	UDP SupportedLoadTestMethods = 4
 )
 
+// LoadTestMethodFromURL returns the load test method implied by the scheme of the url,
+// tcp:// and udp:// urls are load tested with echo requests and all others with HTTP
+func LoadTestMethodFromURL(u string) SupportedLoadTestMethods {
+	switch {
+	case strings.HasPrefix(u, "tcp://"):
+		return TCP
+	case strings.HasPrefix(u, "udp://"):
+		return UDP
+	default:
+		return HTTP
+	}
+}
+
 // LoadTestOptions represents the load test options
 type LoadTestOptions struct {
 	Name     string
@@ -58,10 +77,36 @@ type LoadTestOptions struct {
 	Cookies     *map[string]string
 	Body        []byte
 	ContentType string
+	// Method of the requests, if empty it is implied by the body
+	Method string
+
+	// PayloadSize is the size in bytes of a random payload sent instead of the body
+	PayloadSize int
+	// DisableConnectionReuse opens a new connection for every request
+	DisableConnectionReuse bool
 
 	IsInsecure bool
 	Duration   time.Duration
 
+	// Endpoints are driven at once with their own requests, each one gets a weighted
+	// share of the load of the options unless it sets its own
+	Endpoints LoadTestEndpoints
+
+	// Stages are run back to back with their own QPS, concurrency and duration
+	// instead of the HTTPQPS, HTTPNumThreads and Duration of the options
+	Stages LoadTestStages
+
+	// Workers are the urls of the load test workers the load is split evenly across,
+	// the load is generated in process when empty
+	Workers []string
+	// WorkerToken authenticates the requests to the workers
+	WorkerToken string `json:"-"`
+
+	// Ctx cancels the load test when done, the results of the requests sent so far are returned
+	Ctx context.Context `json:"-"`
+	// Progress records the requests of the load test as they complete
+	Progress *LoadTestProgressRecorder `json:"-"`
+
 	LoadGenerator LoadGenerator
 
 	SupportedLoadTestMethods SupportedLoadTestMethods
@@ -76,6 +121,20 @@ type LoadTestOptions struct {
 	GRPCHealthSvc    string
 	GRPCDoPing       bool
 	GRPCPingDelay    time.Duration
+
+	// SLO the results are evaluated against, if nil the SLO of the
+	// performance profile is used
+	SLO *PerformanceSLO
+
+	// BaselineResult the results are compared against, if nil the pinned
+	// baseline of the performance profile is used
+	BaselineResult *uuid.UUID
+	// RegressionThreshold is the relative increase of the latency over the
+	// baseline in percent which is a regression
+	RegressionThreshold float64
+
+	// ServiceMesh is the SMP service mesh metadata stored along with the results
+	ServiceMesh *SMP.ServiceMesh `json:"-"`
 }
 
 // LoadTestStatus - used for representing load test status
@@ -90,6 +149,9 @@ const (
 
 	// LoadTestSuccess - represents a success status
 	LoadTestSuccess LoadTestStatus = "success"
+
+	// LoadTestProgress - represents a snapshot of the progress of a running test
+	LoadTestProgress LoadTestStatus = "progress"
 )
 
 // LoadTestResponse - used to bundle the response with status to the client
@@ -97,6 +159,10 @@ type LoadTestResponse struct {
 	Status  LoadTestStatus `json:"status,omitempty"`
 	Message string         `json:"message,omitempty"`
 	Result  *MesheryResult `json:"result,omitempty"`
+
+	Snapshot *LoadTestSnapshot `json:"snapshot,omitempty"`
+	// Overhead is the report of a mesh overhead benchmark once both variants are load tested
+	Overhead *MeshOverheadReport `json:"overhead,omitempty"`
 }
 
 // MesheryResult - represents the results from Meshery test run to be shipped
@@ -127,17 +193,22 @@ func (m *MesheryResult) ConvertToSpec() (*PerformanceSpec, error) {
 	var (
 		results periodic.HasRunnerResult
 	)
-	retcodesString, _ := m.Result["RetCodes"].(map[string]interface{})
-	logrus.Debugf("retcodes: %+v, %T", m.Result["RetCodes"], m.Result["RetCodes"])
-	retcodes := map[int]int64{}
-	for k, v := range retcodesString {
-		k1, _ := strconv.Atoi(k)
-		retcodes[k1], _ = v.(int64)
+	runType, _ := m.Result["RunType"].(string)
+	// the ret codes of TCP and UDP echo tests are OK or the error of the request
+	if runType == "HTTP" {
+		retcodesString, _ := m.Result["RetCodes"].(map[string]interface{})
+		logrus.Debugf("retcodes: %+v, %T", m.Result["RetCodes"], m.Result["RetCodes"])
+		retcodes := map[int]int64{}
+		for k, v := range retcodesString {
+			k1, _ := strconv.Atoi(k)
+			retcodes[k1], _ = v.(int64)
+		}
+		m.Result["RetCodes"] = retcodes
 	}
-	m.Result["RetCodes"] = retcodes
 	// loadGenerator := m.Result["load-generator"].(string)
 	logrus.Debugf("result to be converted: %+v", m)
-	if m.Result["RunType"].(string) == "HTTP" {
+	switch runType {
+	case "HTTP":
 		httpResults := &fhttp.HTTPRunnerResults{}
 		resJ, err := json.Marshal(m.Result)
 		if err != nil {
@@ -150,6 +221,20 @@ func (m *MesheryResult) ConvertToSpec() (*PerformanceSpec, error) {
 
 		results = httpResults
 		logrus.Debugf("httpresults: %+v", httpResults)
+	case "TCP", "UDP":
+		socketResults := &periodic.RunnerResults{}
+		resJ, err := json.Marshal(m.Result)
+		if err != nil {
+			return nil, ErrMarshal(err, "Perf Results")
+		}
+		err = json.Unmarshal(resJ, socketResults)
+		if err != nil {
+			return nil, ErrUnmarshal(err, "Perf Results")
+		}
+
+		results = socketResults
+	default:
+		return nil, ErrUnsupportedRunType(runType)
 	}
 
 	result := results.Result()
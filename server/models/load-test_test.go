@@ -0,0 +1,51 @@
+package models
+
+import "testing"
+
+func TestLoadTestMethodFromURL(t *testing.T) {
+	tests := map[string]SupportedLoadTestMethods{
+		"tcp://mysql.default.svc:3306": TCP,
+		"udp://dns.default.svc:53":     UDP,
+		"http://productpage:9080":      HTTP,
+		"https://meshery.io":           HTTP,
+	}
+	for u, want := range tests {
+		if got := LoadTestMethodFromURL(u); got != want {
+			t.Errorf("LoadTestMethodFromURL(%q) = %d, want %d", u, got, want)
+		}
+	}
+}
+
+func TestConvertSocketResultToSpec(t *testing.T) {
+	m := &MesheryResult{Result: map[string]interface{}{
+		"RunType":        "TCP",
+		"ActualQPS":      99.5,
+		"ActualDuration": 1e9,
+		"RetCodes":       map[string]interface{}{"OK": 100.0},
+		"DurationHistogram": map[string]interface{}{
+			"Min": 0.001,
+			"Max": 0.004,
+			"Avg": 0.002,
+			"Percentiles": []interface{}{
+				map[string]interface{}{"Percentile": 50.0, "Value": 0.0018},
+				map[string]interface{}{"Percentile": 99.0, "Value": 0.0039},
+			},
+		},
+	}}
+
+	spec, err := m.ConvertToSpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.ActualQPS != 99.5 || spec.Latencies.Max != 0.004 || spec.Latencies.P50 != 0.0018 || spec.Latencies.P99 != 0.0039 {
+		t.Errorf("ConvertToSpec() = %+v, latencies %+v", spec, spec.Latencies)
+	}
+	if codes, _ := m.Result["RetCodes"].(map[string]interface{}); codes["OK"] != 100.0 {
+		t.Errorf("ConvertToSpec() changed the ret codes to %v", m.Result["RetCodes"])
+	}
+
+	m.Result["RunType"] = "GRPC"
+	if _, err := m.ConvertToSpec(); err == nil {
+		t.Error("ConvertToSpec() expected an error for a gRPC result")
+	}
+}
@@ -0,0 +1,180 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	SMP "github.com/layer5io/service-mesh-performance/spec"
+)
+
+// LoadTestEndpointsKey is the key of the runner results under which the results of the
+// individual endpoints are stored
+const LoadTestEndpointsKey = "endpoints"
+
+// LoadTestEndpoint is an endpoint driven by a load test along with the other endpoints
+// of the test, the requests of the endpoint are sent concurrently with the other ones
+type LoadTestEndpoint struct {
+	URL         string            `json:"url"`
+	Method      string            `json:"method,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Cookies     map[string]string `json:"cookies,omitempty"`
+	Body        string            `json:"body,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	// Weight is the share of the QPS and concurrency of the test sent to the endpoint
+	// relative to the other endpoints, 0 counts as 1
+	Weight float64 `json:"weight,omitempty"`
+
+	// QPS and Concurrency set the load of the endpoint instead of its weighted share
+	QPS         float64 `json:"qps,omitempty"`
+	Concurrency int     `json:"concurrency,omitempty"`
+	// LoadGenerator drives the endpoint instead of the load generator of the test
+	LoadGenerator LoadGenerator `json:"load_generator,omitempty"`
+}
+
+// GetWeight returns the weight of the endpoint
+func (e LoadTestEndpoint) GetWeight() float64 {
+	if e.Weight <= 0 {
+		return 1
+	}
+
+	return e.Weight
+}
+
+// Validate checks that the endpoint can be load tested
+func (e LoadTestEndpoint) Validate() error {
+	u, err := url.Parse(e.URL)
+	if err != nil || !u.IsAbs() {
+		return fmt.Errorf("%q is not an absolute url", e.URL)
+	}
+	if e.Weight < 0 || e.QPS < 0 || e.Concurrency < 0 {
+		return fmt.Errorf("weight, qps and concurrency of %s can't be negative", e.URL)
+	}
+	switch strings.ToUpper(e.Method) {
+	case "", http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete, http.MethodOptions, http.MethodTrace, http.MethodConnect:
+	default:
+		return fmt.Errorf("unknown http method %s for %s", e.Method, e.URL)
+	}
+
+	return nil
+}
+
+// LoadTestEndpoints are the endpoints driven at once by a load test
+//
+// It implements native SQL driver interfaces so that it can be
+// persisted along with the performance profile
+type LoadTestEndpoints []LoadTestEndpoint
+
+// Validate checks that all the endpoints can be load tested
+func (e LoadTestEndpoints) Validate() error {
+	for _, endpoint := range e {
+		if err := endpoint.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TotalWeight returns the sum of the weights of the endpoints
+func (e LoadTestEndpoints) TotalWeight() float64 {
+	var total float64
+	for _, endpoint := range e {
+		total += endpoint.GetWeight()
+	}
+
+	return total
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *LoadTestEndpoints) Scan(src interface{}) error {
+	var b []byte
+
+	switch t := src.(type) {
+	case nil:
+		*e = nil
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+
+	return json.Unmarshal(b, e)
+}
+
+// Value implements the driver.Valuer interface.
+func (e LoadTestEndpoints) Value() (driver.Value, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// GetLoadTestEndpoints returns the endpoints the profile drives at once, nil if the
+// profile tests a single endpoint with its request options
+func (p *PerformanceProfile) GetLoadTestEndpoints() LoadTestEndpoints {
+	if len(p.Scenario) > 0 {
+		return p.Scenario
+	}
+	if len(p.Endpoints) < 2 {
+		return nil
+	}
+
+	headers, cookies := map[string]string{}, map[string]string{}
+	_ = json.Unmarshal([]byte(p.RequestHeaders), &headers)
+	_ = json.Unmarshal([]byte(p.RequestCookies), &cookies)
+	endpoints := LoadTestEndpoints{}
+	for _, u := range p.Endpoints {
+		endpoints = append(endpoints, LoadTestEndpoint{
+			URL:         u,
+			Headers:     headers,
+			Cookies:     cookies,
+			Body:        p.RequestBody,
+			ContentType: p.ContentType,
+		})
+	}
+
+	return endpoints
+}
+
+// SMPClientEndpoints returns an endpoint for every endpoint url of the clients of a SMP
+// test configuration, the load of a client is split evenly across its endpoint urls
+func SMPClientEndpoints(clients []*SMP.PerformanceTestConfig_Client) LoadTestEndpoints {
+	endpoints := LoadTestEndpoints{}
+	for _, c := range clients {
+		if c == nil {
+			continue
+		}
+		n := len(c.EndpointUrls)
+		for _, u := range c.EndpointUrls {
+			concurrency := int(c.Connections) / n
+			if concurrency < 1 {
+				concurrency = 1
+			}
+			endpoints = append(endpoints, LoadTestEndpoint{
+				URL:           u,
+				Headers:       c.Headers,
+				Cookies:       c.Cookies,
+				Body:          c.Body,
+				ContentType:   c.ContentType,
+				QPS:           float64(c.Rps) / float64(n),
+				Concurrency:   concurrency,
+				LoadGenerator: LoadGenerator(c.LoadGenerator),
+			})
+		}
+	}
+
+	return endpoints
+}
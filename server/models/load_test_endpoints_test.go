@@ -0,0 +1,56 @@
+package models
+
+import (
+	"testing"
+
+	SMP "github.com/layer5io/service-mesh-performance/spec"
+)
+
+func TestPerformanceProfileGetLoadTestEndpoints(t *testing.T) {
+	profile := &PerformanceProfile{
+		Endpoints:      []string{"http://productpage:9080/"},
+		RequestHeaders: `{"x-user": "jason"}`,
+	}
+	if e := profile.GetLoadTestEndpoints(); e != nil {
+		t.Errorf("GetLoadTestEndpoints() of a single endpoint = %+v, want nil", e)
+	}
+
+	profile.Endpoints = append(profile.Endpoints, "http://reviews:9080/")
+	e := profile.GetLoadTestEndpoints()
+	if len(e) != 2 || e[1].URL != "http://reviews:9080/" || e[1].Headers["x-user"] != "jason" || e.TotalWeight() != 2 {
+		t.Errorf("GetLoadTestEndpoints() = %+v, want both endpoints with the profile headers", e)
+	}
+
+	profile.Scenario = LoadTestEndpoints{{URL: "http://ratings:9080/", Method: "POST", Weight: 2}}
+	if e := profile.GetLoadTestEndpoints(); len(e) != 1 || e[0].Method != "POST" {
+		t.Errorf("GetLoadTestEndpoints() = %+v, want the scenario", e)
+	}
+}
+
+func TestSMPClientEndpoints(t *testing.T) {
+	e := SMPClientEndpoints([]*SMP.PerformanceTestConfig_Client{
+		{LoadGenerator: "fortio", Rps: 100, Connections: 4, EndpointUrls: []string{"http://productpage:9080/", "http://reviews:9080/"}},
+		{LoadGenerator: "wrk2", Rps: 10, Connections: 1, EndpointUrls: []string{"http://ratings:9080/"}},
+	})
+	if len(e) != 3 {
+		t.Fatalf("SMPClientEndpoints() returned %d endpoints, want 3", len(e))
+	}
+	if e[0].QPS != 50 || e[0].Concurrency != 2 || e[0].LoadGenerator != FortioLG {
+		t.Errorf("SMPClientEndpoints() first endpoint = %+v, want half of the first client", e[0])
+	}
+	if e[2].QPS != 10 || e[2].Concurrency != 1 || e[2].LoadGenerator != Wrk2LG {
+		t.Errorf("SMPClientEndpoints() last endpoint = %+v, want the second client", e[2])
+	}
+}
+
+func TestLoadTestEndpointValidate(t *testing.T) {
+	if err := (LoadTestEndpoint{URL: "http://productpage:9080/", Method: "patch"}).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := (LoadTestEndpoint{URL: "/productpage"}).Validate(); err == nil {
+		t.Error("Validate() expected an error for a relative url")
+	}
+	if err := (LoadTestEndpoint{URL: "http://productpage:9080/", Method: "FETCH"}).Validate(); err == nil {
+		t.Error("Validate() expected an error for an unknown method")
+	}
+}
@@ -0,0 +1,249 @@
+package models
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"fortio.org/fortio/periodic"
+	"fortio.org/fortio/stats"
+)
+
+// LoadTestSnapshotInterval is the interval at which the progress of running load tests is reported
+const LoadTestSnapshotInterval = 5 * time.Second
+
+// LoadTestSnapshot is the progress of a running load test, the QPS and latencies are the
+// ones of the requests completed since the previous snapshot
+type LoadTestSnapshot struct {
+	TestID string `json:"test_id"`
+	// Elapsed is the number of seconds since the start of the load test
+	Elapsed float64 `json:"elapsed"`
+	// Requests is the number of requests completed since the start of the load test
+	Requests int64   `json:"requests"`
+	QPS      float64 `json:"qps"`
+
+	LatencyAvg float64 `json:"latency_avg_ms"`
+	LatencyP50 float64 `json:"latency_p50_ms"`
+	LatencyP90 float64 `json:"latency_p90_ms"`
+	LatencyP99 float64 `json:"latency_p99_ms"`
+	LatencyMax float64 `json:"latency_max_ms"`
+}
+
+// LoadTestProgressRecorder records the requests of a running load test to take snapshots
+// of its progress. It implements the fortio periodic.AccessLogger interface so that it
+// records the requests of the fortio runs it is the access logger of.
+type LoadTestProgressRecorder struct {
+	mu       sync.Mutex
+	start    time.Time
+	last     time.Time
+	requests int64
+	interval *stats.Histogram
+}
+
+// NewLoadTestProgressRecorder returns a recorder of a load test starting now
+func NewLoadTestProgressRecorder() *LoadTestProgressRecorder {
+	now := time.Now()
+	return &LoadTestProgressRecorder{
+		start:    now,
+		last:     now,
+		interval: stats.NewHistogram(0, periodic.DefaultRunnerOptions.Resolution),
+	}
+}
+
+// Report records a request which took latency seconds
+func (p *LoadTestProgressRecorder) Report(thread int, time int64, latency float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.requests++
+	p.interval.Record(latency)
+}
+
+// Info describes the recorder as an access logger
+func (p *LoadTestProgressRecorder) Info() string {
+	return "meshery load test progress"
+}
+
+// Snapshot returns the progress of the load test since the previous snapshot
+func (p *LoadTestProgressRecorder) Snapshot(testID string) *LoadTestSnapshot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	s := &LoadTestSnapshot{
+		TestID:   testID,
+		Elapsed:  now.Sub(p.start).Seconds(),
+		Requests: p.requests,
+	}
+	if d := now.Sub(p.last).Seconds(); d > 0 {
+		s.QPS = float64(p.interval.Count) / d
+	}
+	if p.interval.Count > 0 {
+		// fortio records the latencies in seconds
+		h := p.interval.Export()
+		s.LatencyAvg = h.Avg * 1000
+		s.LatencyP50 = h.CalcPercentile(50) * 1000
+		s.LatencyP90 = h.CalcPercentile(90) * 1000
+		s.LatencyP99 = h.CalcPercentile(99) * 1000
+		s.LatencyMax = h.Max * 1000
+	}
+
+	p.last = now
+	p.interval = stats.NewHistogram(0, periodic.DefaultRunnerOptions.Resolution)
+
+	return s
+}
+
+// LoadTestRun is a load test running on the server
+type LoadTestRun struct {
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	PerformanceProfile string            `json:"performance_profile,omitempty"`
+	UserID             string            `json:"user_id,omitempty"`
+	StartedAt          time.Time         `json:"started_at"`
+	Snapshot           *LoadTestSnapshot `json:"snapshot,omitempty"`
+
+	cancel      context.CancelFunc
+	subscribers map[chan *LoadTestSnapshot]struct{}
+}
+
+// LoadTestRegistry keeps track of the load tests running on the server by their test id
+// so that they can be monitored and cancelled
+type LoadTestRegistry struct {
+	mu   sync.Mutex
+	runs map[string]*LoadTestRun
+}
+
+// NewLoadTestRegistry returns an empty registry
+func NewLoadTestRegistry() *LoadTestRegistry {
+	return &LoadTestRegistry{runs: map[string]*LoadTestRun{}}
+}
+
+// Register adds the run to the registry, cancel stops it
+func (r *LoadTestRegistry) Register(run *LoadTestRun, cancel context.CancelFunc) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.runs[run.ID]; ok {
+		return ErrLoadTestRunning(run.ID)
+	}
+	run.cancel = cancel
+	run.subscribers = map[chan *LoadTestSnapshot]struct{}{}
+	r.runs[run.ID] = run
+
+	return nil
+}
+
+// Deregister removes the run from the registry once it is over, its subscriptions are closed
+func (r *LoadTestRegistry) Deregister(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[id]
+	if !ok {
+		return
+	}
+	for ch := range run.subscribers {
+		delete(run.subscribers, ch)
+		close(ch)
+	}
+	delete(r.runs, id)
+}
+
+// Get returns a copy of the run with the given id
+func (r *LoadTestRegistry) Get(id string) (LoadTestRun, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[id]
+	if !ok {
+		return LoadTestRun{}, false
+	}
+
+	return run.public(), true
+}
+
+// List returns copies of the runs ordered by their start
+func (r *LoadTestRegistry) List() []LoadTestRun {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runs := make([]LoadTestRun, 0, len(r.runs))
+	for _, run := range r.runs {
+		runs = append(runs, run.public())
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return runs[i].StartedAt.Before(runs[j].StartedAt)
+	})
+
+	return runs
+}
+
+// Cancel cancels the run with the given id, it returns false if there is no such run
+func (r *LoadTestRegistry) Cancel(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[id]
+	if !ok {
+		return false
+	}
+	run.cancel()
+
+	return true
+}
+
+// Publish stores the snapshot as the latest one of the run and sends it to its subscribers,
+// subscribers which are not keeping up miss the snapshot
+func (r *LoadTestRegistry) Publish(s *LoadTestSnapshot) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[s.TestID]
+	if !ok {
+		return
+	}
+	run.Snapshot = s
+	for ch := range run.subscribers {
+		select {
+		case ch <- s:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the snapshots of the run, it is closed when the
+// run is over or unsubscribe is called. It returns false if there is no such run.
+func (r *LoadTestRegistry) Subscribe(id string) (snapshots <-chan *LoadTestSnapshot, unsubscribe func(), ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, ok := r.runs[id]
+	if !ok {
+		return nil, nil, false
+	}
+	ch := make(chan *LoadTestSnapshot, 1)
+	run.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		if _, ok := run.subscribers[ch]; ok {
+			delete(run.subscribers, ch)
+			close(ch)
+		}
+	}, true
+}
+
+func (run *LoadTestRun) public() LoadTestRun {
+	return LoadTestRun{
+		ID:                 run.ID,
+		Name:               run.Name,
+		PerformanceProfile: run.PerformanceProfile,
+		UserID:             run.UserID,
+		StartedAt:          run.StartedAt,
+		Snapshot:           run.Snapshot,
+	}
+}
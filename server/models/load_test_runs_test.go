@@ -0,0 +1,68 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLoadTestProgressRecorder(t *testing.T) {
+	p := NewLoadTestProgressRecorder()
+	for _, latency := range []float64{0.001, 0.002, 0.003, 0.010} {
+		p.Report(0, time.Now().UnixNano(), latency)
+	}
+
+	s := p.Snapshot("test")
+	if s.TestID != "test" || s.Requests != 4 || s.QPS <= 0 {
+		t.Errorf("Snapshot() = %+v, want the 4 requests recorded", s)
+	}
+	if s.LatencyMax != 10 || s.LatencyAvg != 4 || s.LatencyP50 > s.LatencyP99 {
+		t.Errorf("Snapshot() = %+v, want the latencies in milliseconds", s)
+	}
+
+	p.Report(0, time.Now().UnixNano(), 0.005)
+	if s := p.Snapshot("test"); s.Requests != 5 || s.LatencyMax != 5 {
+		t.Errorf("Snapshot() = %+v, want the total requests and the latencies since the previous snapshot", s)
+	}
+}
+
+func TestLoadTestRegistry(t *testing.T) {
+	r := NewLoadTestRegistry()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := r.Register(&LoadTestRun{ID: "test", StartedAt: time.Now()}, cancel); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if err := r.Register(&LoadTestRun{ID: "test"}, cancel); err == nil {
+		t.Error("Register() of a running test succeeded, want an error")
+	}
+
+	snapshots, unsubscribe, ok := r.Subscribe("test")
+	if !ok {
+		t.Fatal("Subscribe() of a running test failed")
+	}
+	defer unsubscribe()
+	r.Publish(&LoadTestSnapshot{TestID: "test", Requests: 10})
+	if s := <-snapshots; s.Requests != 10 {
+		t.Errorf("subscriber received %+v, want the published snapshot", s)
+	}
+	if run, ok := r.Get("test"); !ok || run.Snapshot == nil || run.Snapshot.Requests != 10 {
+		t.Errorf("Get() = %+v, want the latest snapshot", run)
+	}
+
+	if !r.Cancel("test") || ctx.Err() == nil {
+		t.Error("Cancel() did not cancel the context of the test")
+	}
+	if r.Cancel("other") {
+		t.Error("Cancel() of a test which is not running succeeded")
+	}
+
+	r.Deregister("test")
+	if _, ok := <-snapshots; ok {
+		t.Error("subscription still open once the test is deregistered")
+	}
+	if runs := r.List(); len(runs) != 0 {
+		t.Errorf("List() = %+v, want no running tests", runs)
+	}
+}
@@ -0,0 +1,128 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// LoadTestStagesKey is the key of the runner results under which the results of the
+// individual stages are stored
+const LoadTestStagesKey = "stages"
+
+// LoadTestStage is a stage of a load test, the stages of a load test are run back to back
+type LoadTestStage struct {
+	Name     string `json:"name,omitempty"`
+	Duration string `json:"duration"`
+	// QPS is the queries per second at the start of the stage
+	QPS float64 `json:"qps"`
+	// TargetQPS makes the stage a linear ramp from QPS to TargetQPS over the duration,
+	// a ramp can go down to 0
+	TargetQPS *float64 `json:"target_qps,omitempty"`
+	// MaxQPS runs a constant stage at the max QPS, a QPS of 0 is rejected otherwise
+	// as the load generators take it for the max
+	MaxQPS bool `json:"max_qps,omitempty"`
+	// Concurrency is the number of threads of the stage, if 0 the one of the test is used
+	Concurrency int `json:"concurrency,omitempty"`
+}
+
+// IsRamp checks if the QPS of the stage changes over its duration
+func (s LoadTestStage) IsRamp() bool {
+	return s.TargetQPS != nil && *s.TargetQPS != s.QPS
+}
+
+// GetDuration returns the parsed duration of the stage
+func (s LoadTestStage) GetDuration() (time.Duration, error) {
+	d, err := time.ParseDuration(s.Duration)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration should be positive")
+	}
+
+	return d, nil
+}
+
+// Validate checks that the stage can be run
+func (s LoadTestStage) Validate() error {
+	if _, err := s.GetDuration(); err != nil {
+		return fmt.Errorf("invalid duration %q: %s", s.Duration, err)
+	}
+	if s.QPS < 0 || (s.TargetQPS != nil && *s.TargetQPS < 0) {
+		return fmt.Errorf("qps can't be negative")
+	}
+	switch {
+	case s.MaxQPS && (s.IsRamp() || s.QPS != 0):
+		return fmt.Errorf("a stage run at the max qps can't set its qps")
+	case !s.MaxQPS && !s.IsRamp() && s.QPS == 0:
+		return fmt.Errorf("qps of a constant stage should be positive, set max_qps to run it at the max qps")
+	}
+	if s.Concurrency < 0 {
+		return fmt.Errorf("concurrency can't be negative")
+	}
+
+	return nil
+}
+
+// LoadTestStages is the sequence of stages of a load test
+//
+// It implements native SQL driver interfaces so that it can be
+// persisted along with the performance profile
+type LoadTestStages []LoadTestStage
+
+// Validate checks that all the stages can be run
+func (s LoadTestStages) Validate() error {
+	for i, stage := range s {
+		if err := stage.Validate(); err != nil {
+			return fmt.Errorf("stage %d: %s", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// TotalDuration returns the sum of the durations of the stages
+func (s LoadTestStages) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, stage := range s {
+		d, _ := stage.GetDuration()
+		total += d
+	}
+
+	return total
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *LoadTestStages) Scan(src interface{}) error {
+	var b []byte
+
+	switch t := src.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		b = t
+	case string:
+		b = []byte(t)
+	default:
+		return fmt.Errorf("scan source was not []byte nor string but %T", src)
+	}
+
+	return json.Unmarshal(b, s)
+}
+
+// Value implements the driver.Valuer interface.
+func (s LoadTestStages) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
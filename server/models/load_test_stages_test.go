@@ -0,0 +1,31 @@
+package models
+
+import "testing"
+
+func TestLoadTestStageValidate(t *testing.T) {
+	zero, hundred := float64(0), float64(100)
+	for _, tc := range []struct {
+		name  string
+		stage LoadTestStage
+		valid bool
+	}{
+		{"constant", LoadTestStage{Duration: "10s", QPS: 50}, true},
+		{"constant without qps", LoadTestStage{Duration: "10s"}, false},
+		{"max qps", LoadTestStage{Duration: "10s", MaxQPS: true}, true},
+		{"max qps with a qps", LoadTestStage{Duration: "10s", QPS: 50, MaxQPS: true}, false},
+		{"ramp up from 0", LoadTestStage{Duration: "10s", TargetQPS: &hundred}, true},
+		{"ramp down to 0", LoadTestStage{Duration: "10s", QPS: 100, TargetQPS: &zero}, true},
+		{"ramp at the max qps", LoadTestStage{Duration: "10s", QPS: 100, TargetQPS: &zero, MaxQPS: true}, false},
+		{"ramp to the same qps", LoadTestStage{Duration: "10s", TargetQPS: &zero}, false},
+		{"negative target", LoadTestStage{Duration: "10s", QPS: 10, TargetQPS: func() *float64 { q := -1.0; return &q }()}, false},
+	} {
+		if err := tc.stage.Validate(); (err == nil) != tc.valid {
+			t.Errorf("%s: Validate() = %v, want valid %t", tc.name, err, tc.valid)
+		}
+	}
+
+	ramp := LoadTestStage{Duration: "10s", QPS: 100, TargetQPS: &zero}
+	if !ramp.IsRamp() {
+		t.Error("ramp down to 0 is not a ramp")
+	}
+}
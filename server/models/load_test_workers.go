@@ -0,0 +1,18 @@
+package models
+
+// LoadTestWorkersKey is the key of the runner results under which the results of the
+// individual load test workers are stored
+const LoadTestWorkersKey = "workers"
+
+// LoadTestWorkerPath is the path load test workers run the load tests posted to
+const LoadTestWorkerPath = "/api/perf/worker/run"
+
+// LoadTestWorkerMessage is a message streamed by a load test worker while it runs a
+// load test, the last message carries either the runner results or an error
+type LoadTestWorkerMessage struct {
+	Status  LoadTestStatus         `json:"status"`
+	Message string                 `json:"message,omitempty"`
+	Result  map[string]interface{} `json:"result,omitempty"`
+	// Snapshot is the progress of the load test on the worker
+	Snapshot *LoadTestSnapshot `json:"snapshot,omitempty"`
+}
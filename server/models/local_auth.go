@@ -0,0 +1,332 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// oidcStateCookieName is the cookie holding the state of an OIDC login in progress
+	oidcStateCookieName = "meshery-oidc-state"
+
+	// DefaultLocalSessionDuration is the lifetime of the sessions of the local users
+	DefaultLocalSessionDuration = 24 * time.Hour
+)
+
+// dummyPasswordHash is compared against the password of unknown users so that
+// a failed login takes the same time whether the user exists or not
+var dummyPasswordHash = func() string {
+	u := &LocalUser{}
+	_ = u.SetPassword("meshery-dummy-password")
+	return u.PasswordHash
+}()
+
+// LocalOIDCConfig configures the login of the local users through an OIDC
+// identity provider, such as a Dex or Keycloak instance standing in for the
+// identity provider of the organization
+type LocalOIDCConfig struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the URL of the token endpoint of Meshery, /api/user/token,
+	// as registered at the identity provider
+	RedirectURL string
+	// DefaultRole is the role of the users created by their first OIDC login
+	DefaultRole UserRole
+
+	mx               sync.Mutex
+	endpoint         *oauth2.Endpoint
+	userInfoEndpoint string
+}
+
+// oidcUserInfo holds the claims of the userinfo endpoint used by Meshery
+type oidcUserInfo struct {
+	Subject           string `json:"sub"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+	GivenName         string `json:"given_name"`
+	FamilyName        string `json:"family_name"`
+}
+
+// config returns the OAuth2 configuration of the identity provider, the endpoints
+// are discovered on first use
+func (c *LocalOIDCConfig) config(ctx context.Context) (*oauth2.Config, string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.endpoint == nil {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.Issuer, "/")+"/.well-known/openid-configuration", nil)
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer SafeClose(resp.Body)
+		if resp.StatusCode != http.StatusOK {
+			return nil, "", fmt.Errorf("discovery of the identity provider %s failed with status %s", c.Issuer, resp.Status)
+		}
+
+		discovery := struct {
+			AuthorizationEndpoint string `json:"authorization_endpoint"`
+			TokenEndpoint         string `json:"token_endpoint"`
+			UserInfoEndpoint      string `json:"userinfo_endpoint"`
+		}{}
+		if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+			return nil, "", err
+		}
+		if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.UserInfoEndpoint == "" {
+			return nil, "", fmt.Errorf("the identity provider %s does not publish its authorization, token and userinfo endpoints", c.Issuer)
+		}
+
+		c.endpoint = &oauth2.Endpoint{AuthURL: discovery.AuthorizationEndpoint, TokenURL: discovery.TokenEndpoint}
+		c.userInfoEndpoint = discovery.UserInfoEndpoint
+	}
+
+	return &oauth2.Config{
+		ClientID:     c.ClientID,
+		ClientSecret: c.ClientSecret,
+		RedirectURL:  c.RedirectURL,
+		Endpoint:     *c.endpoint,
+		Scopes:       []string{"openid", "profile", "email"},
+	}, c.userInfoEndpoint, nil
+}
+
+// userInfo exchanges the authorization code and returns the claims of the user
+func (c *LocalOIDCConfig) userInfo(ctx context.Context, code string) (*oidcUserInfo, error) {
+	cfg, userInfoEndpoint, err := c.config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := cfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cfg.Client(ctx, token).Get(userInfoEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	defer SafeClose(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo request failed with status %s", resp.Status)
+	}
+
+	info := &oidcUserInfo{}
+	if err := json.NewDecoder(resp.Body).Decode(info); err != nil {
+		return nil, err
+	}
+	if info.Subject == "" {
+		return nil, fmt.Errorf("the userinfo of the identity provider has no subject")
+	}
+
+	return info, nil
+}
+
+// localSessionToken returns the session token of the request, taken from the
+// bearer token of the Authorization header or else from the token cookie
+func localSessionToken(req *http.Request) string {
+	if auth := req.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(auth, "Bearer "))
+	}
+	if ck, err := req.Cookie(tokenName); err == nil {
+		return ck.Value
+	}
+
+	return ""
+}
+
+// sessionUser returns the local user of the session of the request
+func (l *DefaultLocalProvider) sessionUser(req *http.Request) (*LocalUser, error) {
+	token := localSessionToken(req)
+	if token == "" {
+		return nil, ErrLocalSession(fmt.Errorf("the request has no session token"))
+	}
+
+	return l.LocalUserPersister.GetSessionUser(token)
+}
+
+// issueLocalSession creates a session of the user and sets its cookie
+func (l *DefaultLocalProvider) issueLocalSession(w http.ResponseWriter, user *LocalUser) (string, error) {
+	duration := l.SessionDuration
+	if duration <= 0 {
+		duration = DefaultLocalSessionDuration
+	}
+
+	token, err := l.LocalUserPersister.CreateSession(*user.ID, duration)
+	if err != nil {
+		return "", ErrLocalSession(err)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     tokenName,
+		Value:    token,
+		Path:     "/",
+		Expires:  time.Now().Add(duration),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	return token, nil
+}
+
+// LoginWithPassword checks the credentials of the local user and issues a session,
+// the token of the session is returned along with the user
+func (l *DefaultLocalProvider) LoginWithPassword(w http.ResponseWriter, userName, password string) (*User, string, error) {
+	if !l.MultiUser {
+		return nil, "", ErrLocalProviderSupport
+	}
+
+	user, err := l.LocalUserPersister.GetUserByName(userName)
+	if err != nil {
+		(&LocalUser{PasswordHash: dummyPasswordHash}).CheckPassword(password)
+		return nil, "", ErrInvalidCredentials
+	}
+	if !user.CheckPassword(password) {
+		return nil, "", ErrInvalidCredentials
+	}
+
+	token, err := l.issueLocalSession(w, user)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return user.ToUser(), token, nil
+}
+
+// initiateOIDCLogin redirects to the identity provider
+func (l *DefaultLocalProvider) initiateOIDCLogin(w http.ResponseWriter, req *http.Request) {
+	cfg, _, err := l.OIDC.config(req.Context())
+	if err != nil {
+		err = ErrOIDCLogin(err)
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	state, err := newSessionToken()
+	if err != nil {
+		http.Error(w, ErrOIDCLogin(err).Error(), http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    state,
+		Path:     "/",
+		Expires:  time.Now().Add(10 * time.Minute),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, req, cfg.AuthCodeURL(state), http.StatusFound)
+}
+
+// completeOIDCLogin handles the redirect of the identity provider, the user is
+// created with the default role on their first login
+func (l *DefaultLocalProvider) completeOIDCLogin(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	ck, err := req.Cookie(oidcStateCookieName)
+	if err != nil || ck.Value == "" || ck.Value != q.Get("state") {
+		http.Error(w, ErrOIDCLogin(fmt.Errorf("the state of the login does not match")).Error(), http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookieName, Path: "/", MaxAge: -1})
+
+	if e := q.Get("error"); e != "" {
+		http.Error(w, ErrOIDCLogin(fmt.Errorf("%s: %s", e, q.Get("error_description"))).Error(), http.StatusUnauthorized)
+		return
+	}
+
+	info, err := l.OIDC.userInfo(req.Context(), q.Get("code"))
+	if err != nil {
+		err = ErrOIDCLogin(err)
+		logrus.Error(err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	user, err := l.LocalUserPersister.GetUserByOIDCSubject(info.Subject)
+	if err != nil {
+		user = &LocalUser{
+			UserName:    info.PreferredUsername,
+			FirstName:   info.GivenName,
+			LastName:    info.FamilyName,
+			Email:       info.Email,
+			Role:        l.OIDC.DefaultRole,
+			OIDCSubject: info.Subject,
+		}
+		if user.UserName == "" {
+			user.UserName = info.Email
+		}
+		if _, err := l.LocalUserPersister.GetUserByName(user.UserName); user.UserName == "" || err == nil {
+			user.UserName = "oidc:" + info.Subject
+		}
+		if !user.Role.IsValid() {
+			user.Role = RoleViewer
+		}
+		if err := l.LocalUserPersister.SaveUser(user); err != nil {
+			err = ErrOIDCLogin(err)
+			logrus.Error(err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if _, err := l.issueLocalSession(w, user); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, req, "/", http.StatusFound)
+}
+
+// localLoginTemplate is the login form of the local users
+var localLoginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Meshery - Sign in</title></head>
+<body style="font-family: sans-serif; display: flex; justify-content: center; margin-top: 10%;">
+<form method="POST" action="/api/user/login" style="display: flex; flex-direction: column; gap: 0.5em; width: 18em;">
+<h2>Sign in to Meshery</h2>
+{{if .Failed}}<p style="color: #c00;">Invalid user name or password</p>{{end}}
+<input type="hidden" name="return_to" value="{{.ReturnTo}}">
+<input name="user_name" placeholder="User name" autocomplete="username" required autofocus>
+<input name="password" type="password" placeholder="Password" autocomplete="current-password" required>
+<button type="submit">Sign in</button>
+</form>
+</body>
+</html>`))
+
+// serveLoginForm serves the login form of the local users
+func (l *DefaultLocalProvider) serveLoginForm(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := localLoginTemplate.Execute(w, struct {
+		Failed   bool
+		ReturnTo string
+	}{
+		Failed:   req.URL.Query().Get("error") != "",
+		ReturnTo: SafeReturnURL(req.URL.Query().Get("return_to")),
+	}); err != nil {
+		logrus.Error(err)
+	}
+}
+
+// SafeReturnURL returns the URL if it is a path of Meshery, and "/" otherwise, so
+// that a login can't redirect to another site
+func SafeReturnURL(returnTo string) string {
+	u, err := url.Parse(returnTo)
+	if err != nil || returnTo == "" || u.IsAbs() || u.Host != "" || !strings.HasPrefix(returnTo, "/") || strings.HasPrefix(returnTo, "//") || strings.HasPrefix(returnTo, "/\\") {
+		return "/"
+	}
+
+	return returnTo
+}
@@ -0,0 +1,185 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// minPasswordLength is the minimum length of the passwords of the local users
+const minPasswordLength = 8
+
+// UserRole is the role of a user of the local provider in multi-user mode, every
+// role is allowed to do what the roles below it are allowed to do
+type UserRole string
+
+const (
+	// RoleViewer - can read the state of Meshery and of the clusters
+	RoleViewer UserRole = "viewer"
+
+	// RoleOperator - can also deploy patterns, run load tests and operate the adapters
+	RoleOperator UserRole = "operator"
+
+	// RoleAdmin - can also manage the users, the adapters and the kubernetes contexts
+	RoleAdmin UserRole = "admin"
+)
+
+var userRoleRanks = map[UserRole]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// IsValid returns true if the role is one of the known roles
+func (r UserRole) IsValid() bool {
+	_, ok := userRoleRanks[r]
+	return ok
+}
+
+// Allows returns true if the role grants what the required role grants
+func (r UserRole) Allows(required UserRole) bool {
+	return r.IsValid() && userRoleRanks[r] >= userRoleRanks[required]
+}
+
+// LocalUser is a user of the local provider in multi-user mode, users log in
+// either with their password or through the OIDC identity provider
+type LocalUser struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	UserName  string     `json:"user_name" gorm:"uniqueIndex"`
+	FirstName string     `json:"first_name,omitempty"`
+	LastName  string     `json:"last_name,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	Role      UserRole   `json:"role"`
+
+	// PasswordHash is the bcrypt hash of the password, empty for the
+	// users created by an OIDC login
+	PasswordHash string `json:"-"`
+
+	// OIDCSubject is the subject of the user at the OIDC identity provider
+	OIDCSubject string `json:"oidc_subject,omitempty" gorm:"index"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// SetPassword replaces the password hash of the user
+func (u *LocalUser) SetPassword(password string) error {
+	if len(password) < minPasswordLength {
+		return fmt.Errorf("the password should be at least %d characters long", minPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.PasswordHash = string(hash)
+
+	return nil
+}
+
+// CheckPassword returns true if the password matches the hash of the user
+func (u *LocalUser) CheckPassword(password string) bool {
+	if u.PasswordHash == "" {
+		return false
+	}
+
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+// Validate checks the user name and the role of the user
+func (u *LocalUser) Validate() error {
+	if strings.TrimSpace(u.UserName) == "" {
+		return fmt.Errorf("the user name is required")
+	}
+	if !u.Role.IsValid() {
+		return fmt.Errorf("invalid role %q, the roles are %s, %s and %s", u.Role, RoleViewer, RoleOperator, RoleAdmin)
+	}
+
+	return nil
+}
+
+// ToUser returns the user as seen by the handlers
+func (u *LocalUser) ToUser() *User {
+	user := &User{
+		UserID:    u.UserName,
+		FirstName: u.FirstName,
+		LastName:  u.LastName,
+		Email:     u.Email,
+		Role:      string(u.Role),
+	}
+	if user.FirstName == "" {
+		user.FirstName = u.UserName
+	}
+
+	return user
+}
+
+// LocalUserRequest is the payload creating or updating a local user, the fields
+// left empty keep their value on update
+type LocalUserRequest struct {
+	UserName  string   `json:"user_name,omitempty"`
+	FirstName string   `json:"first_name,omitempty"`
+	LastName  string   `json:"last_name,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	Role      UserRole `json:"role,omitempty"`
+	Password  string   `json:"password,omitempty"`
+}
+
+// Apply updates the user with the fields of the request
+func (r *LocalUserRequest) Apply(user *LocalUser) error {
+	if r.UserName != "" {
+		user.UserName = r.UserName
+	}
+	if r.FirstName != "" {
+		user.FirstName = r.FirstName
+	}
+	if r.LastName != "" {
+		user.LastName = r.LastName
+	}
+	if r.Email != "" {
+		user.Email = r.Email
+	}
+	if r.Role != "" {
+		user.Role = r.Role
+	}
+	if r.Password != "" {
+		if err := user.SetPassword(r.Password); err != nil {
+			return err
+		}
+	}
+
+	return user.Validate()
+}
+
+// LocalSession is a login session of a local user, only the hash of the
+// session token is persisted
+type LocalSession struct {
+	TokenHash string    `json:"-" gorm:"primaryKey"`
+	UserID    uuid.UUID `json:"user_id" gorm:"index"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// newSessionToken returns a random session token
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSessionToken returns the hash of the token under which its session is persisted
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
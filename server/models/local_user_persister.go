@@ -0,0 +1,137 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// LocalUserPersister is the persister for persisting the users
+// of the local provider and their sessions on the database
+type LocalUserPersister struct {
+	DB *database.Handler
+}
+
+// GetUsers returns all of the users ordered by user name
+func (up *LocalUserPersister) GetUsers() ([]LocalUser, error) {
+	users := []LocalUser{}
+	err := up.DB.Order("user_name").Find(&users).Error
+	return users, err
+}
+
+// GetUser returns the user with the given id
+func (up *LocalUserPersister) GetUser(id uuid.UUID) (*LocalUser, error) {
+	user := &LocalUser{}
+	if err := up.DB.First(user, id).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetUserByName returns the user with the given user name
+func (up *LocalUserPersister) GetUserByName(userName string) (*LocalUser, error) {
+	user := &LocalUser{}
+	if err := up.DB.Where("user_name = ?", userName).First(user).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetUserByOIDCSubject returns the user with the given subject at the OIDC identity provider
+func (up *LocalUserPersister) GetUserByOIDCSubject(subject string) (*LocalUser, error) {
+	user := &LocalUser{}
+	if err := up.DB.Where("oidc_subject = ?", subject).First(user).Error; err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// CountUsers returns the number of users with one of the given roles, or of all users if no role is given
+func (up *LocalUserPersister) CountUsers(roles ...UserRole) (int64, error) {
+	count := int64(0)
+	query := up.DB.Model(&LocalUser{})
+	if len(roles) > 0 {
+		query = query.Where("role IN ?", roles)
+	}
+
+	return count, query.Count(&count).Error
+}
+
+// SaveUser validates and creates or updates the given user
+func (up *LocalUserPersister) SaveUser(user *LocalUser) error {
+	if err := user.Validate(); err != nil {
+		return err
+	}
+
+	if user.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+
+		user.ID = &id
+	}
+
+	return up.DB.Save(user).Error
+}
+
+// DeleteUser deletes the user with the given id along with their sessions
+func (up *LocalUserPersister) DeleteUser(id uuid.UUID) error {
+	if err := up.DB.Where("user_id = ?", id).Delete(&LocalSession{}).Error; err != nil {
+		return err
+	}
+
+	return up.DB.Delete(&LocalUser{ID: &id}).Error
+}
+
+// CreateSession creates a session of the user lasting for the given duration
+// and returns its token
+func (up *LocalUserPersister) CreateSession(userID uuid.UUID, duration time.Duration) (string, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return "", err
+	}
+
+	session := &LocalSession{
+		TokenHash: hashSessionToken(token),
+		UserID:    userID,
+		ExpiresAt: time.Now().Add(duration),
+	}
+
+	return token, up.DB.Create(session).Error
+}
+
+// GetSessionUser returns the user of the session with the given token, the
+// session should not have expired
+func (up *LocalUserPersister) GetSessionUser(token string) (*LocalUser, error) {
+	session := &LocalSession{}
+	if err := up.DB.Where("token_hash = ?", hashSessionToken(token)).First(session).Error; err != nil {
+		return nil, ErrLocalSession(err)
+	}
+	if time.Now().After(session.ExpiresAt) {
+		_ = up.DeleteSession(token)
+		return nil, ErrLocalSession(fmt.Errorf("the session has expired"))
+	}
+
+	user, err := up.GetUser(session.UserID)
+	if err != nil {
+		return nil, ErrLocalSession(err)
+	}
+
+	return user, nil
+}
+
+// DeleteSession deletes the session with the given token
+func (up *LocalUserPersister) DeleteSession(token string) error {
+	return up.DB.Where("token_hash = ?", hashSessionToken(token)).Delete(&LocalSession{}).Error
+}
+
+// DeleteExpiredSessions deletes the sessions which have expired
+func (up *LocalUserPersister) DeleteExpiredSessions() error {
+	return up.DB.Where("expires_at < ?", time.Now()).Delete(&LocalSession{}).Error
+}
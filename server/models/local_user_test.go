@@ -0,0 +1,157 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+)
+
+func newTestLocalProvider(t *testing.T) *DefaultLocalProvider {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&LocalUser{}, &LocalSession{}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &DefaultLocalProvider{MultiUser: true, LocalUserPersister: &LocalUserPersister{DB: &db}}
+}
+
+func TestUserRoleAllows(t *testing.T) {
+	for _, tc := range []struct {
+		role, required UserRole
+		allowed        bool
+	}{
+		{RoleAdmin, RoleOperator, true},
+		{RoleOperator, RoleOperator, true},
+		{RoleViewer, RoleOperator, false},
+		{RoleOperator, RoleAdmin, false},
+		{UserRole("root"), RoleViewer, false},
+	} {
+		if allowed := tc.role.Allows(tc.required); allowed != tc.allowed {
+			t.Errorf("%s.Allows(%s) = %t, want %t", tc.role, tc.required, allowed, tc.allowed)
+		}
+	}
+}
+
+func TestLocalUserRequestApply(t *testing.T) {
+	user := &LocalUser{}
+	if err := (&LocalUserRequest{UserName: "alice", Role: RoleOperator, Password: "short"}).Apply(user); err == nil {
+		t.Error("short password is accepted")
+	}
+	if err := (&LocalUserRequest{UserName: "alice", Role: "root", Password: "correct horse"}).Apply(user); err == nil {
+		t.Error("unknown role is accepted")
+	}
+	if err := (&LocalUserRequest{UserName: "alice", Role: RoleOperator, Password: "correct horse"}).Apply(user); err != nil {
+		t.Fatal(err)
+	}
+	if !user.CheckPassword("correct horse") || user.CheckPassword("wrong horse") {
+		t.Error("password check does not match the password")
+	}
+
+	if err := (&LocalUserRequest{Email: "alice@example.com"}).Apply(user); err != nil || user.Role != RoleOperator || !user.CheckPassword("correct horse") {
+		t.Errorf("partial update changed the role %s or the password: %v", user.Role, err)
+	}
+}
+
+func TestLocalProviderSessions(t *testing.T) {
+	l := newTestLocalProvider(t)
+	if err := l.InitializeUsers("", ""); err == nil {
+		t.Error("multi-user mode without admin nor OIDC is accepted")
+	}
+	if err := l.InitializeUsers("admin", "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	if _, _, err := l.LoginWithPassword(rec, "admin", "wrong horse"); err == nil {
+		t.Error("login with a wrong password succeeded")
+	}
+	user, token, err := l.LoginWithPassword(rec, "admin", "correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.Role != string(RoleAdmin) || len(rec.Result().Cookies()) != 1 {
+		t.Errorf("user %+v with cookies %v", user, rec.Result().Cookies())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/user", nil)
+	if err := l.GetSession(req); err == nil {
+		t.Error("request without a session is accepted")
+	}
+	req.AddCookie(rec.Result().Cookies()[0])
+	if err := l.GetSession(req); err != nil {
+		t.Errorf("request with the session cookie is rejected: %v", err)
+	}
+	bearer := httptest.NewRequest(http.MethodGet, "/api/user", nil)
+	bearer.Header.Set("Authorization", "Bearer "+token)
+	if u, err := l.GetUserDetails(bearer); err != nil || u.UserID != "admin" {
+		t.Errorf("user %+v of the bearer token: %v", u, err)
+	}
+
+	l.Logout(httptest.NewRecorder(), req)
+	if err := l.GetSession(req); err == nil {
+		t.Error("session is valid after logout")
+	}
+
+	admin, err := l.LocalUserPersister.GetUserByName("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, _ := l.LocalUserPersister.CreateSession(*admin.ID, -time.Minute)
+	if _, err := l.LocalUserPersister.GetSessionUser(expired); err == nil {
+		t.Error("expired session is accepted")
+	}
+}
+
+func TestLocalProviderScheduledRunUser(t *testing.T) {
+	l := newTestLocalProvider(t)
+	if err := l.InitializeUsers("admin", "correct horse"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		owner string
+		valid bool
+	}{
+		{"admin", true},
+		{"", false},
+		{"deleted", false},
+	} {
+		ctx := context.WithValue(context.Background(), ScheduledRunUserCtxKey, tc.owner)
+		req := httptest.NewRequest(http.MethodGet, "/api/user/performance/profiles/1234/run", nil).WithContext(ctx)
+		user, err := l.GetUserDetails(req)
+		if (err == nil) != tc.valid || (tc.valid && user.UserID != tc.owner) {
+			t.Errorf("scheduled run of %q is run as %+v: %v", tc.owner, user, err)
+		}
+	}
+}
+
+func TestSafeReturnURL(t *testing.T) {
+	for in, want := range map[string]string{
+		"/performance?id=1":    "/performance?id=1",
+		"":                     "/",
+		"https://evil.example": "/",
+		"//evil.example":       "/",
+		"/\\evil.example":      "/",
+	} {
+		if got := SafeReturnURL(in); got != want {
+			t.Errorf("SafeReturnURL(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
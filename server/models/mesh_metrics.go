@@ -0,0 +1,186 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	promModel "github.com/prometheus/common/model"
+)
+
+// MeshMetricsKey is the key of the runner results under which the metrics of the
+// service mesh during the test are stored
+const MeshMetricsKey = "mesh-metrics"
+
+// MeshMetricQuery is a Prometheus query of a metric of the service mesh
+type MeshMetricQuery struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+	Unit  string `json:"unit,omitempty"`
+}
+
+// MeshMetricQueries are the queries run for each service mesh, keyed by the lower case
+// name of the mesh
+type MeshMetricQueries map[string][]MeshMetricQuery
+
+// proxyMetricQueries returns the queries of the resources and count of the sidecar
+// proxies running in the container with the given name
+func proxyMetricQueries(container string) []MeshMetricQuery {
+	return []MeshMetricQuery{
+		{Name: "proxy_cpu", Query: fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{container=%q}[1m]))`, container), Unit: "cores"},
+		{Name: "proxy_memory", Query: fmt.Sprintf(`sum(container_memory_working_set_bytes{container=%q})`, container), Unit: "bytes"},
+		{Name: "sidecars", Query: fmt.Sprintf(`count(container_memory_working_set_bytes{container=%q})`, container)},
+	}
+}
+
+// DefaultMeshMetricQueries are the queries run for the service meshes unless configured otherwise
+var DefaultMeshMetricQueries = MeshMetricQueries{
+	"istio": append(proxyMetricQueries("istio-proxy"),
+		MeshMetricQuery{Name: "control_plane_cpu", Query: `sum(rate(container_cpu_usage_seconds_total{container="discovery"}[1m]))`, Unit: "cores"},
+		MeshMetricQuery{Name: "control_plane_push_latency_p99", Query: `histogram_quantile(0.99, sum(rate(pilot_proxy_convergence_time_bucket[1m])) by (le))`, Unit: "seconds"},
+	),
+	"linkerd": append(proxyMetricQueries("linkerd-proxy"),
+		MeshMetricQuery{Name: "control_plane_cpu", Query: `sum(rate(container_cpu_usage_seconds_total{namespace="linkerd", container!="linkerd-proxy"}[1m]))`, Unit: "cores"},
+	),
+	"consul": proxyMetricQueries("envoy-sidecar"),
+	"kuma":   proxyMetricQueries("kuma-sidecar"),
+}
+
+// LoadMeshMetricQueries returns the default queries overridden by the ones of the meshes
+// in the given YAML or JSON file, if any
+func LoadMeshMetricQueries(path string) (MeshMetricQueries, error) {
+	queries := MeshMetricQueries{}
+	for mesh, q := range DefaultMeshMetricQueries {
+		queries[mesh] = q
+	}
+	if path == "" {
+		return queries, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return queries, ErrReadMeshMetricQueries(err, path)
+	}
+	configured := MeshMetricQueries{}
+	if err := yaml.Unmarshal(b, &configured); err != nil {
+		return queries, ErrReadMeshMetricQueries(err, path)
+	}
+	for mesh, q := range configured {
+		for _, query := range q {
+			if query.Name == "" || query.Query == "" {
+				return queries, ErrReadMeshMetricQueries(fmt.Errorf("the queries of %s should all have a name and a query", mesh), path)
+			}
+		}
+		queries[strings.ToLower(mesh)] = q
+	}
+
+	return queries, nil
+}
+
+// ForMesh returns the queries of the service mesh
+func (q MeshMetricQueries) ForMesh(mesh string) []MeshMetricQuery {
+	return q[strings.ToLower(mesh)]
+}
+
+// MeshMetrics are the metrics of the service mesh during a test, aligned on the steps of the test window
+type MeshMetrics struct {
+	Start  time.Time          `json:"start"`
+	End    time.Time          `json:"end"`
+	Step   string             `json:"step"`
+	Series []MeshMetricSeries `json:"series"`
+	// Errors are the queries which failed, by the name of their metric
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// MeshMetricSeries is a time series of a metric, the samples are pairs of a unix timestamp in
+// seconds and a value
+type MeshMetricSeries struct {
+	Name    string             `json:"name"`
+	Unit    string             `json:"unit,omitempty"`
+	Query   string             `json:"query"`
+	Labels  map[string]string  `json:"labels,omitempty"`
+	Samples [][2]float64       `json:"samples"`
+	Summary MeshMetricsSummary `json:"summary"`
+}
+
+// MeshMetricsSummary summarizes the samples of a series
+type MeshMetricsSummary struct {
+	Min float64 `json:"min"`
+	Avg float64 `json:"avg"`
+	Max float64 `json:"max"`
+}
+
+// QueryMeshMetrics runs the queries over the window, a query which fails is recorded among
+// the errors of the metrics so that the other ones are kept
+func (p *PrometheusClient) QueryMeshMetrics(ctx context.Context, promURL string, queries []MeshMetricQuery, start, end time.Time) *MeshMetrics {
+	step := p.ComputeStep(ctx, start, end)
+	metrics := &MeshMetrics{
+		Start:  start,
+		End:    end,
+		Step:   step.String(),
+		Series: []MeshMetricSeries{},
+	}
+
+	for _, q := range queries {
+		value, err := p.QueryRangeUsingClient(ctx, promURL, q.Query, start, end, step)
+		if err != nil {
+			if metrics.Errors == nil {
+				metrics.Errors = map[string]string{}
+			}
+			metrics.Errors[q.Name] = err.Error()
+			continue
+		}
+
+		matrix, _ := value.(promModel.Matrix)
+		for _, stream := range matrix {
+			series := MeshMetricSeries{
+				Name:    q.Name,
+				Unit:    q.Unit,
+				Query:   q.Query,
+				Samples: make([][2]float64, 0, len(stream.Values)),
+			}
+			for name, value := range stream.Metric {
+				if series.Labels == nil {
+					series.Labels = map[string]string{}
+				}
+				series.Labels[string(name)] = string(value)
+			}
+			for _, v := range stream.Values {
+				series.Samples = append(series.Samples, [2]float64{float64(v.Timestamp.Unix()), float64(v.Value)})
+			}
+			series.Summary = summarizeSamples(series.Samples)
+			metrics.Series = append(metrics.Series, series)
+		}
+	}
+	sort.SliceStable(metrics.Series, func(i, j int) bool {
+		return metrics.Series[i].Name < metrics.Series[j].Name
+	})
+
+	return metrics
+}
+
+func summarizeSamples(samples [][2]float64) MeshMetricsSummary {
+	s := MeshMetricsSummary{}
+	if len(samples) == 0 {
+		return s
+	}
+
+	s.Min, s.Max = samples[0][1], samples[0][1]
+	var sum float64
+	for _, sample := range samples {
+		if sample[1] < s.Min {
+			s.Min = sample[1]
+		}
+		if sample[1] > s.Max {
+			s.Max = sample[1]
+		}
+		sum += sample[1]
+	}
+	s.Avg = sum / float64(len(samples))
+
+	return s
+}
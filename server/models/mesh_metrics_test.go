@@ -0,0 +1,89 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadMeshMetricQueries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mesh-metrics.yaml")
+	config := `Istio:
+- name: proxy_cpu
+  query: sum(rate(container_cpu_usage_seconds_total{container="istio-proxy", namespace="demo"}[1m]))
+  unit: cores
+osm:
+- name: sidecars
+  query: count(container_memory_working_set_bytes{container="envoy"})
+`
+	if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	queries, err := LoadMeshMetricQueries(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := queries.ForMesh("ISTIO"); len(q) != 1 || !strings.Contains(q[0].Query, `namespace="demo"`) {
+		t.Errorf("istio queries %+v, want the configured ones", q)
+	}
+	if q := queries.ForMesh("osm"); len(q) != 1 || q[0].Name != "sidecars" {
+		t.Errorf("osm queries %+v", q)
+	}
+	if q := queries.ForMesh("linkerd"); len(q) != len(DefaultMeshMetricQueries["linkerd"]) {
+		t.Errorf("linkerd queries %+v, want the default ones", q)
+	}
+
+	if err := os.WriteFile(path, []byte("kuma:\n- name: proxy_cpu\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadMeshMetricQueries(path); err == nil {
+		t.Error("loaded a query without a query")
+	}
+}
+
+func TestQueryMeshMetrics(t *testing.T) {
+	start := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/query_range" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = r.ParseForm()
+		if strings.HasPrefix(r.Form.Get("query"), "broken") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"matrix","result":[{"metric":{"pod":"productpage"},"values":[[%d,"1"],[%d,"2"],[%d,"6"]]}]}}`,
+			start.Unix(), start.Unix()+5, start.Unix()+10)
+	}))
+	defer srv.Close()
+
+	queries := []MeshMetricQuery{
+		{Name: "sidecars", Query: "count(up)"},
+		{Name: "control_plane_push_latency_p99", Query: "broken("},
+	}
+	metrics := NewPrometheusClient().QueryMeshMetrics(context.Background(), srv.URL, queries, start, start.Add(10*time.Second))
+
+	if metrics.Step != "5s" || len(metrics.Series) != 1 {
+		t.Fatalf("metrics %+v", metrics)
+	}
+	s := metrics.Series[0]
+	if s.Name != "sidecars" || s.Labels["pod"] != "productpage" || len(s.Samples) != 3 || s.Samples[1] != [2]float64{float64(start.Unix() + 5), 2} {
+		t.Errorf("series %+v", s)
+	}
+	if s.Summary != (MeshMetricsSummary{Min: 1, Avg: 3, Max: 6}) {
+		t.Errorf("summary %+v", s.Summary)
+	}
+	if _, ok := metrics.Errors["control_plane_push_latency_p99"]; !ok || len(metrics.Errors) != 1 {
+		t.Errorf("errors %v, want the failed query only", metrics.Errors)
+	}
+}
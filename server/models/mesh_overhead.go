@@ -0,0 +1,272 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	SMP "github.com/layer5io/service-mesh-performance/spec"
+)
+
+const (
+	// MeshOverheadNamespacePlaceholder is replaced in the endpoints of the profile by the
+	// namespace of the variant the load is sent to
+	MeshOverheadNamespacePlaceholder = "{namespace}"
+
+	// ServiceMeshKey is the key of the runner results under which the SMP service mesh
+	// metadata of the test is stored
+	ServiceMeshKey = "service-mesh"
+
+	// MeshOverheadVariantLabel is the label of the SMP service mesh metadata naming the variant
+	MeshOverheadVariantLabel = "meshery.io/overhead-variant"
+	// MeshOverheadNamespaceAnnotation is the annotation of the SMP service mesh metadata
+	// naming the namespace the variant was deployed in
+	MeshOverheadNamespaceAnnotation = "meshery.io/namespace"
+)
+
+// MeshOverheadVariant is one of the deployments of the workload of a mesh overhead benchmark
+type MeshOverheadVariant string
+
+const (
+	MeshOverheadWithoutSidecar MeshOverheadVariant = "without-sidecar"
+	MeshOverheadWithSidecar    MeshOverheadVariant = "with-sidecar"
+)
+
+// MeshOverheadVariants are the variants in the order they are load tested
+var MeshOverheadVariants = []MeshOverheadVariant{MeshOverheadWithoutSidecar, MeshOverheadWithSidecar}
+
+// sidecarInjection is the namespace label or annotation toggling the sidecar injection of a mesh
+type sidecarInjection struct {
+	key        string
+	annotation bool
+}
+
+var sidecarInjections = map[string]sidecarInjection{
+	"istio":   {key: "istio-injection"},
+	"linkerd": {key: "linkerd.io/inject", annotation: true},
+	"kuma":    {key: "kuma.io/sidecar-injection"},
+}
+
+// MeshOverheadBenchmark is the request of a benchmark of the overhead of a service mesh, the
+// workload pattern is deployed with and without sidecars and the load of the profile is run
+// against both
+type MeshOverheadBenchmark struct {
+	// PatternID is the id of the saved pattern of the workload, PatternFile is used if not set
+	PatternID   string `json:"pattern_id,omitempty"`
+	PatternFile string `json:"pattern_file,omitempty"`
+	// Mesh is the service mesh whose sidecars are injected, it defaults to the one of the profile
+	Mesh string `json:"mesh,omitempty"`
+	// ReadyTimeout bounds the wait for the endpoints of both variants to respond, 5m by default
+	ReadyTimeout string `json:"ready_timeout,omitempty"`
+}
+
+// Validate checks that a workload is given and the sidecars of the mesh can be toggled
+func (b *MeshOverheadBenchmark) Validate() error {
+	if b.PatternID == "" && b.PatternFile == "" {
+		return fmt.Errorf("a pattern id or a pattern file is required")
+	}
+	if _, ok := sidecarInjections[strings.ToLower(b.Mesh)]; !ok {
+		meshes := make([]string, 0, len(sidecarInjections))
+		for mesh := range sidecarInjections {
+			meshes = append(meshes, mesh)
+		}
+		sort.Strings(meshes)
+		return fmt.Errorf("sidecar injection of %q is not supported, supported meshes are %s", b.Mesh, strings.Join(meshes, ", "))
+	}
+	if b.ReadyTimeout != "" {
+		if _, err := time.ParseDuration(b.ReadyTimeout); err != nil {
+			return fmt.Errorf("invalid ready timeout %q: %s", b.ReadyTimeout, err)
+		}
+	}
+
+	return nil
+}
+
+// GetReadyTimeout returns the time the endpoints of the variants are given to respond
+func (b *MeshOverheadBenchmark) GetReadyTimeout() time.Duration {
+	d, err := time.ParseDuration(b.ReadyTimeout)
+	if err != nil || d <= 0 {
+		return 5 * time.Minute
+	}
+
+	return d
+}
+
+// SidecarInjectionMetadata returns the labels and annotations of a namespace enabling or
+// disabling the injection of the sidecars of the mesh in its pods
+func SidecarInjectionMetadata(mesh string, variant MeshOverheadVariant) (labels, annotations map[string]string) {
+	labels, annotations = map[string]string{}, map[string]string{}
+	injection, ok := sidecarInjections[strings.ToLower(mesh)]
+	if !ok {
+		return
+	}
+
+	value := "disabled"
+	if variant == MeshOverheadWithSidecar {
+		value = "enabled"
+	}
+	if injection.annotation {
+		annotations[injection.key] = value
+	} else {
+		labels[injection.key] = value
+	}
+
+	return
+}
+
+// MeshOverheadNamespace returns the namespace the variant of the benchmark is deployed in
+func MeshOverheadNamespace(benchmarkID uuid.UUID, variant MeshOverheadVariant) string {
+	return fmt.Sprintf("meshery-overhead-%s-%s", strings.Split(benchmarkID.String(), "-")[0], variant)
+}
+
+// MeshOverheadEndpoint returns the endpoint of the variant deployed in the namespace
+func MeshOverheadEndpoint(endpoint, namespace string) string {
+	return strings.ReplaceAll(endpoint, MeshOverheadNamespacePlaceholder, namespace)
+}
+
+// MeshOverheadProfile returns a copy of the profile whose endpoints reach the workload deployed
+// in the namespace, at least one of the endpoints should have the namespace placeholder
+func MeshOverheadProfile(profile *PerformanceProfile, namespace string) (*PerformanceProfile, error) {
+	p := *profile
+	placeholders := 0
+
+	p.Endpoints = make([]string, 0, len(profile.Endpoints))
+	for _, e := range profile.Endpoints {
+		if strings.Contains(e, MeshOverheadNamespacePlaceholder) {
+			placeholders++
+		}
+		p.Endpoints = append(p.Endpoints, MeshOverheadEndpoint(e, namespace))
+	}
+	p.Scenario = make(LoadTestEndpoints, 0, len(profile.Scenario))
+	for _, e := range profile.Scenario {
+		if strings.Contains(e.URL, MeshOverheadNamespacePlaceholder) {
+			placeholders++
+		}
+		e.URL = MeshOverheadEndpoint(e.URL, namespace)
+		p.Scenario = append(p.Scenario, e)
+	}
+
+	if placeholders == 0 {
+		return nil, fmt.Errorf("none of the endpoints of the profile has the %s placeholder of the namespace of the workload", MeshOverheadNamespacePlaceholder)
+	}
+
+	return &p, nil
+}
+
+// MeshOverheadServiceMesh returns the SMP service mesh metadata of the results of the variant
+func MeshOverheadServiceMesh(mesh string, variant MeshOverheadVariant, namespace string) *SMP.ServiceMesh {
+	labels, annotations := SidecarInjectionMetadata(mesh, variant)
+	labels[MeshOverheadVariantLabel] = string(variant)
+	annotations[MeshOverheadNamespaceAnnotation] = namespace
+
+	return &SMP.ServiceMesh{
+		Type:        SMP.ServiceMesh_Type(SMP.ServiceMesh_Type_value[strings.ToUpper(mesh)]),
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
+
+// NamespaceResourceQueries returns the queries of the CPU and memory used by the containers of the namespace
+func NamespaceResourceQueries(namespace string) []MeshMetricQuery {
+	return []MeshMetricQuery{
+		{Name: "cpu", Query: fmt.Sprintf(`sum(rate(container_cpu_usage_seconds_total{namespace=%q, container!=""}[1m]))`, namespace), Unit: "cores"},
+		{Name: "memory", Query: fmt.Sprintf(`sum(container_memory_working_set_bytes{namespace=%q, container!=""})`, namespace), Unit: "bytes"},
+	}
+}
+
+// MeshOverheadVariantResult is the outcome of the load test of a variant
+type MeshOverheadVariantResult struct {
+	Variant   MeshOverheadVariant `json:"variant"`
+	Namespace string              `json:"namespace"`
+	ResultID  *uuid.UUID          `json:"result_id,omitempty"`
+	Start     time.Time           `json:"start"`
+	End       time.Time           `json:"end"`
+	// CPU and Memory summarize the resources used by the namespace during the test, they
+	// are only collected when Prometheus is connected
+	CPU    *MeshMetricsSummary `json:"cpu_cores,omitempty"`
+	Memory *MeshMetricsSummary `json:"memory_bytes,omitempty"`
+
+	result *MesheryResult
+}
+
+// SetResult records the result of the load test of the variant
+func (v *MeshOverheadVariantResult) SetResult(result *MesheryResult) {
+	v.result = result
+	if result != nil && result.ID != uuid.Nil {
+		id := result.ID
+		v.ResultID = &id
+	}
+}
+
+// SetResources records the resources used by the namespace from the metrics of the
+// queries returned by NamespaceResourceQueries
+func (v *MeshOverheadVariantResult) SetResources(metrics *MeshMetrics) {
+	for _, s := range metrics.Series {
+		summary := s.Summary
+		switch s.Name {
+		case "cpu":
+			v.CPU = &summary
+		case "memory":
+			v.Memory = &summary
+		}
+	}
+}
+
+// MeshOverheadReport compares the variant with sidecars against the one without them
+type MeshOverheadReport struct {
+	ID          uuid.UUID                    `json:"id"`
+	ProfileID   *uuid.UUID                   `json:"profile_id,omitempty"`
+	Mesh        string                       `json:"mesh"`
+	PatternName string                       `json:"pattern_name,omitempty"`
+	Variants    []*MeshOverheadVariantResult `json:"variants"`
+
+	// Latency compares the latency and throughput of the variant with sidecars, the
+	// candidate, against the one without them, the base
+	Latency *PerformanceComparison `json:"latency,omitempty"`
+	// CPUOverhead and MemoryOverhead compare the average resources used by the namespaces
+	CPUOverhead    *MetricDelta `json:"cpu_overhead_cores,omitempty"`
+	MemoryOverhead *MetricDelta `json:"memory_overhead_bytes,omitempty"`
+
+	// Errors lists the steps of the benchmark which failed, such as the teardown of a namespace
+	Errors []string `json:"errors,omitempty"`
+}
+
+// Variant returns the result of the variant, nil if it is not part of the report
+func (r *MeshOverheadReport) Variant(variant MeshOverheadVariant) *MeshOverheadVariantResult {
+	for _, v := range r.Variants {
+		if v.Variant == variant {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// Compare computes the overhead of the sidecars once both variants have been load tested
+func (r *MeshOverheadReport) Compare() error {
+	without, with := r.Variant(MeshOverheadWithoutSidecar), r.Variant(MeshOverheadWithSidecar)
+	if without == nil || without.result == nil || with == nil || with.result == nil {
+		return fmt.Errorf("the results of both variants are required")
+	}
+
+	comparison, err := ComparePerformanceResults(without.result.Result, with.result.Result, DefaultRegressionThreshold)
+	if err != nil {
+		return err
+	}
+	comparison.BaseResultID, comparison.CandidateResultID = without.ResultID, with.ResultID
+	comparison.ProfileID = r.ProfileID
+	r.Latency = comparison
+
+	if without.CPU != nil && with.CPU != nil {
+		delta := newMetricDelta(without.CPU.Avg, with.CPU.Avg)
+		r.CPUOverhead = &delta
+	}
+	if without.Memory != nil && with.Memory != nil {
+		delta := newMetricDelta(without.Memory.Avg, with.Memory.Avg)
+		r.MemoryOverhead = &delta
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	SMP "github.com/layer5io/service-mesh-performance/spec"
+)
+
+func TestMeshOverheadBenchmarkValidate(t *testing.T) {
+	for _, tc := range []struct {
+		benchmark MeshOverheadBenchmark
+		valid     bool
+	}{
+		{MeshOverheadBenchmark{PatternID: "bookinfo", Mesh: "ISTIO"}, true},
+		{MeshOverheadBenchmark{PatternFile: "name: bookinfo", Mesh: "linkerd", ReadyTimeout: "2m"}, true},
+		{MeshOverheadBenchmark{Mesh: "istio"}, false},
+		{MeshOverheadBenchmark{PatternID: "bookinfo", Mesh: "consul"}, false},
+		{MeshOverheadBenchmark{PatternID: "bookinfo", Mesh: "istio", ReadyTimeout: "soon"}, false},
+	} {
+		if err := tc.benchmark.Validate(); (err == nil) != tc.valid {
+			t.Errorf("Validate(%+v) = %v, want valid %t", tc.benchmark, err, tc.valid)
+		}
+	}
+}
+
+func TestMeshOverheadProfile(t *testing.T) {
+	profile := &PerformanceProfile{
+		Name:      "bookinfo",
+		Endpoints: []string{"http://productpage.{namespace}:9080/productpage"},
+		Scenario:  LoadTestEndpoints{{URL: "http://reviews.{namespace}:9080", Weight: 2}},
+	}
+
+	p, err := MeshOverheadProfile(profile, "meshery-overhead-1234-with-sidecar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Endpoints[0] != "http://productpage.meshery-overhead-1234-with-sidecar:9080/productpage" || p.Scenario[0].URL != "http://reviews.meshery-overhead-1234-with-sidecar:9080" {
+		t.Errorf("endpoints %v and scenario %+v", p.Endpoints, p.Scenario)
+	}
+	if !strings.Contains(profile.Endpoints[0], MeshOverheadNamespacePlaceholder) || !strings.Contains(profile.Scenario[0].URL, MeshOverheadNamespacePlaceholder) {
+		t.Error("the endpoints of the profile were modified")
+	}
+
+	if _, err := MeshOverheadProfile(&PerformanceProfile{Endpoints: []string{"http://productpage:9080"}}, "ns"); err == nil {
+		t.Error("profile without the namespace placeholder is accepted")
+	}
+}
+
+func TestMeshOverheadServiceMesh(t *testing.T) {
+	sm := MeshOverheadServiceMesh("Istio", MeshOverheadWithoutSidecar, "ns")
+	if sm.Type != SMP.ServiceMesh_ISTIO || sm.Labels[MeshOverheadVariantLabel] != string(MeshOverheadWithoutSidecar) || sm.Labels["istio-injection"] != "disabled" || sm.Annotations[MeshOverheadNamespaceAnnotation] != "ns" {
+		t.Errorf("service mesh %+v", sm)
+	}
+
+	labels, annotations := SidecarInjectionMetadata("linkerd", MeshOverheadWithSidecar)
+	if len(labels) != 0 || annotations["linkerd.io/inject"] != "enabled" {
+		t.Errorf("linkerd labels %v and annotations %v", labels, annotations)
+	}
+}
+
+func TestMeshOverheadReportCompare(t *testing.T) {
+	id, _ := uuid.NewV4()
+	report := &MeshOverheadReport{ID: id, Mesh: "istio"}
+	for i, r := range []string{testBaseRunnerResults, testSlowerRunnerResults} {
+		v := &MeshOverheadVariantResult{Variant: MeshOverheadVariants[i], Namespace: MeshOverheadNamespace(id, MeshOverheadVariants[i])}
+		result := &MesheryResult{}
+		if err := json.Unmarshal([]byte(r), &result.Result); err != nil {
+			t.Fatal(err)
+		}
+		result.ID, _ = uuid.NewV4()
+		v.SetResult(result)
+		v.SetResources(&MeshMetrics{Series: []MeshMetricSeries{
+			{Name: "cpu", Summary: MeshMetricsSummary{Avg: 0.5 * float64(i+1)}},
+			{Name: "memory", Summary: MeshMetricsSummary{Avg: 100 * float64(i+1)}},
+		}})
+		report.Variants = append(report.Variants, v)
+	}
+
+	if err := report.Compare(); err != nil {
+		t.Fatal(err)
+	}
+	if report.Latency == nil || report.Latency.MeanLatency.Delta <= 0 || *report.Latency.BaseResultID != *report.Variants[0].ResultID {
+		t.Errorf("latency %+v, want the variant with sidecars compared against the one without", report.Latency)
+	}
+	if report.CPUOverhead == nil || report.CPUOverhead.Delta != 0.5 || report.MemoryOverhead == nil || report.MemoryOverhead.Delta != 100 {
+		t.Errorf("overhead of %+v cores and %+v bytes", report.CPUOverhead, report.MemoryOverhead)
+	}
+
+	if err := (&MeshOverheadReport{Variants: report.Variants[:1]}).Compare(); err == nil {
+		t.Error("compared a report with a single variant")
+	}
+}
@@ -27,6 +27,10 @@ func GetApplicationTypes() (r []ApplicationTypeResponse) {
 		ApplicationTypeResponse{
 			Type:                K8sManifest,
 			SupportedExtensions: []string{".yaml", ".yml"},
+		},
+		ApplicationTypeResponse{
+			Type:                Kustomize,
+			SupportedExtensions: []string{".tgz", ".tar.gz"},
 		})
 	return
 }
@@ -35,6 +39,7 @@ const (
 	HelmChart     ApplicationType = "Helm Chart"
 	DockerCompose ApplicationType = "Docker Compose"
 	K8sManifest   ApplicationType = "Kubernetes Manifest"
+	Kustomize     ApplicationType = "Kustomize"
 )
 
 // MesheryApplication represents the applications that needs to be saved
@@ -82,12 +82,49 @@ func (mkcp *MesheryK8sContextPersister) SaveMesheryK8sContext(mkc K8sContext) (K
 			return ErrContextAlreadyPersisted
 		}
 
+		// the credentials are encrypted once the id is generated from them
+		if kms := GetK8sContextKMS(); kms != nil {
+			if err := mkc.Seal(kms); err != nil {
+				return err
+			}
+		}
+
 		return tx.Save(&mkc).Error
 	})
 
 	return mkc, err
 }
 
+// SealMesheryK8sContexts encrypts the credentials of the contexts stored in clear and
+// rewraps the data keys of those encrypted with another key than the primary key of the
+// KMS. It returns the number of contexts updated
+func (mkcp *MesheryK8sContextPersister) SealMesheryK8sContexts(kms KMS) (int, error) {
+	contexts := []*K8sContext{}
+	if err := mkcp.DB.Find(&contexts).Error; err != nil {
+		return 0, err
+	}
+
+	sealed := 0
+	for _, kc := range contexts {
+		if kc.SealedWith(kms) {
+			continue
+		}
+		if err := kc.Seal(kms); err != nil {
+			return sealed, err
+		}
+		err := mkcp.DB.Model(&K8sContext{}).Where("id = ?", kc.ID).UpdateColumns(map[string]interface{}{
+			"auth":    kc.Auth,
+			"cluster": kc.Cluster,
+		}).Error
+		if err != nil {
+			return sealed, err
+		}
+		sealed++
+	}
+
+	return sealed, nil
+}
+
 func (mkcp *MesheryK8sContextPersister) GetMesheryK8sContext(id string) (K8sContext, error) {
 	var mesheryK8sContext K8sContext
 
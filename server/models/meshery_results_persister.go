@@ -80,9 +80,11 @@ func (mrp *MesheryResultsPersister) GetAllResults(page, pageSize uint64) ([]byte
 func (mrp *MesheryResultsPersister) GetResult(key uuid.UUID) (*MesheryResult, error) {
 	var lres localMesheryResultDBRepresentation
 
-	err := mrp.DB.Table("meshery_results").Find(&lres).Where("id = ?", key).Error
-	res := convertLocalRepresentationToMesheryResult(&lres)
-	return res, err
+	err := mrp.DB.Table("meshery_results").Where("id = ?", key).First(&lres).Error
+	if err != nil {
+		return nil, err
+	}
+	return convertLocalRepresentationToMesheryResult(&lres), nil
 }
 
 func (mrp *MesheryResultsPersister) WriteResult(key uuid.UUID, result []byte) error {
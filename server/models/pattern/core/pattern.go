@@ -27,8 +27,11 @@ type Pattern struct {
 	Vars map[string]interface{} `yaml:"vars,omitempty" json:"vars,omitempty"`
 	// PatternID is the moniker use to uniquely identify any given pattern
 	// Convention: SMP-###-v#.#.#
-	PatternID string              `yaml:"patternID,omitempty" json:"patternID,omitempty"`
-	Services  map[string]*Service `yaml:"services,omitempty" json:"services,omitempty"`
+	PatternID string `yaml:"patternID,omitempty" json:"patternID,omitempty"`
+	// Inputs are the typed parameters of the pattern whose values are supplied
+	// at deploy time and referenced as $(#ref.inputs.<name>)
+	Inputs   map[string]*PatternInput `yaml:"inputs,omitempty" json:"inputs,omitempty"`
+	Services map[string]*Service      `yaml:"services,omitempty" json:"services,omitempty"`
 }
 
 // Service represents the services defined within the appfile
@@ -192,6 +195,48 @@ func (p *Pattern) ToYAML() ([]byte, error) {
 	return yaml.Marshal(p)
 }
 
+// Isolated returns a copy of the pattern deploying all of its services in the namespace,
+// which is created first with the given labels and annotations. The namespaces of the
+// pattern itself are left out.
+func (p *Pattern) Isolated(namespace string, labels, annotations map[string]string) Pattern {
+	isolated := *p
+	isolated.Services = map[string]*Service{}
+
+	dropped := map[string]bool{}
+	for name, svc := range p.Services {
+		if svc != nil && strings.TrimSuffix(svc.Type, ".K8s") == "Namespace" {
+			dropped[name] = true
+		}
+	}
+
+	for name, svc := range p.Services {
+		if svc == nil || dropped[name] {
+			continue
+		}
+
+		s := *svc
+		s.Namespace = namespace
+		s.DependsOn = []string{namespace}
+		for _, dep := range svc.DependsOn {
+			if !dropped[dep] {
+				s.DependsOn = append(s.DependsOn, dep)
+			}
+		}
+		isolated.Services[name] = &s
+	}
+
+	isolated.Services[namespace] = &Service{
+		Name:        namespace,
+		Type:        "Namespace.K8s",
+		Labels:      labels,
+		Annotations: annotations,
+		Settings:    map[string]interface{}{},
+		Traits:      map[string]interface{}{},
+	}
+
+	return isolated
+}
+
 // NewPatternFileFromCytoscapeJSJSON takes in CytoscapeJS JSON
 // and creates a PatternFile from it
 func NewPatternFileFromCytoscapeJSJSON(name string, byt []byte) (Pattern, error) {
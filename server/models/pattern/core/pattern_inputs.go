@@ -0,0 +1,177 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Types supported by the pattern inputs
+const (
+	InputTypeString  = "string"
+	InputTypeInteger = "integer"
+	InputTypeNumber  = "number"
+	InputTypeBoolean = "boolean"
+)
+
+// PatternInput is a typed input parameter of a pattern
+type PatternInput struct {
+	// Type is one of string, integer, number or boolean, defaults to string
+	Type        string      `yaml:"type,omitempty" json:"type,omitempty"`
+	Description string      `yaml:"description,omitempty" json:"description,omitempty"`
+	Default     interface{} `yaml:"default,omitempty" json:"default,omitempty"`
+	Required    bool        `yaml:"required,omitempty" json:"required,omitempty"`
+	// Enum restricts the input to one of the given values
+	Enum []interface{} `yaml:"enum,omitempty" json:"enum,omitempty"`
+	// Pattern is a regular expression which the input has to match
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty"`
+}
+
+// ResolveInputs validates the given values against the inputs declared by the
+// pattern and returns the value of every input, falling back to the defaults.
+//
+// Values may be given as strings, as is the case for the values passed with
+// the request, they are converted to the declared type of the input.
+//
+// Required inputs are only enforced when values are given, nil values stand
+// for a pattern which is verified, exported or dry run without inputs, in which
+// case the inputs without a default are left out. Deploys always give values.
+func (p *Pattern) ResolveInputs(values map[string]interface{}) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	errs := []string{}
+
+	for name := range values {
+		if _, ok := p.Inputs[name]; !ok {
+			errs = append(errs, fmt.Sprintf("unknown input %q", name))
+		}
+	}
+
+	for name, input := range p.Inputs {
+		if input == nil {
+			input = &PatternInput{}
+		}
+
+		val, ok := values[name]
+		if !ok {
+			val = input.Default
+		}
+		if val == nil {
+			if input.Required && values != nil {
+				errs = append(errs, fmt.Sprintf("missing required input %q", name))
+			}
+			continue
+		}
+
+		cval, err := input.validate(val)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("invalid input %q: %s", name, err))
+			continue
+		}
+
+		res[name] = cval
+	}
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return res, nil
+}
+
+// validate converts the value to the type of the input and checks it against the constraints
+func (pi *PatternInput) validate(val interface{}) (interface{}, error) {
+	cval, err := convertInput(val, pi.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pi.Enum) > 0 {
+		found := false
+		for _, e := range pi.Enum {
+			ce, err := convertInput(e, pi.Type)
+			if err == nil && reflect.DeepEqual(ce, cval) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%v is not one of %v", cval, pi.Enum)
+		}
+	}
+
+	if pi.Pattern != "" {
+		re, err := regexp.Compile(pi.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %s", pi.Pattern, err)
+		}
+		if !re.MatchString(fmt.Sprint(cval)) {
+			return nil, fmt.Errorf("%v does not match %q", cval, pi.Pattern)
+		}
+	}
+
+	return cval, nil
+}
+
+func convertInput(val interface{}, typ string) (interface{}, error) {
+	switch typ {
+	case "", InputTypeString:
+		switch v := val.(type) {
+		case string:
+			return v, nil
+		case bool, int, int64, float64:
+			return fmt.Sprint(v), nil
+		}
+	case InputTypeInteger:
+		switch v := val.(type) {
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case float64:
+			if v == math.Trunc(v) {
+				return int64(v), nil
+			}
+		case string:
+			i, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+			if err == nil {
+				return i, nil
+			}
+		}
+	case InputTypeNumber:
+		switch v := val.(type) {
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		case string:
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err == nil {
+				return f, nil
+			}
+		}
+	case InputTypeBoolean:
+		switch v := val.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			if err == nil {
+				return b, nil
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %q", typ)
+	}
+
+	if typ == "" {
+		typ = InputTypeString
+	}
+	return nil, fmt.Errorf("%v is not of the type %s", val, typ)
+}
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Export renders the objects which Deploy creates for the application as standalone
+// kubernetes objects, that is the rollout and, unless disabled, its service.
+//
+// The objects are rendered without a cluster, hence the rollout engine and the service
+// mesh can't be detected and the native rollout of the default engine, argo, is rendered.
+func Export(oamComp v1alpha1.Component, oamConfig v1alpha1.Configuration) ([]map[string]interface{}, error) {
+	if oamComp.Spec.Type != "Application" {
+		return nil, fmt.Errorf("%s is not an application pattern", oamComp.Spec.Type)
+	}
+
+	settings, err := getApplicationPatternSettings(oamComp)
+	if err != nil {
+		return nil, err
+	}
+	config, err := getApplicationPatternConfiguration(oamComp.Name, oamConfig)
+	if err != nil {
+		return nil, err
+	}
+	if config.RolloutStrategy != nil {
+		return nil, fmt.Errorf("strategy not supported")
+	}
+
+	setupDefaults(&settings)
+	opt := RolloutEngineGenericOptions{
+		Name:        oamComp.Name,
+		Namespace:   oamComp.Namespace,
+		ServiceMesh: string(settings.Mesh),
+		Replicas:    settings.Replicas,
+		Containers:  settings.Containers,
+		Metadata: RolloutEngineGenericOptionsMetadata{
+			Labels:      oamComp.Labels,
+			Annotations: oamComp.Annotations,
+		},
+		Advanced: settings.Advanced,
+	}
+
+	rollout := createNativeArgoResource(opt)
+	objs := []interface{}{&rollout}
+	if *settings.Advanced.CreateService {
+		svc := createNativeService(opt)
+		objs = append(objs, &svc)
+	}
+
+	res := make([]map[string]interface{}, 0, len(objs))
+	for _, o := range objs {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+		if err != nil {
+			return nil, err
+		}
+
+		// The status and the timestamps are maintained by the api server
+		unstructured.RemoveNestedField(obj, "status")
+		unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(obj, "spec", "template", "metadata", "creationTimestamp")
+
+		res = append(res, obj)
+	}
+
+	return res, nil
+}
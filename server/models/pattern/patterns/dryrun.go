@@ -0,0 +1,119 @@
+package patterns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"github.com/layer5io/meshkit/utils/kubernetes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Cluster is a kubernetes cluster on which a pattern is evaluated
+type Cluster struct {
+	Name       string
+	Kubeconfig string
+}
+
+// LiveObject is an object of a cluster as it was observed by MeshSync
+type LiveObject struct {
+	Cluster string
+	Object  map[string]interface{}
+}
+
+// DriftOAM renders the components just like DryRunOAM but diffs them against
+// the objects observed by MeshSync which carry the label of the component
+func DriftOAM(clusters []Cluster, live []LiveObject, comps []v1alpha1.Component) []k8s.ObjectDiff {
+	res := []k8s.ObjectDiff{}
+
+	for _, cluster := range clusters {
+		for _, comp := range comps {
+			if !strings.HasSuffix(strings.ToLower(comp.Spec.Type), ".k8s") {
+				res = append(res, unknownObjectDiff(
+					cluster.Name,
+					comp,
+					fmt.Errorf("drift detection is not supported for components of type %s", comp.Spec.Type),
+				))
+				continue
+			}
+
+			objs := []map[string]interface{}{}
+			for _, l := range live {
+				if l.Cluster == cluster.Name && hasLabel(l.Object, comp.Labels) {
+					objs = append(objs, l.Object)
+				}
+			}
+
+			diff, err := k8s.Drift(comp, objs)
+			diff.Context = cluster.Name
+			if err != nil {
+				diff.Operation = k8s.DiffOperationUnknown
+				diff.Error = err.Error()
+			}
+			res = append(res, diff)
+		}
+	}
+
+	return res
+}
+
+// hasLabel returns true if the object carries all of the given labels
+func hasLabel(obj map[string]interface{}, labels map[string]string) bool {
+	objLabels, _, _ := unstructured.NestedStringMap(obj, "metadata", "labels")
+	for k, v := range labels {
+		if objLabels[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// DryRunOAM renders the components just like ProcessOAM would and diffs them
+// against the live objects of every cluster, nothing is applied to the clusters
+func DryRunOAM(clusters []Cluster, comps []v1alpha1.Component, isDel bool) []k8s.ObjectDiff {
+	res := []k8s.ObjectDiff{}
+
+	for _, cluster := range clusters {
+		kcli, err := kubernetes.New([]byte(cluster.Kubeconfig))
+		if err != nil {
+			for _, comp := range comps {
+				res = append(res, unknownObjectDiff(cluster.Name, comp, err))
+			}
+			continue
+		}
+
+		for _, comp := range comps {
+			if !strings.HasSuffix(strings.ToLower(comp.Spec.Type), ".k8s") {
+				res = append(res, unknownObjectDiff(
+					cluster.Name,
+					comp,
+					fmt.Errorf("dry run is not supported for components of type %s", comp.Spec.Type),
+				))
+				continue
+			}
+
+			diff, err := k8s.DryRun(kcli, comp, isDel)
+			diff.Context = cluster.Name
+			if err != nil {
+				diff.Operation = k8s.DiffOperationUnknown
+				diff.Error = err.Error()
+			}
+			res = append(res, diff)
+		}
+	}
+
+	return res
+}
+
+func unknownObjectDiff(context string, comp v1alpha1.Component, err error) k8s.ObjectDiff {
+	return k8s.ObjectDiff{
+		Context:   context,
+		Kind:      comp.Spec.Type,
+		Name:      comp.Name,
+		Namespace: comp.Namespace,
+		Operation: k8s.DiffOperationUnknown,
+		Error:     err.Error(),
+	}
+}
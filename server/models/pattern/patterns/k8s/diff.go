@@ -0,0 +1,280 @@
+package k8s
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// DiffOperation is the operation that a deploy would perform on an object
+type DiffOperation string
+
+const (
+	DiffOperationCreate    DiffOperation = "create"
+	DiffOperationUpdate    DiffOperation = "update"
+	DiffOperationDelete    DiffOperation = "delete"
+	DiffOperationUnchanged DiffOperation = "unchanged"
+	DiffOperationUnknown   DiffOperation = "unknown"
+)
+
+// FieldOperation describes how a single field of an object changes
+type FieldOperation string
+
+const (
+	FieldAdded   FieldOperation = "added"
+	FieldChanged FieldOperation = "changed"
+	FieldRemoved FieldOperation = "removed"
+)
+
+// FieldDiff is the difference of a single field between the live and the desired object
+type FieldDiff struct {
+	Path      string         `json:"path"`
+	Operation FieldOperation `json:"operation"`
+	Live      interface{}    `json:"live,omitempty"`
+	Desired   interface{}    `json:"desired,omitempty"`
+}
+
+// ObjectDiff is the difference between the live object in a cluster and the
+// object which a deploy of the component would apply
+type ObjectDiff struct {
+	Context    string        `json:"context,omitempty"`
+	APIVersion string        `json:"apiVersion,omitempty"`
+	Kind       string        `json:"kind,omitempty"`
+	Name       string        `json:"name,omitempty"`
+	Namespace  string        `json:"namespace,omitempty"`
+	Operation  DiffOperation `json:"operation"`
+	Fields     []FieldDiff   `json:"fields,omitempty"`
+	Error      string        `json:"error,omitempty"`
+}
+
+// Render returns the kubernetes object that Deploy would apply for the component
+func Render(oamComp v1alpha1.Component) (map[string]interface{}, error) {
+	manifest, err := yaml.Marshal(createK8sResourceStructure(oamComp))
+	if err != nil {
+		return nil, err
+	}
+
+	_, obj, err := meshkube.GetObjectFromManifest(string(manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	return pruneNil(obj.Object).(map[string]interface{}), nil
+}
+
+// DryRun renders the component and diffs it against the live object in the
+// cluster without applying anything. Like Drift, an update only reports the
+// fields which the component sets.
+func DryRun(kubeClient *meshkube.Client, oamComp v1alpha1.Component, isDel bool) (ObjectDiff, error) {
+	desired, err := Render(oamComp)
+	if err != nil {
+		return ObjectDiff{}, err
+	}
+
+	res := ObjectDiff{
+		APIVersion: getAPIVersionFromComponent(oamComp),
+		Kind:       getKindFromComponent(oamComp),
+		Name:       oamComp.Name,
+	}
+
+	namespace, live, err := liveObject(kubeClient, oamComp, desired)
+	if err != nil {
+		return res, err
+	}
+	if namespace != "" {
+		res.Namespace = namespace
+		_ = unstructured.SetNestedField(desired, namespace, "metadata", "namespace")
+	}
+
+	switch {
+	case isDel && live == nil:
+		res.Operation = DiffOperationUnchanged
+	case isDel:
+		res.Operation = DiffOperationDelete
+		res.Fields = DiffObjects(nil, live)
+	case live == nil:
+		res.Operation = DiffOperationCreate
+		res.Fields = DiffObjects(desired, nil)
+	default:
+		res.Fields = desiredFields(DiffObjects(desired, live))
+		res.Operation = DiffOperationUpdate
+		if len(res.Fields) == 0 {
+			res.Operation = DiffOperationUnchanged
+		}
+	}
+
+	return res, nil
+}
+
+// DiffObjects returns the fields which differ between the desired and the live object.
+//
+// The status and the metadata maintained by the api server are not a part of
+// what Deploy applies and hence are left out of the comparison.
+func DiffObjects(desired, live map[string]interface{}) []FieldDiff {
+	desired = stripServerFields(desired)
+	live = stripServerFields(live)
+
+	diffs := []FieldDiff{}
+	diffValues("", desired, live, &diffs)
+	return diffs
+}
+
+// desiredFields leaves out the fields which are only present on the live object,
+// these are mostly defaulted by the api server, e.g. the defaulted fields of the
+// spec, and would otherwise bury the fields that a deploy changes
+func desiredFields(diffs []FieldDiff) []FieldDiff {
+	res := []FieldDiff{}
+	for _, f := range diffs {
+		if f.Operation == FieldRemoved {
+			continue
+		}
+		res = append(res, f)
+	}
+
+	return res
+}
+
+func diffValues(path string, desired, live interface{}, diffs *[]FieldDiff) {
+	dm, dIsMap := desired.(map[string]interface{})
+	lm, lIsMap := live.(map[string]interface{})
+	if dIsMap && lIsMap || dIsMap && live == nil || lIsMap && desired == nil {
+		for _, k := range unionKeys(dm, lm) {
+			dv, dok := dm[k]
+			lv, lok := lm[k]
+			switch {
+			case dok && lok:
+				diffValues(joinPath(path, k), dv, lv, diffs)
+			case dok:
+				diffValues(joinPath(path, k), dv, nil, diffs)
+			default:
+				diffValues(joinPath(path, k), nil, lv, diffs)
+			}
+		}
+		return
+	}
+
+	dl, dIsList := desired.([]interface{})
+	ll, lIsList := live.([]interface{})
+	if dIsList && lIsList {
+		for i := 0; i < len(dl) || i < len(ll); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i < len(dl) && i < len(ll):
+				diffValues(p, dl[i], ll[i], diffs)
+			case i < len(dl):
+				diffValues(p, dl[i], nil, diffs)
+			default:
+				diffValues(p, nil, ll[i], diffs)
+			}
+		}
+		return
+	}
+
+	switch {
+	case live == nil && desired == nil:
+	case live == nil:
+		*diffs = append(*diffs, FieldDiff{Path: path, Operation: FieldAdded, Desired: desired})
+	case desired == nil:
+		*diffs = append(*diffs, FieldDiff{Path: path, Operation: FieldRemoved, Live: live})
+	case !equalValues(desired, live):
+		*diffs = append(*diffs, FieldDiff{Path: path, Operation: FieldChanged, Live: live, Desired: desired})
+	}
+}
+
+// equalValues compares two leaf values, numbers are compared by their
+// value as the live object and the rendered object may use different types
+func equalValues(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+
+	return fmt.Sprint(a) == fmt.Sprint(b) && isNumber(a) && isNumber(b)
+}
+
+func isNumber(v interface{}) bool {
+	switch v.(type) {
+	case int, int32, int64, float32, float64:
+		return true
+	}
+	return false
+}
+
+func stripServerFields(obj map[string]interface{}) map[string]interface{} {
+	if obj == nil {
+		return nil
+	}
+
+	res := map[string]interface{}{}
+	for k, v := range obj {
+		switch k {
+		case "status":
+			continue
+		case "metadata":
+			md, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			kept := map[string]interface{}{}
+			for _, f := range []string{"name", "namespace", "labels", "annotations"} {
+				if fv, ok := md[f]; ok {
+					kept[f] = fv
+				}
+			}
+			res[k] = kept
+		default:
+			res[k] = v
+		}
+	}
+
+	return res
+}
+
+// pruneNil removes the keys with nil values, these are produced for empty
+// labels or annotations and are dropped by the api server anyway
+func pruneNil(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		for k, v2 := range x {
+			if v2 == nil {
+				delete(x, k)
+				continue
+			}
+			x[k] = pruneNil(v2)
+		}
+	case []interface{}:
+		for i, v2 := range x {
+			x[i] = pruneNil(v2)
+		}
+	}
+
+	return v
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, m := range []map[string]interface{}{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,106 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffObjects(t *testing.T) {
+	desired := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "web"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": float64(3),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "nginx:1.23"},
+					},
+				},
+			},
+		},
+	}
+	live := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":            "web",
+			"namespace":       "default",
+			"uid":             "1234",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "web", "tier": "frontend"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"paused":   true,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "nginx:1.22"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(3)},
+	}
+
+	tests := []struct {
+		name    string
+		desired map[string]interface{}
+		live    map[string]interface{}
+		want    []FieldDiff
+	}{
+		{
+			name:    "update",
+			desired: desired,
+			live:    live,
+			want: []FieldDiff{
+				{Path: "metadata.labels.tier", Operation: FieldRemoved, Live: "frontend"},
+				{Path: "spec.paused", Operation: FieldRemoved, Live: true},
+				{Path: "spec.template.spec.containers[0].image", Operation: FieldChanged, Live: "nginx:1.22", Desired: "nginx:1.23"},
+			},
+		},
+		{
+			name:    "unchanged",
+			desired: desired,
+			live:    desired,
+			want:    []FieldDiff{},
+		},
+		{
+			name: "create",
+			desired: map[string]interface{}{
+				"kind":     "ConfigMap",
+				"metadata": map[string]interface{}{"name": "cfg"},
+				"data":     map[string]interface{}{"key": "value"},
+			},
+			live: nil,
+			want: []FieldDiff{
+				{Path: "data.key", Operation: FieldAdded, Desired: "value"},
+				{Path: "kind", Operation: FieldAdded, Desired: "ConfigMap"},
+				{Path: "metadata.name", Operation: FieldAdded, Desired: "cfg"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffObjects(tt.desired, tt.live)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffObjects() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// the fields defaulted by the api server are not reported by an update
+	want := []FieldDiff{
+		{Path: "spec.template.spec.containers[0].image", Operation: FieldChanged, Live: "nginx:1.22", Desired: "nginx:1.23"},
+	}
+	if got := desiredFields(DiffObjects(desired, live)); !reflect.DeepEqual(got, want) {
+		t.Errorf("desiredFields() = %+v, want %+v", got, want)
+	}
+}
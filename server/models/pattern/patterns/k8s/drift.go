@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Drift renders the component and compares it with the live objects which were
+// observed in a cluster, for instance by MeshSync, instead of fetching them from
+// the cluster.
+//
+// Only the fields which the component sets are compared as the fields which are
+// only present on the live object are mostly defaulted by the api server. If none
+// of the live objects is the object of the component, the object was deleted
+// since it was deployed and the operation to bring it back is a create.
+func Drift(oamComp v1alpha1.Component, live []map[string]interface{}) (ObjectDiff, error) {
+	res := ObjectDiff{
+		APIVersion: getAPIVersionFromComponent(oamComp),
+		Kind:       getKindFromComponent(oamComp),
+		Name:       oamComp.Name,
+		Namespace:  oamComp.Namespace,
+	}
+
+	desired, err := Render(oamComp)
+	if err != nil {
+		return res, err
+	}
+
+	var obj map[string]interface{}
+	for _, l := range live {
+		kind, _, _ := unstructured.NestedString(l, "kind")
+		name, _, _ := unstructured.NestedString(l, "metadata", "name")
+		if kind == res.Kind && name == res.Name {
+			obj = l
+			break
+		}
+	}
+
+	if obj == nil {
+		res.Operation = DiffOperationCreate
+		return res, nil
+	}
+
+	// Cluster scoped objects have no namespace
+	res.Namespace, _, _ = unstructured.NestedString(obj, "metadata", "namespace")
+	if res.Namespace != "" {
+		_ = unstructured.SetNestedField(desired, res.Namespace, "metadata", "namespace")
+	}
+
+	res.Fields = desiredFields(DiffObjects(desired, obj))
+
+	res.Operation = DiffOperationUpdate
+	if len(res.Fields) == 0 {
+		res.Operation = DiffOperationUnchanged
+	}
+
+	return res, nil
+}
@@ -0,0 +1,94 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDrift(t *testing.T) {
+	comp := v1alpha1.Component{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "default",
+			Labels:    map[string]string{"resource.pattern.meshery.io/id": "1234"},
+			Annotations: map[string]string{
+				"pattern.meshery.io.k8s.k8sAPIVersion": "apps/v1",
+				"pattern.meshery.io.k8s.k8sKind":       "Deployment",
+			},
+		},
+		Spec: v1alpha1.ComponentSpec{
+			Type: "Deployment.K8s",
+			Settings: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": 3,
+				},
+			},
+		},
+	}
+
+	live := func(replicas int64) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "web",
+				"namespace": "default",
+				"labels":    map[string]interface{}{"resource.pattern.meshery.io/id": "1234"},
+				"annotations": map[string]interface{}{
+					"pattern.meshery.io.k8s.k8sAPIVersion": "apps/v1",
+					"pattern.meshery.io.k8s.k8sKind":       "Deployment",
+					"deployment.kubernetes.io/revision":    "1",
+				},
+			},
+			"spec": map[string]interface{}{
+				"replicas":             replicas,
+				"revisionHistoryLimit": int64(10),
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		live       []map[string]interface{}
+		wantOp     DiffOperation
+		wantFields []FieldDiff
+	}{
+		{
+			name:   "deleted",
+			live:   nil,
+			wantOp: DiffOperationCreate,
+		},
+		{
+			name:       "unchanged",
+			live:       []map[string]interface{}{live(3)},
+			wantOp:     DiffOperationUnchanged,
+			wantFields: []FieldDiff{},
+		},
+		{
+			name:   "modified",
+			live:   []map[string]interface{}{live(1)},
+			wantOp: DiffOperationUpdate,
+			wantFields: []FieldDiff{
+				{Path: "spec.replicas", Operation: FieldChanged, Live: int64(1), Desired: int64(3)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Drift(comp, tt.live)
+			if err != nil {
+				t.Fatalf("Drift() error = %v", err)
+			}
+			if got.Operation != tt.wantOp {
+				t.Errorf("Drift() operation = %v, want %v", got.Operation, tt.wantOp)
+			}
+			if !reflect.DeepEqual(got.Fields, tt.wantFields) {
+				t.Errorf("Drift() fields = %+v, want %+v", got.Fields, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package k8s
+
+import (
+	"strings"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// clusterScopedKinds are the kinds of the core kubernetes resources which are not namespaced.
+// The exported objects are rendered without a cluster hence the scope can't be discovered.
+var clusterScopedKinds = map[string]bool{
+	"APIService":                     true,
+	"CertificateSigningRequest":      true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"CSIDriver":                      true,
+	"CSINode":                        true,
+	"CustomResourceDefinition":       true,
+	"IngressClass":                   true,
+	"MutatingWebhookConfiguration":   true,
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"PriorityClass":                  true,
+	"RuntimeClass":                   true,
+	"StorageClass":                   true,
+	"ValidatingWebhookConfiguration": true,
+	"VolumeAttachment":               true,
+}
+
+// Export renders the kubernetes object of the component as a standalone object which
+// can be applied without Meshery. The labels and annotations that Meshery adds for its
+// own bookkeeping are dropped and the namespace of the component is set on the object.
+func Export(oamComp v1alpha1.Component) (map[string]interface{}, error) {
+	obj, err := Render(oamComp)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, _, _ := unstructured.NestedStringMap(obj, "metadata", "labels")
+	for k := range labels {
+		if strings.HasSuffix(k, "pattern.meshery.io/id") {
+			delete(labels, k)
+		}
+	}
+	setOrRemoveStringMap(obj, labels, "metadata", "labels")
+
+	annotations, _, _ := unstructured.NestedStringMap(obj, "metadata", "annotations")
+	for k := range annotations {
+		if strings.HasPrefix(k, "pattern.meshery.io") {
+			delete(annotations, k)
+		}
+	}
+	setOrRemoveStringMap(obj, annotations, "metadata", "annotations")
+
+	kind, _, _ := unstructured.NestedString(obj, "kind")
+	if oamComp.Namespace != "" && !clusterScopedKinds[kind] {
+		_ = unstructured.SetNestedField(obj, oamComp.Namespace, "metadata", "namespace")
+	}
+
+	return obj, nil
+}
+
+func setOrRemoveStringMap(obj map[string]interface{}, m map[string]string, fields ...string) {
+	if len(m) == 0 {
+		unstructured.RemoveNestedField(obj, fields...)
+		return
+	}
+
+	_ = unstructured.SetNestedStringMap(obj, m, fields...)
+}
@@ -0,0 +1,122 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	meshkube "github.com/layer5io/meshkit/utils/kubernetes"
+	"gopkg.in/yaml.v2"
+	kubeerror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/restmapper"
+)
+
+// liveObject returns the namespace of the object of the component, empty for cluster
+// scoped objects, and the object in the cluster, nil if it doesn't exist
+func liveObject(kubeClient *meshkube.Client, oamComp v1alpha1.Component, desired map[string]interface{}) (string, map[string]interface{}, error) {
+	gv, err := schema.ParseGroupVersion(getAPIVersionFromComponent(oamComp))
+	if err != nil {
+		return "", nil, err
+	}
+
+	groupResources, err := restmapper.GetAPIGroupResources(kubeClient.KubeClient.Discovery())
+	if err != nil {
+		return "", nil, err
+	}
+	mapping, err := restmapper.NewDiscoveryRESTMapper(groupResources).RESTMapping(
+		schema.GroupKind{Group: gv.Group, Kind: getKindFromComponent(oamComp)},
+		gv.Version,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+
+	ri := kubeClient.DynamicKubeClient.Resource(mapping.Resource)
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		obj, err := ri.Get(context.TODO(), oamComp.Name, metav1.GetOptions{})
+		if kubeerror.IsNotFound(err) {
+			return "", nil, nil
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		return "", obj.Object, nil
+	}
+
+	// Deploy prefers the namespace of the component over the one in the settings
+	namespace := oamComp.Namespace
+	if namespace == "" {
+		namespace, _, _ = unstructured.NestedString(desired, "metadata", "namespace")
+	}
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
+	obj, err := ri.Namespace(namespace).Get(context.TODO(), oamComp.Name, metav1.GetOptions{})
+	if kubeerror.IsNotFound(err) {
+		return namespace, nil, nil
+	}
+	if err != nil {
+		return namespace, nil, err
+	}
+	return namespace, obj.Object, nil
+}
+
+// Snapshot returns the object of the component as it is in the cluster before a deploy,
+// nil if it doesn't exist yet
+func Snapshot(kubeClient *meshkube.Client, oamComp v1alpha1.Component) (map[string]interface{}, error) {
+	desired, err := Render(oamComp)
+	if err != nil {
+		return nil, err
+	}
+
+	_, live, err := liveObject(kubeClient, oamComp, desired)
+	return live, err
+}
+
+// Restore brings the object of the component back to its snapshot taken before a deploy,
+// the object is deleted if the deploy created it
+func Restore(kubeClient *meshkube.Client, oamComp v1alpha1.Component, snapshot map[string]interface{}) error {
+	if snapshot == nil {
+		return Deploy(kubeClient, oamComp, v1alpha1.Configuration{}, true)
+	}
+
+	manifest, err := yaml.Marshal(restorableObject(snapshot))
+	if err != nil {
+		return err
+	}
+	namespace, _, _ := unstructured.NestedString(snapshot, "metadata", "namespace")
+
+	return kubeClient.ApplyManifest(manifest, meshkube.ApplyOptions{
+		Namespace: namespace,
+		Update:    true,
+	})
+}
+
+// restorableObject returns the object without its status and the metadata the api server
+// maintains, which can't be written back
+func restorableObject(obj map[string]interface{}) map[string]interface{} {
+	res := map[string]interface{}{}
+	for k, v := range obj {
+		if k != "status" {
+			res[k] = v
+		}
+	}
+
+	if md, ok := obj["metadata"].(map[string]interface{}); ok {
+		kept := map[string]interface{}{}
+		for k, v := range md {
+			switch k {
+			case "uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds", "managedFields", "selfLink":
+				continue
+			}
+			kept[k] = v
+		}
+		res["metadata"] = kept
+	}
+
+	return res
+}
@@ -0,0 +1,43 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRestorableObject(t *testing.T) {
+	live := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":              "settings",
+			"namespace":         "default",
+			"labels":            map[string]interface{}{"app": "web"},
+			"ownerReferences":   []interface{}{map[string]interface{}{"name": "web"}},
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2022-01-01T00:00:00Z",
+			"managedFields":     []interface{}{},
+		},
+		"data":   map[string]interface{}{"mode": "live"},
+		"status": map[string]interface{}{},
+	}
+
+	want := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "settings",
+			"namespace":       "default",
+			"labels":          map[string]interface{}{"app": "web"},
+			"ownerReferences": []interface{}{map[string]interface{}{"name": "web"}},
+		},
+		"data": map[string]interface{}{"mode": "live"},
+	}
+	if got := restorableObject(live); !reflect.DeepEqual(got, want) {
+		t.Errorf("restorable object %v, want %v", got, want)
+	}
+	if _, ok := live["status"]; !ok {
+		t.Error("the snapshot was modified")
+	}
+}
@@ -0,0 +1,71 @@
+package patterns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+	"github.com/layer5io/meshkit/models/oam/core/v1alpha1"
+	"github.com/layer5io/meshkit/utils/kubernetes"
+)
+
+// Snapshot holds the objects of a component in each cluster as they were before a deploy
+type Snapshot struct {
+	Component v1alpha1.Component
+	// objects are the live objects by kubeconfig, nil if the object didn't exist
+	objects map[string]map[string]interface{}
+}
+
+// CanSnapshot returns true if the state of the component can be snapshotted and restored,
+// which is only the case of the kubernetes components deployed by the server
+func CanSnapshot(comp v1alpha1.Component) bool {
+	return strings.HasSuffix(strings.ToLower(comp.Spec.Type), ".k8s")
+}
+
+// SnapshotOAM records the live objects of the component in every cluster before it is deployed
+func SnapshotOAM(kconfigs []string, comp v1alpha1.Component) (*Snapshot, error) {
+	if !CanSnapshot(comp) {
+		return nil, fmt.Errorf("components of type %s can't be snapshotted", comp.Spec.Type)
+	}
+
+	snapshot := &Snapshot{Component: comp, objects: map[string]map[string]interface{}{}}
+	for _, config := range kconfigs {
+		kcli, err := kubernetes.New([]byte(config))
+		if err != nil {
+			return nil, err
+		}
+		obj, err := k8s.Snapshot(kcli, comp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s: %s", comp.Name, err)
+		}
+		snapshot.objects[config] = obj
+	}
+
+	return snapshot, nil
+}
+
+// RestoreOAM brings the objects of the component back to the snapshot, the objects which
+// existed before the deploy are restored and only those created by the deploy are deleted
+func RestoreOAM(snapshot *Snapshot) (string, error) {
+	var msgs []string
+	var errs []error
+	for config, obj := range snapshot.objects {
+		kcli, err := kubernetes.New([]byte(config))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := k8s.Restore(kcli, snapshot.Component, obj); err != nil {
+			errs = append(errs, fmt.Errorf("failed to restore %s: %s", snapshot.Component.Name, err))
+			continue
+		}
+
+		if obj == nil {
+			msgs = append(msgs, "deleted the created "+snapshot.Component.Name)
+		} else {
+			msgs = append(msgs, "restored the previous "+snapshot.Component.Name)
+		}
+	}
+
+	return strings.Join(msgs, "\n"), mergeErrors(errs)
+}
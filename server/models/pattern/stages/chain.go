@@ -12,6 +12,9 @@ type Data struct {
 	PatternSvcWorkloadCapabilities map[string]core.WorkloadCapability
 	PatternSvcTraitCapabilities    map[string][]core.TraitCapability
 
+	// Inputs are the values of the pattern inputs supplied at deploy time
+	Inputs map[string]interface{}
+
 	// Other is for passing metadata across different stages
 	Lock  sync.Mutex
 	Other map[string]interface{}
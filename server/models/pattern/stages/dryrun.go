@@ -0,0 +1,78 @@
+package stages
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+)
+
+const DryRunSuffixKey = ".dryRun"
+
+// DryRun generates the CompConfigPairs just like Provision does but instead of
+// provisioning them it hands them over to the ServiceActionProvider for a dry run.
+//
+// The diffs for each service are stored in Data.Other under "<service>.dryRun"
+func DryRun(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+
+		config, err := data.Pattern.GenerateApplicationConfiguration()
+		if err != nil {
+			act.Terminate(fmt.Errorf("failed to generate application configuration: %s", err))
+			return
+		}
+
+		names := make([]string, 0, len(data.Pattern.Services))
+		for name := range data.Pattern.Services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		errs := []error{}
+		for _, name := range names {
+			ccp, err := generateCompConfigPair(data, name, *data.Pattern.Services[name], config)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			diffs, err := act.DryRun(ccp)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			data.Lock.Lock()
+			data.Other[fmt.Sprintf("%s%s", name, DryRunSuffixKey)] = diffs
+			data.Lock.Unlock()
+		}
+
+		if next != nil {
+			next(data, mergeErrors(errs))
+		}
+	}
+}
+
+// GetDryRunDiffs returns the diffs which were stored by the DryRun stage keyed by the service name
+func GetDryRunDiffs(data *Data) map[string][]k8s.ObjectDiff {
+	res := map[string][]k8s.ObjectDiff{}
+
+	data.Lock.Lock()
+	defer data.Lock.Unlock()
+	for k, v := range data.Other {
+		if !strings.HasSuffix(k, DryRunSuffixKey) {
+			continue
+		}
+
+		if diffs, ok := v.([]k8s.ObjectDiff); ok {
+			res[strings.TrimSuffix(k, DryRunSuffixKey)] = diffs
+		}
+	}
+
+	return res
+}
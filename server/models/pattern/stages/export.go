@@ -0,0 +1,161 @@
+package stages
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/application"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+)
+
+const ExportSuffixKey = ".export"
+
+// Export generates the components of the services just like Provision does but instead
+// of provisioning them it renders them into standalone kubernetes objects.
+//
+// The kubernetes components and the core workloads of Meshery, such as Application, are
+// rendered through their workload definitions. The core traits, such as meshmap, are
+// only meaningful to Meshery and render no objects. The components and traits of the
+// adapters are rendered by the adapters while provisioning and hence fail the export.
+//
+// The objects for each service are stored in Data.Other under "<service>.export"
+func Export(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
+	return func(data *Data, err error, next ChainStageNextFunction) {
+		if err != nil {
+			act.Terminate(err)
+			return
+		}
+
+		config, err := data.Pattern.GenerateApplicationConfiguration()
+		if err != nil {
+			act.Terminate(fmt.Errorf("failed to generate application configuration: %s", err))
+			return
+		}
+
+		errs := []error{}
+		for name, svc := range data.Pattern.Services {
+			if err := exportableTraits(name, svc, data.PatternSvcTraitCapabilities[name]); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			isK8s := strings.HasSuffix(strings.ToLower(svc.Type), ".k8s")
+			if !isK8s && !isCoreWorkload(data.PatternSvcWorkloadCapabilities[name], "Application") {
+				errs = append(errs, fmt.Errorf("export is not supported for the service %s of type %s", name, svc.Type))
+				continue
+			}
+
+			ccp, err := generateCompConfigPair(data, name, *svc, config)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			// The component only carries the resource id label for the deploy, the exported
+			// objects are labelled as the service instead
+			ccp.Component.Labels = svc.Labels
+
+			var objs []map[string]interface{}
+			if isK8s {
+				var obj map[string]interface{}
+				obj, err = k8s.Export(ccp.Component)
+				objs = []map[string]interface{}{obj}
+			} else {
+				ccp.Component.Annotations = svc.Annotations
+				objs, err = application.Export(ccp.Component, ccp.Configuration)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to render the service %s: %s", name, err))
+				continue
+			}
+
+			data.Lock.Lock()
+			data.Other[fmt.Sprintf("%s%s", name, ExportSuffixKey)] = objs
+			data.Lock.Unlock()
+		}
+
+		if next != nil {
+			next(data, mergeErrors(errs))
+		}
+	}
+}
+
+// isCoreWorkload returns true if the workload is the core workload of Meshery with the given name
+func isCoreWorkload(wc core.WorkloadCapability, name string) bool {
+	return wc.Metadata["adapter.meshery.io/name"] == "core" && wc.OAMDefinition.Name == name
+}
+
+// exportableTraits checks that the traits of the service are core traits, these only
+// describe the service to Meshery whereas the other traits are provisioned by the adapters
+func exportableTraits(name string, svc *core.Service, tcs []core.TraitCapability) error {
+	for trName := range svc.Traits {
+		isCore := false
+		for _, tc := range tcs {
+			if tc.OAMDefinition.Name == trName && tc.Metadata["adapter.meshery.io/name"] == "core" {
+				isCore = true
+				break
+			}
+		}
+		if !isCore {
+			return fmt.Errorf("export is not supported for the trait %s of the service %s", trName, name)
+		}
+	}
+
+	return nil
+}
+
+// exportOrder ranks the kinds which other objects depend upon so that they come first
+var exportOrder = map[string]int{
+	"Namespace":                0,
+	"CustomResourceDefinition": 1,
+	"ServiceAccount":           2,
+	"Secret":                   3,
+	"ConfigMap":                3,
+}
+
+// GetExportedObjects returns the objects which were rendered by the Export stage in the
+// order they should be applied in, namespaces and definitions first and then by service name
+func GetExportedObjects(data *Data) []map[string]interface{} {
+	type exported struct {
+		service string
+		obj     map[string]interface{}
+	}
+	objs := []exported{}
+
+	data.Lock.Lock()
+	for k, v := range data.Other {
+		if !strings.HasSuffix(k, ExportSuffixKey) {
+			continue
+		}
+
+		if svcObjs, ok := v.([]map[string]interface{}); ok {
+			for _, obj := range svcObjs {
+				objs = append(objs, exported{service: strings.TrimSuffix(k, ExportSuffixKey), obj: obj})
+			}
+		}
+	}
+	data.Lock.Unlock()
+
+	rank := func(obj map[string]interface{}) int {
+		kind, _ := obj["kind"].(string)
+		if r, ok := exportOrder[kind]; ok {
+			return r
+		}
+		return len(exportOrder)
+	}
+	// The objects of a service are kept in the order they were rendered in
+	sort.SliceStable(objs, func(i, j int) bool {
+		if rank(objs[i].obj) != rank(objs[j].obj) {
+			return rank(objs[i].obj) < rank(objs[j].obj)
+		}
+		return objs[i].service < objs[j].service
+	})
+
+	res := make([]map[string]interface{}, 0, len(objs))
+	for _, e := range objs {
+		res = append(res, e.obj)
+	}
+
+	return res
+}
@@ -0,0 +1,175 @@
+package stages
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+)
+
+func TestExport(t *testing.T) {
+	const patternFile = `
+name: ExportPattern
+services:
+  web:
+    type: Deployment.K8s
+    namespace: shop
+    labels:
+      app: web
+    annotations:
+      pattern.meshery.io.k8s.k8sAPIVersion: apps/v1
+      pattern.meshery.io.k8s.k8sKind: Deployment
+    settings:
+      spec:
+        replicas: 2
+    traits:
+      meshmap:
+        id: 1234
+  shop:
+    type: Namespace.K8s
+    namespace: shop
+    annotations:
+      pattern.meshery.io.k8s.k8sAPIVersion: v1
+      pattern.meshery.io.k8s.k8sKind: Namespace
+`
+
+	export := func(t *testing.T, patternFile string) ([]map[string]interface{}, error) {
+		p, err := core.NewPatternFile([]byte(patternFile))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The capabilities are looked up by the Validator, the core ones are registered by Meshery
+		data := &Data{
+			Pattern:                        &p,
+			Other:                          map[string]interface{}{},
+			PatternSvcWorkloadCapabilities: map[string]core.WorkloadCapability{},
+			PatternSvcTraitCapabilities:    map[string][]core.TraitCapability{},
+		}
+		for name, svc := range p.Services {
+			id, _ := uuid.NewV4()
+			svc.ID = &id
+
+			wc := core.WorkloadCapability{}
+			wc.OAMDefinition.Name = svc.Type
+			wc.Metadata = map[string]string{"adapter.meshery.io/name": "core"}
+			data.PatternSvcWorkloadCapabilities[name] = wc
+			for trName := range svc.Traits {
+				tc := core.TraitCapability{}
+				tc.OAMDefinition.Name = trName
+				tc.Metadata = map[string]string{"adapter.meshery.io/name": "core"}
+				if trName == "mTLS" {
+					tc.Metadata["adapter.meshery.io/name"] = "istio"
+				}
+				data.PatternSvcTraitCapabilities[name] = append(data.PatternSvcTraitCapabilities[name], tc)
+			}
+		}
+
+		var gotErr error
+		var objs []map[string]interface{}
+		CreateChain().
+			Add(Export(&fakeProvider{}, &fakeActionProvider{})).
+			Add(func(data *Data, err error, next ChainStageNextFunction) {
+				gotErr = err
+				objs = GetExportedObjects(data)
+			}).
+			Process(data)
+
+		return objs, gotErr
+	}
+
+	objs, err := export(t, patternFile)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("Export() rendered %d objects, want 2", len(objs))
+	}
+
+	// The namespace is rendered first and without a namespace of its own
+	ns := objs[0]
+	if ns["kind"] != "Namespace" {
+		t.Fatalf("first object is a %v, want the Namespace", ns["kind"])
+	}
+	if _, ok := ns["metadata"].(map[string]interface{})["namespace"]; ok {
+		t.Errorf("Namespace is rendered with a namespace")
+	}
+
+	web := objs[1]
+	metadata := web["metadata"].(map[string]interface{})
+	if web["apiVersion"] != "apps/v1" || web["kind"] != "Deployment" || metadata["namespace"] != "shop" {
+		t.Errorf("Export() = %v, want an apps/v1 Deployment in the shop namespace", web)
+	}
+	if labels := metadata["labels"].(map[string]interface{}); len(labels) != 1 || labels["app"] != "web" {
+		t.Errorf("Export() labels = %v, want only the labels of the service", labels)
+	}
+	if _, ok := metadata["annotations"]; ok {
+		t.Errorf("Export() annotations = %v, want the Meshery annotations dropped", metadata["annotations"])
+	}
+	if replicas := web["spec"].(map[string]interface{})["replicas"]; replicas != int64(2) {
+		t.Errorf("Export() replicas = %v, want 2", replicas)
+	}
+
+	// Components of the adapters can't be rendered by the server
+	_, err = export(t, patternFile+`
+  istio:
+    type: IstioMesh
+    namespace: istio-system
+`)
+	if err == nil || !strings.Contains(err.Error(), "export is not supported for the service istio") {
+		t.Errorf("Export() error = %v, want the istio service to be unsupported", err)
+	}
+
+	// Neither can their traits
+	_, err = export(t, patternFile+`
+  api:
+    type: Deployment.K8s
+    annotations:
+      pattern.meshery.io.k8s.k8sAPIVersion: apps/v1
+      pattern.meshery.io.k8s.k8sKind: Deployment
+    traits:
+      mTLS:
+        policy: strict
+`)
+	if err == nil || !strings.Contains(err.Error(), "export is not supported for the trait mTLS of the service api") {
+		t.Errorf("Export() error = %v, want the mTLS trait to be unsupported", err)
+	}
+
+	// The core applications are rendered into the objects of their rollout
+	objs, err = export(t, `
+name: ApplicationPattern
+services:
+  shop:
+    type: Application
+    namespace: shop
+    labels:
+      app: shop
+    settings:
+      replicas: 3
+      containers:
+      - name: shop
+        image: shop:1.0
+        ports:
+        - name: http
+          containerPort: 8080
+    traits:
+      meshmap:
+        id: 5678
+`)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(objs) != 2 || objs[0]["kind"] != "Rollout" || objs[1]["kind"] != "Service" {
+		t.Fatalf("Export() = %v, want the Rollout and its Service", objs)
+	}
+	rollout := objs[0]
+	if rollout["apiVersion"] != "argoproj.io/v1alpha1" || rollout["metadata"].(map[string]interface{})["namespace"] != "shop" {
+		t.Errorf("Export() = %v, want an argo Rollout in the shop namespace", rollout)
+	}
+	if replicas := rollout["spec"].(map[string]interface{})["replicas"]; replicas != int64(3) {
+		t.Errorf("Export() replicas = %v, want 3", replicas)
+	}
+	if _, ok := rollout["status"]; ok {
+		t.Errorf("Export() rendered the status of the rollout")
+	}
+}
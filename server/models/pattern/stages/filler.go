@@ -12,6 +12,10 @@ import (
 
 const FillerPattern = `\$\(#ref\..+\)`
 
+// InputsRefPrefix is the prefix of the reference queries which resolve to the
+// pattern inputs, for instance $(#ref.inputs.replicas)
+const InputsRefPrefix = "inputs."
+
 var FillerRegex *regexp.Regexp
 
 func init() {
@@ -33,6 +37,29 @@ func Filler(skipPrintLogs bool) ChainStageFunction {
 		// Flatten the service map to perform queries
 		flatSvc := map[string]interface{}{}
 		utils.FlattenMap("", utils.ToMapStringInterface(data.Pattern), flatSvc)
+
+		// Resolve the inputs before anything is provisioned so that
+		// missing or invalid inputs reject the pattern
+		inputs, err := data.Pattern.ResolveInputs(data.Inputs)
+		if err != nil {
+			if next != nil {
+				next(data, fmt.Errorf("invalid pattern inputs: %s", err))
+			}
+			return
+		}
+		for name, val := range inputs {
+			flatSvc[InputsRefPrefix+name] = val
+		}
+		if data.Inputs == nil {
+			// Without inputs the references to the inputs which have no
+			// default are kept as they are instead of being rejected
+			for name := range data.Pattern.Inputs {
+				if _, ok := inputs[name]; !ok {
+					flatSvc[InputsRefPrefix+name] = "$(#ref." + InputsRefPrefix + name + ")"
+				}
+			}
+		}
+
 		if !skipPrintLogs {
 			fmt.Printf("%+#v\n", flatSvc)
 		}
@@ -215,7 +242,9 @@ func fillMap(mp map[string]interface{}, flatSvc map[string]interface{}) (map[str
 	return _fillMap(mp)
 }
 
-func fillMapString(str string, flatSvc map[string]interface{}) (string, bool, error) {
+// fillMapString resolves the reference query in the string, the pattern inputs
+// retain their type so that for instance an integer input can be used as is
+func fillMapString(str string, flatSvc map[string]interface{}) (interface{}, bool, error) {
 	res, ok := matchPattern(str)
 	if !ok {
 		return "", false, nil
@@ -226,6 +255,10 @@ func fillMapString(str string, flatSvc map[string]interface{}) (string, bool, er
 		return "", false, fmt.Errorf("invalid reference query: %s", res)
 	}
 
+	if strings.HasPrefix(res, InputsRefPrefix) {
+		return val, true, nil
+	}
+
 	cval, ok := val.(string)
 	if !ok {
 		return "", false, fmt.Errorf("resolved reference query [%s] does not return string", res)
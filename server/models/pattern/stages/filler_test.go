@@ -124,3 +124,117 @@ func Test_matchPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestFillerInputs(t *testing.T) {
+	var samplePattern = `
+name: ParameterizedPattern
+inputs:
+  namespace:
+    type: string
+    default: default
+    pattern: "^[a-z0-9-]+$"
+  replicas:
+    type: integer
+    required: true
+  env:
+    enum: [dev, prod]
+    default: dev
+services:
+  web:
+    type: Deployment
+    namespace: "$(#ref.inputs.namespace)"
+    settings:
+      replicas: "$(#ref.inputs.replicas)"
+      labels:
+        env: "$(#ref.inputs.env)"
+`
+
+	tests := []struct {
+		name    string
+		inputs  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:   "When the inputs are valid",
+			inputs: map[string]interface{}{"replicas": "3", "namespace": "web"},
+		},
+		{
+			name:    "When a required input is missing",
+			inputs:  map[string]interface{}{"namespace": "web"},
+			wantErr: true,
+		},
+		{
+			name:    "When an input is not of the declared type",
+			inputs:  map[string]interface{}{"replicas": "three"},
+			wantErr: true,
+		},
+		{
+			name:    "When an input is not one of the enum",
+			inputs:  map[string]interface{}{"replicas": "3", "env": "staging"},
+			wantErr: true,
+		},
+		{
+			name:    "When an input does not match the pattern",
+			inputs:  map[string]interface{}{"replicas": "3", "namespace": "Web_1"},
+			wantErr: true,
+		},
+		{
+			name:    "When an input is unknown",
+			inputs:  map[string]interface{}{"replicas": "3", "image": "nginx"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := core.NewPatternFile([]byte(samplePattern))
+			if err != nil {
+				t.Fatal("failed to generate pattern file: ", err)
+			}
+
+			called := false
+			Filler(true)(&Data{Pattern: &p, Inputs: tt.inputs}, nil, func(data *Data, err error) {
+				called = true
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("Filler() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if tt.wantErr {
+					return
+				}
+
+				web := data.Pattern.Services["web"]
+				if web.Namespace != "web" {
+					t.Errorf("expected: %s\nGot: %s", "web", web.Namespace)
+				}
+				if web.Settings["replicas"] != int64(3) {
+					t.Errorf("expected: %v\nGot: %#v", 3, web.Settings["replicas"])
+				}
+				if env := web.Settings["labels"].(map[string]interface{})["env"]; env != "dev" {
+					t.Errorf("expected: %s\nGot: %v", "dev", env)
+				}
+			})
+			if !called {
+				t.Fatal("next stage was not invoked")
+			}
+		})
+	}
+	t.Run("When no inputs are supplied", func(t *testing.T) {
+		p, err := core.NewPatternFile([]byte(samplePattern))
+		if err != nil {
+			t.Fatal("failed to generate pattern file: ", err)
+		}
+
+		Filler(true)(&Data{Pattern: &p}, nil, func(data *Data, err error) {
+			if err != nil {
+				t.Fatalf("Filler() error = %v", err)
+			}
+
+			web := data.Pattern.Services["web"]
+			if web.Namespace != "default" {
+				t.Errorf("expected: %s\nGot: %s", "default", web.Namespace)
+			}
+			if web.Settings["replicas"] != "$(#ref.inputs.replicas)" {
+				t.Errorf("expected the reference to be kept\nGot: %#v", web.Settings["replicas"])
+			}
+		})
+	})
+}
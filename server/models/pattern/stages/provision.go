@@ -2,6 +2,7 @@ package stages
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/layer5io/meshery/server/helpers"
@@ -17,8 +18,21 @@ type CompConfigPair struct {
 	Hosts         map[string]bool
 }
 
-const ProvisionSuffixKey = ".isProvisioned"
+const (
+	ProvisionSuffixKey = ".isProvisioned"
 
+	// RollbackSuffixKey marks the services which were rolled back after
+	// a failed atomic provision
+	RollbackSuffixKey = ".isRolledBack"
+
+	// FailedSuffixKey marks the services which failed to provision
+	FailedSuffixKey = ".isFailed"
+)
+
+// Provision provisions the services of the pattern as per the plan.
+//
+// If the ServiceInfoProvider asks for an atomic provision then a failure of any of
+// the services rolls back the services which were already provisioned.
 func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFunction {
 	return func(data *Data, err error, next ChainStageNextFunction) {
 		if err != nil {
@@ -46,58 +60,142 @@ func Provision(prov ServiceInfoProvider, act ServiceActionProvider) ChainStageFu
 		}
 
 		errs := []error{}
+		provisioned := map[string]CompConfigPair{}
 
 		// Execute the plan
 		_ = plan.Execute(func(name string, svc core.Service) bool {
-			ccp := CompConfigPair{}
-
-			// Create application component
-			comp, err := data.Pattern.GetApplicationComponent(name)
+			ccp, err := generateCompConfigPair(data, name, svc, config)
 			if err != nil {
+				data.Lock.Lock()
+				errs = append(errs, err)
+				data.Other[fmt.Sprintf("%s%s", name, FailedSuffixKey)] = err.Error()
+				data.Lock.Unlock()
 				return false
 			}
 
-			// Generate hosts list
-			ccp.Hosts = generateHosts(
-				data.PatternSvcWorkloadCapabilities[name],
-				data.PatternSvcTraitCapabilities[name],
-			)
-
-			comp.SetLabels(map[string]string{
-				"resource.pattern.meshery.io/id": svc.ID.String(),
-			})
-
-			// Get annotations for the component, if any
-			comp.Annotations = helpers.MergeStringMaps(
-				selector.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
-				comp.Annotations,
-			)
-
-			ccp.Component = comp
-
-			// Add configuration only if traits are applied to the component
-			if len(svc.Traits) > 0 {
-				ccp.Configuration = config
-			}
-
 			msg, err := act.Provision(ccp)
 			if err != nil {
+				data.Lock.Lock()
 				errs = append(errs, err)
+				data.Other[fmt.Sprintf("%s%s", name, FailedSuffixKey)] = err.Error()
+				data.Lock.Unlock()
 				return false
 			}
 
 			data.Lock.Lock()
 			// Store that this service was provisioned successfully
 			data.Other[fmt.Sprintf("%s%s", name, ProvisionSuffixKey)] = msg
+			provisioned[name] = ccp
 			data.Lock.Unlock()
 
 			return true
 		})
 
+		err = mergeErrors(errs)
+		if err != nil && prov.IsAtomic() && len(provisioned) > 0 {
+			err = rollback(data, prov, act, provisioned, err)
+		}
+
 		if next != nil {
-			next(data, mergeErrors(errs))
+			next(data, err)
+		}
+	}
+}
+
+// rollback undoes the provisioned services in the reverse order in which they
+// were provisioned, the returned error carries both the failure which caused
+// the rollback and the result of the rollback
+func rollback(data *Data, prov ServiceInfoProvider, act ServiceActionProvider, provisioned map[string]CompConfigPair, cause error) error {
+	// Only the provisioned services take part in the rollback
+	p := core.Pattern{
+		Name:     data.Pattern.Name,
+		Services: map[string]*core.Service{},
+	}
+	for name := range provisioned {
+		svc := *data.Pattern.Services[name]
+		svc.DependsOn = []string{}
+		for _, dep := range data.Pattern.Services[name].DependsOn {
+			if _, ok := provisioned[dep]; ok {
+				svc.DependsOn = append(svc.DependsOn, dep)
+			}
+		}
+		p.Services[name] = &svc
+	}
+
+	// Invert the direction of the plan used for provisioning
+	plan, err := planner.CreatePlan(p, !prov.IsDelete())
+	if err != nil {
+		return fmt.Errorf("%s\nrollback failed: %s", cause, err)
+	}
+
+	errs := []error{}
+	_ = plan.Execute(func(name string, svc core.Service) bool {
+		msg, err := act.Rollback(provisioned[name])
+
+		data.Lock.Lock()
+		defer data.Lock.Unlock()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back %s: %s", name, err))
+			return false
 		}
+
+		delete(data.Other, fmt.Sprintf("%s%s", name, ProvisionSuffixKey))
+		data.Other[fmt.Sprintf("%s%s", name, RollbackSuffixKey)] = msg
+		return true
+	})
+
+	if rerr := mergeErrors(errs); rerr != nil {
+		remaining := []string{}
+		data.Lock.Lock()
+		for name := range provisioned {
+			if _, ok := data.Other[fmt.Sprintf("%s%s", name, ProvisionSuffixKey)]; ok {
+				remaining = append(remaining, name)
+			}
+		}
+		data.Lock.Unlock()
+		sort.Strings(remaining)
+
+		return fmt.Errorf("%s\nrollback failed, services left provisioned: %s\n%s", cause, strings.Join(remaining, ", "), rerr)
 	}
+
+	return fmt.Errorf("%s\nrollback succeeded: %d provisioned service(s) were rolled back", cause, len(provisioned))
+}
+
+// generateCompConfigPair creates the component and configuration pair which
+// is handed over to the ServiceActionProvider for the given service
+func generateCompConfigPair(data *Data, name string, svc core.Service, config v1alpha1.Configuration) (CompConfigPair, error) {
+	ccp := CompConfigPair{}
+
+	// Create application component
+	comp, err := data.Pattern.GetApplicationComponent(name)
+	if err != nil {
+		return ccp, err
+	}
+
+	// Generate hosts list
+	ccp.Hosts = generateHosts(
+		data.PatternSvcWorkloadCapabilities[name],
+		data.PatternSvcTraitCapabilities[name],
+	)
+
+	comp.SetLabels(map[string]string{
+		"resource.pattern.meshery.io/id": svc.ID.String(),
+	})
+
+	// Get annotations for the component, if any
+	comp.Annotations = helpers.MergeStringMaps(
+		selector.GetAnnotationsForWorkload(data.PatternSvcWorkloadCapabilities[name]),
+		comp.Annotations,
+	)
+
+	ccp.Component = comp
+
+	// Add configuration only if traits are applied to the component
+	if len(svc.Traits) > 0 {
+		ccp.Configuration = config
+	}
+
+	return ccp, nil
 }
 
 func generateHosts(wc core.WorkloadCapability, tcs []core.TraitCapability) map[string]bool {
@@ -0,0 +1,131 @@
+package stages
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
+)
+
+type fakeProvider struct {
+	atomic bool
+}
+
+func (fp *fakeProvider) GetMesheryPatternResource(_, _, _, _ string) (*uuid.UUID, error) {
+	return nil, fmt.Errorf("not found")
+}
+func (fp *fakeProvider) GetServiceMesh() (string, string)   { return "", "" }
+func (fp *fakeProvider) GetAPIVersionForKind(string) string { return "" }
+func (fp *fakeProvider) IsDelete() bool                     { return false }
+func (fp *fakeProvider) IsAtomic() bool                     { return fp.atomic }
+
+type fakeActionProvider struct {
+	fail string
+
+	mu         sync.Mutex
+	rolledBack []string
+}
+
+func (fa *fakeActionProvider) Terminate(error) {}
+func (fa *fakeActionProvider) Provision(ccp CompConfigPair) (string, error) {
+	if ccp.Component.Name == fa.fail {
+		return "", fmt.Errorf("failed to provision %s", ccp.Component.Name)
+	}
+	return "provisioned " + ccp.Component.Name, nil
+}
+func (fa *fakeActionProvider) Rollback(ccp CompConfigPair) (string, error) {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+	fa.rolledBack = append(fa.rolledBack, ccp.Component.Name)
+	return "rolled back " + ccp.Component.Name, nil
+}
+func (fa *fakeActionProvider) DryRun(CompConfigPair) ([]k8s.ObjectDiff, error) { return nil, nil }
+func (fa *fakeActionProvider) Persist(string, core.Service, bool) error        { return nil }
+
+func TestProvisionRollback(t *testing.T) {
+	const patternFile = `
+name: RollbackPattern
+services:
+  first:
+    type: ConfigMap
+    namespace: default
+  second:
+    type: ConfigMap
+    namespace: default
+    dependsOn:
+      - first
+  third:
+    type: ConfigMap
+    namespace: default
+    dependsOn:
+      - second
+`
+
+	tests := []struct {
+		name           string
+		atomic         bool
+		wantRolledBack []string
+		wantErr        string
+	}{
+		{
+			name:           "atomic provision rolls back in reverse order",
+			atomic:         true,
+			wantRolledBack: []string{"second", "first"},
+			wantErr:        "rollback succeeded",
+		},
+		{
+			name:           "non atomic provision leaves provisioned services",
+			atomic:         false,
+			wantRolledBack: nil,
+			wantErr:        "failed to provision third",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := core.NewPatternFile([]byte(patternFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, svc := range p.Services {
+				id, _ := uuid.NewV4()
+				svc.ID = &id
+			}
+
+			prov := &fakeProvider{atomic: tt.atomic}
+			act := &fakeActionProvider{fail: "third"}
+
+			var gotErr error
+			var other map[string]interface{}
+			CreateChain().
+				Add(Provision(prov, act)).
+				Add(func(data *Data, err error, next ChainStageNextFunction) {
+					gotErr = err
+					other = data.Other
+				}).
+				Process(&Data{
+					Pattern: &p,
+					Other:   map[string]interface{}{},
+				})
+
+			if gotErr == nil || !strings.Contains(gotErr.Error(), tt.wantErr) {
+				t.Fatalf("Provision() error = %v, want it to contain %q", gotErr, tt.wantErr)
+			}
+			if fmt.Sprint(act.rolledBack) != fmt.Sprint(tt.wantRolledBack) {
+				t.Errorf("rolled back = %v, want %v", act.rolledBack, tt.wantRolledBack)
+			}
+			for _, name := range tt.wantRolledBack {
+				if _, ok := other[name+ProvisionSuffixKey]; ok {
+					t.Errorf("%s is still marked as provisioned", name)
+				}
+				if _, ok := other[name+RollbackSuffixKey]; !ok {
+					t.Errorf("%s is not marked as rolled back", name)
+				}
+			}
+		})
+	}
+}
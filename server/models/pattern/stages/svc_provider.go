@@ -3,6 +3,7 @@ package stages
 import (
 	"github.com/gofrs/uuid"
 	"github.com/layer5io/meshery/server/models/pattern/core"
+	"github.com/layer5io/meshery/server/models/pattern/patterns/k8s"
 )
 
 type ServiceInfoProvider interface {
@@ -15,10 +16,13 @@ type ServiceInfoProvider interface {
 	GetServiceMesh() (name string, version string)
 	GetAPIVersionForKind(kind string) string
 	IsDelete() bool
+	IsAtomic() bool
 }
 
 type ServiceActionProvider interface {
 	Terminate(error)
 	Provision(CompConfigPair) (string, error)
+	Rollback(CompConfigPair) (string, error)
+	DryRun(CompConfigPair) ([]k8s.ObjectDiff, error)
 	Persist(string, core.Service, bool) error
 }
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+)
+
+// PatternDeploymentsAPIResponse is the API response model for the pattern deployments
+type PatternDeploymentsAPIResponse struct {
+	Page        uint                `json:"page"`
+	PageSize    uint                `json:"page_size"`
+	TotalCount  uint                `json:"total_count"`
+	Deployments []PatternDeployment `json:"deployments"`
+}
+
+// PatternDeploymentStatus is the overall outcome of a pattern deployment
+type PatternDeploymentStatus string
+
+const (
+	PatternDeploymentSucceeded  PatternDeploymentStatus = "succeeded"
+	PatternDeploymentFailed     PatternDeploymentStatus = "failed"
+	PatternDeploymentRolledBack PatternDeploymentStatus = "rolled_back"
+)
+
+// PatternDeploymentServiceStatus is the outcome of a single service of a pattern deployment
+type PatternDeploymentServiceStatus string
+
+const (
+	PatternServiceProvisioned PatternDeploymentServiceStatus = "provisioned"
+	PatternServiceFailed      PatternDeploymentServiceStatus = "failed"
+	PatternServiceSkipped     PatternDeploymentServiceStatus = "skipped"
+	PatternServiceRolledBack  PatternDeploymentServiceStatus = "rolled_back"
+)
+
+// PatternDeployment records a single deploy (or undeploy) of a pattern
+type PatternDeployment struct {
+	ID *uuid.UUID `json:"id,omitempty"`
+
+	// PatternID is the id of the saved pattern which was deployed, if any
+	PatternID   *uuid.UUID `json:"pattern_id,omitempty"`
+	PatternName string     `json:"pattern_name,omitempty"`
+	// VersionHash is the sha256 of the pattern file which was deployed
+	VersionHash string `json:"version_hash,omitempty"`
+	// PatternFile is the pattern as it was deployed, with its references and inputs filled
+	PatternFile string `json:"pattern_file,omitempty"`
+
+	ContextIDs   pq.StringArray `json:"context_ids,omitempty" gorm:"type:text[]"`
+	ContextNames pq.StringArray `json:"context_names,omitempty" gorm:"type:text[]"`
+	UserID       string         `json:"user_id,omitempty"`
+
+	IsDelete bool                    `json:"is_delete"`
+	Status   PatternDeploymentStatus `json:"status,omitempty"`
+	Message  string                  `json:"message,omitempty"`
+
+	Services []PatternDeploymentService `json:"services,omitempty" gorm:"-"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternDeploymentService records what happened to a single service of the pattern
+type PatternDeploymentService struct {
+	ID           *uuid.UUID                     `json:"id,omitempty"`
+	DeploymentID *uuid.UUID                     `json:"deployment_id,omitempty"`
+	Name         string                         `json:"name,omitempty"`
+	Type         string                         `json:"type,omitempty"`
+	Status       PatternDeploymentServiceStatus `json:"status,omitempty"`
+	// Message is the message returned by the adapter or the error, if any
+	Message string `json:"message,omitempty"`
+}
+
+// PatternVersionHash returns the hash which identifies a version of the pattern file
+func PatternVersionHash(patternFile []byte) string {
+	sum := sha256.Sum256(patternFile)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// PatternDeploymentPersister is the persister for persisting
+// pattern deployments and the outcome of their services on the database
+type PatternDeploymentPersister struct {
+	DB *database.Handler
+}
+
+// GetPatternDeployments returns the pattern deployments, optionally only of the given pattern
+func (pdp *PatternDeploymentPersister) GetPatternDeployments(patternID, order string, page, pageSize uint64) ([]byte, error) {
+	order = sanitizeOrderInput(order, []string{"created_at", "updated_at", "started_at", "pattern_name"})
+	if order == "" {
+		order = "started_at desc"
+	}
+
+	count := int64(0)
+	deployments := []PatternDeployment{}
+
+	query := pdp.DB.Model(&PatternDeployment{}).Order(order)
+	if patternID != "" {
+		query = query.Where("pattern_id = ?", patternID)
+	}
+	query.Count(&count)
+
+	if err := Paginate(uint(page), uint(pageSize))(query).Find(&deployments).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range deployments {
+		services, err := pdp.getServices(*deployments[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		deployments[i].Services = services
+	}
+
+	return marshalPatternDeploymentsPage(&PatternDeploymentsAPIResponse{
+		Page:        uint(page),
+		PageSize:    uint(pageSize),
+		TotalCount:  uint(count),
+		Deployments: deployments,
+	}), nil
+}
+
+// GetPatternDeployment returns the pattern deployment with the given id along with its services
+func (pdp *PatternDeploymentPersister) GetPatternDeployment(id uuid.UUID) ([]byte, error) {
+	var deployment PatternDeployment
+
+	if err := pdp.DB.First(&deployment, id).Error; err != nil {
+		return nil, err
+	}
+
+	services, err := pdp.getServices(id)
+	if err != nil {
+		return nil, err
+	}
+	deployment.Services = services
+
+	return marshalPatternDeployment(&deployment), nil
+}
+
+// SavePatternDeployment saves the pattern deployment along with the outcome of its services
+func (pdp *PatternDeploymentPersister) SavePatternDeployment(deployment *PatternDeployment) ([]byte, error) {
+	if deployment.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, ErrGenerateUUID(err)
+		}
+
+		deployment.ID = &id
+	}
+
+	for i := range deployment.Services {
+		svc := &deployment.Services[i]
+		if svc.ID == nil {
+			id, err := uuid.NewV4()
+			if err != nil {
+				return nil, ErrGenerateUUID(err)
+			}
+
+			svc.ID = &id
+		}
+		svc.DeploymentID = deployment.ID
+	}
+
+	if err := pdp.DB.Save(deployment).Error; err != nil {
+		return nil, err
+	}
+	for i := range deployment.Services {
+		if err := pdp.DB.Save(&deployment.Services[i]).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	return marshalPatternDeployment(deployment), nil
+}
+
+func (pdp *PatternDeploymentPersister) getServices(deploymentID uuid.UUID) ([]PatternDeploymentService, error) {
+	services := []PatternDeploymentService{}
+	err := pdp.DB.
+		Where("deployment_id = ?", deploymentID).
+		Order("name").
+		Find(&services).Error
+
+	return services, err
+}
+
+func marshalPatternDeploymentsPage(pdp *PatternDeploymentsAPIResponse) []byte {
+	res, _ := json.Marshal(pdp)
+
+	return res
+}
+
+func marshalPatternDeployment(pd *PatternDeployment) []byte {
+	res, _ := json.Marshal(pd)
+
+	return res
+}
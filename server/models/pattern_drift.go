@@ -0,0 +1,184 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+	meshsyncmodel "github.com/layer5io/meshsync/pkg/model"
+)
+
+// PatternResourceIDLabelKey is the label which carries the id of the pattern
+// resource on the objects provisioned for the services of a pattern
+const PatternResourceIDLabelKey = "resource.pattern.meshery.io/id"
+
+// PatternDriftStatus is the overall drift status of a deployed pattern
+type PatternDriftStatus string
+
+const (
+	PatternInSync  PatternDriftStatus = "in_sync"
+	PatternDrifted PatternDriftStatus = "drifted"
+)
+
+// ServiceDriftStatus is the drift status of the object of a service in a cluster
+type ServiceDriftStatus string
+
+const (
+	ServiceInSync   ServiceDriftStatus = "in_sync"
+	ServiceDeleted  ServiceDriftStatus = "deleted"
+	ServiceModified ServiceDriftStatus = "modified"
+	ServiceUnknown  ServiceDriftStatus = "unknown"
+)
+
+// PatternDriftReport is the result of comparing a pattern deployment with
+// the state of the clusters as observed by MeshSync
+type PatternDriftReport struct {
+	DeploymentID *uuid.UUID         `json:"deployment_id,omitempty"`
+	PatternID    *uuid.UUID         `json:"pattern_id,omitempty"`
+	PatternName  string             `json:"pattern_name,omitempty"`
+	Status       PatternDriftStatus `json:"status"`
+	CheckedAt    *time.Time         `json:"checked_at,omitempty"`
+
+	Services []ServiceDrift `json:"services"`
+	// Orphans are the labelled objects which have no matching pattern resource
+	Orphans []OrphanedObject `json:"orphans"`
+}
+
+// ServiceDrift is the drift of the object of a single service in a cluster
+type ServiceDrift struct {
+	Service    string             `json:"service"`
+	ResourceID string             `json:"resource_id,omitempty"`
+	Context    string             `json:"context,omitempty"`
+	APIVersion string             `json:"api_version,omitempty"`
+	Kind       string             `json:"kind,omitempty"`
+	Name       string             `json:"name,omitempty"`
+	Namespace  string             `json:"namespace,omitempty"`
+	Status     ServiceDriftStatus `json:"status"`
+	Fields     []FieldDrift       `json:"fields,omitempty"`
+	Message    string             `json:"message,omitempty"`
+}
+
+// FieldDrift is a field of an object which was modified since the deploy
+type FieldDrift struct {
+	Path    string      `json:"path"`
+	Live    interface{} `json:"live,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// OrphanedObject is an object carrying the pattern resource label whose
+// pattern resource doesn't exist (anymore)
+type OrphanedObject struct {
+	ResourceID string `json:"resource_id"`
+	Context    string `json:"context,omitempty"`
+	APIVersion string `json:"api_version,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// GetPatternLabelledObjects returns the objects recorded by MeshSync in the given
+// clusters which carry the pattern resource label
+func GetPatternLabelledObjects(db *database.Handler, clusterIDs []string) ([]meshsyncmodel.Object, error) {
+	objects := []meshsyncmodel.Object{}
+	if len(clusterIDs) == 0 {
+		return objects, nil
+	}
+
+	labelled := db.Model(&meshsyncmodel.KeyValue{}).
+		Select("id").
+		Where("kind = ? AND key = ?", meshsyncmodel.KindLabel, PatternResourceIDLabelKey)
+
+	err := db.Model(&meshsyncmodel.Object{}).
+		Preload("ObjectMeta").
+		Preload("ObjectMeta.Labels", "kind = ?", meshsyncmodel.KindLabel).
+		Preload("ObjectMeta.Annotations", "kind = ?", meshsyncmodel.KindAnnotation).
+		Preload("Spec").
+		Where("id IN (?) AND cluster_id IN (?)", labelled, clusterIDs).
+		Find(&objects).Error
+	if err != nil {
+		return nil, ErrDBRead(err)
+	}
+
+	return objects, nil
+}
+
+// MeshSyncObjectToMap rebuilds the kubernetes object from the object recorded by MeshSync,
+// the status is left out
+func MeshSyncObjectToMap(obj meshsyncmodel.Object) map[string]interface{} {
+	res := map[string]interface{}{
+		"apiVersion": obj.APIVersion,
+		"kind":       obj.Kind,
+	}
+
+	if obj.ObjectMeta != nil {
+		metadata := map[string]interface{}{
+			"name": obj.ObjectMeta.Name,
+		}
+		if obj.ObjectMeta.Namespace != "" {
+			metadata["namespace"] = obj.ObjectMeta.Namespace
+		}
+		if labels := keyValuesToMap(obj.ObjectMeta.Labels); len(labels) > 0 {
+			metadata["labels"] = labels
+		}
+		if annotations := keyValuesToMap(obj.ObjectMeta.Annotations); len(annotations) > 0 {
+			metadata["annotations"] = annotations
+		}
+		res["metadata"] = metadata
+	}
+
+	if obj.Spec != nil && obj.Spec.Attribute != "" {
+		var spec interface{}
+		if err := json.Unmarshal([]byte(obj.Spec.Attribute), &spec); err == nil {
+			res["spec"] = spec
+		}
+	}
+
+	// Secondary fields of the configmaps and secrets
+	for k, v := range map[string]string{
+		"immutable":  obj.Immutable,
+		"data":       obj.Data,
+		"binaryData": obj.BinaryData,
+		"stringData": obj.StringData,
+		"type":       obj.Type,
+	} {
+		if v == "" {
+			continue
+		}
+
+		var val interface{}
+		if err := json.Unmarshal([]byte(v), &val); err != nil {
+			val = v
+		}
+		res[k] = val
+	}
+
+	return res
+}
+
+// GetPatternResourceLabel returns the value of the pattern resource label of the object
+func GetPatternResourceLabel(obj meshsyncmodel.Object) string {
+	if obj.ObjectMeta == nil {
+		return ""
+	}
+
+	for _, label := range obj.ObjectMeta.Labels {
+		if label != nil && label.Key == PatternResourceIDLabelKey {
+			return label.Value
+		}
+	}
+
+	return ""
+}
+
+func keyValuesToMap(kvs []*meshsyncmodel.KeyValue) map[string]interface{} {
+	res := map[string]interface{}{}
+	for _, kv := range kvs {
+		if kv == nil {
+			continue
+		}
+		res[kv.Key] = kv.Value
+	}
+
+	return res
+}
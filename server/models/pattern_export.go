@@ -0,0 +1,163 @@
+package models
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// PatternExportFormat is the format of the artifact a pattern is exported to
+type PatternExportFormat string
+
+const (
+	// PatternExportManifest is a multi document yaml of the kubernetes objects
+	PatternExportManifest PatternExportFormat = "manifest"
+	// PatternExportHelm is a helm chart archive with one template per object
+	PatternExportHelm PatternExportFormat = "helm"
+	// PatternExportKustomize is a tarball of a kustomize base with one resource per object
+	PatternExportKustomize PatternExportFormat = "kustomize"
+)
+
+// GetPatternExportFormats returns the formats a pattern can be exported to
+func GetPatternExportFormats() []PatternExportFormat {
+	return []PatternExportFormat{PatternExportManifest, PatternExportHelm, PatternExportKustomize}
+}
+
+// PatternExport is an exported pattern along with the file it should be saved as
+type PatternExport struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+var invalidExportNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// exportName converts the name into a name usable for a chart and for the files
+func exportName(name string) string {
+	name = invalidExportNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	name = strings.Trim(name, "-")
+	if name == "" {
+		return "pattern"
+	}
+
+	return name
+}
+
+// ExportPattern packages the rendered kubernetes objects of the pattern into the given
+// format. The artifacts only contain plain kubernetes objects and can be applied with
+// kubectl, helm or kustomize without Meshery.
+func ExportPattern(name string, objects []map[string]interface{}, format PatternExportFormat) (*PatternExport, error) {
+	name = exportName(name)
+
+	docs := make([][]byte, 0, len(objects))
+	for _, obj := range objects {
+		doc, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	switch format {
+	case PatternExportManifest, "":
+		return &PatternExport{
+			FileName:    name + ".yaml",
+			ContentType: "application/x-yaml",
+			Content:     bytes.Join(docs, []byte("---\n")),
+		}, nil
+	case PatternExportHelm:
+		return exportHelmChart(name, objects, docs)
+	case PatternExportKustomize:
+		return exportKustomization(name, objects, docs)
+	}
+
+	return nil, fmt.Errorf("unsupported export format %q, supported formats are %v", format, GetPatternExportFormats())
+}
+
+// exportHelmChart packages the objects as the templates of a chart named after the pattern
+func exportHelmChart(name string, objects []map[string]interface{}, docs [][]byte) (*PatternExport, error) {
+	files := []RepoFile{
+		{
+			Path:    path.Join(name, "Chart.yaml"),
+			Content: []byte(fmt.Sprintf("apiVersion: v2\nname: %s\ndescription: Exported from the Meshery pattern %s\ntype: application\nversion: 0.1.0\n", name, name)),
+		},
+		{Path: path.Join(name, "values.yaml"), Content: []byte("{}\n")},
+	}
+
+	for i, fileName := range exportFileNames(objects) {
+		// Braces in the settings would be evaluated as template actions otherwise
+		content := strings.ReplaceAll(string(docs[i]), "{{", `{{"{{"}}`)
+		files = append(files, RepoFile{
+			Path:    path.Join(name, "templates", fileName),
+			Content: []byte(content),
+		})
+	}
+
+	content, err := PackTarball(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PatternExport{
+		FileName:    name + "-0.1.0.tgz",
+		ContentType: "application/x-tar",
+		Content:     content,
+	}, nil
+}
+
+// exportKustomization packages the objects as the resources of a kustomize base
+func exportKustomization(name string, objects []map[string]interface{}, docs [][]byte) (*PatternExport, error) {
+	fileNames := exportFileNames(objects)
+
+	kustomization, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  fileNames,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := []RepoFile{{Path: path.Join(name, "kustomization.yaml"), Content: kustomization}}
+	for i, fileName := range fileNames {
+		files = append(files, RepoFile{Path: path.Join(name, fileName), Content: docs[i]})
+	}
+
+	content, err := PackTarball(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PatternExport{
+		FileName:    name + ".tar.gz",
+		ContentType: "application/x-tar",
+		Content:     content,
+	}, nil
+}
+
+// exportFileNames names the file of each object after its kind and name, objects
+// of the same kind and name in different namespaces are numbered
+func exportFileNames(objects []map[string]interface{}) []string {
+	res := make([]string, 0, len(objects))
+	seen := map[string]int{}
+	for _, obj := range objects {
+		kind, _ := obj["kind"].(string)
+		var objName string
+		if metadata, ok := obj["metadata"].(map[string]interface{}); ok {
+			objName, _ = metadata["name"].(string)
+		}
+
+		fileName := exportName(kind + "-" + objName)
+		seen[fileName]++
+		if seen[fileName] > 1 {
+			fileName = fmt.Sprintf("%s-%d", fileName, seen[fileName])
+		}
+		res = append(res, fileName+".yaml")
+	}
+
+	return res
+}
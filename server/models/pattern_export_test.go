@@ -0,0 +1,79 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExportPattern(t *testing.T) {
+	objects := []map[string]interface{}{
+		{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": "shop"},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "shop"},
+			"data":       map[string]interface{}{"greeting": "{{ hello }}"},
+		},
+		{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		},
+	}
+	want := []string{"name: shop", "namespace: shop", "namespace: default", "greeting: '{{ hello }}'"}
+
+	tests := []struct {
+		format   PatternExportFormat
+		fileName string
+		render   func(content []byte) (string, error)
+	}{
+		{
+			format:   PatternExportManifest,
+			fileName: "my-shop.yaml",
+			render:   func(content []byte) (string, error) { return string(content), nil },
+		},
+		{
+			format:   PatternExportHelm,
+			fileName: "my-shop-0.1.0.tgz",
+			render:   func(content []byte) (string, error) { return RenderHelmChart(content, HelmChartValues{}) },
+		},
+		{
+			format:   PatternExportKustomize,
+			fileName: "my-shop.tar.gz",
+			render:   func(content []byte) (string, error) { return RenderKustomization(content, "my-shop") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := ExportPattern("My Shop", objects, tt.format)
+			if err != nil {
+				t.Fatalf("ExportPattern() error = %v", err)
+			}
+			if got.FileName != tt.fileName {
+				t.Errorf("ExportPattern() file name = %s, want %s", got.FileName, tt.fileName)
+			}
+
+			manifest, err := tt.render(got.Content)
+			if err != nil {
+				t.Fatalf("failed to render the exported pattern: %v", err)
+			}
+			if n := strings.Count(manifest, "kind: "); n != len(objects) {
+				t.Errorf("exported pattern renders %d objects, want %d", n, len(objects))
+			}
+			for _, w := range want {
+				if !strings.Contains(manifest, w) {
+					t.Errorf("exported pattern renders %s, want it to contain %q", manifest, w)
+				}
+			}
+		})
+	}
+
+	if _, err := ExportPattern("shop", objects, "terraform"); err == nil {
+		t.Errorf("ExportPattern() error = nil, want an unsupported format")
+	}
+}
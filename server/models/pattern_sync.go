@@ -0,0 +1,253 @@
+package models
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"github.com/gobwas/glob"
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/spf13/viper"
+)
+
+// minPatternSyncInterval is the lowest interval at which a repository can be polled
+const minPatternSyncInterval = 10 * time.Second
+
+// PatternSyncSourcesAPIResponse is the API response model for the pattern sync sources
+type PatternSyncSourcesAPIResponse struct {
+	Page        uint                `json:"page"`
+	PageSize    uint                `json:"page_size"`
+	TotalCount  uint                `json:"total_count"`
+	SyncSources []PatternSyncSource `json:"sync_sources"`
+}
+
+// PatternSyncSource is a git repository from which the patterns are continuously
+// imported and optionally deployed
+type PatternSyncSource struct {
+	ID     *uuid.UUID `json:"id,omitempty"`
+	UserID string     `json:"user_id,omitempty"`
+
+	// RepoURL is the URL of the git repository, either https:// or ssh, the
+	// latter also in the scp-like form git@github.com:org/repo.git. Local
+	// repositories are accepted only when ALLOW_LOCAL_GIT_REPOS is set
+	RepoURL string `json:"repo_url,omitempty"`
+	Branch  string `json:"branch,omitempty"`
+	// PathGlob selects the pattern files of the repository, for instance patterns/**.yaml
+	PathGlob string `json:"path_glob,omitempty"`
+	// PollInterval is the interval at which the repository is polled, for instance 5m
+	PollInterval string `json:"poll_interval,omitempty"`
+
+	// Deploy the changed patterns to the kubernetes contexts with the given ids
+	Deploy     bool           `json:"deploy"`
+	ContextIDs pq.StringArray `json:"context_ids,omitempty" gorm:"type:text[]"`
+
+	// LastCommit is the commit of the branch which was synced the last time
+	LastCommit   string     `json:"last_commit,omitempty"`
+	LastSyncedAt *time.Time `json:"last_synced_at,omitempty"`
+
+	// Syncs holds the most recent syncs of the source
+	Syncs []PatternSync `json:"syncs,omitempty" gorm:"-"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// PatternSyncStatus is the outcome of a single sync
+type PatternSyncStatus string
+
+const (
+	PatternSyncSucceeded PatternSyncStatus = "succeeded"
+	PatternSyncFailed    PatternSyncStatus = "failed"
+)
+
+// PatternSync records a single sync of a new commit of a sync source
+type PatternSync struct {
+	ID        *uuid.UUID        `json:"id,omitempty"`
+	SourceID  *uuid.UUID        `json:"source_id,omitempty"`
+	CommitSHA string            `json:"commit_sha,omitempty"`
+	Status    PatternSyncStatus `json:"status,omitempty"`
+	Message   string            `json:"message,omitempty"`
+	// ImportedPatterns are the paths of the pattern files which changed with the commit
+	ImportedPatterns pq.StringArray `json:"imported_patterns,omitempty" gorm:"type:text[]"`
+	// DeployResult is the outcome of deploying the imported patterns, if enabled
+	DeployResult string `json:"deploy_result,omitempty"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// PatternSyncFile tracks the pattern imported for a file of a sync source so
+// that a changed file updates the same pattern
+type PatternSyncFile struct {
+	ID        *uuid.UUID `json:"id,omitempty"`
+	SourceID  *uuid.UUID `json:"source_id,omitempty"`
+	Path      string     `json:"path,omitempty"`
+	PatternID *uuid.UUID `json:"pattern_id,omitempty"`
+	// BlobHash is the git hash of the content which was imported
+	BlobHash string `json:"blob_hash,omitempty"`
+}
+
+// RepoFile is a file of a git repository
+type RepoFile struct {
+	Path     string
+	BlobHash string
+	Content  []byte
+}
+
+// ValidateRepoURL checks that the git repository is cloned over https or ssh. Other
+// transports, such as file:// or a local path, read the repositories on the host of
+// Meshery, hence they are only accepted when ALLOW_LOCAL_GIT_REPOS is set, for
+// instance to test with local repositories
+func ValidateRepoURL(repoURL string) error {
+	ep, err := transport.NewEndpoint(repoURL)
+	if err != nil {
+		return fmt.Errorf("invalid repository URL %q: %s", repoURL, err)
+	}
+	if ep.Protocol == "file" && viper.GetBool("ALLOW_LOCAL_GIT_REPOS") {
+		return nil
+	}
+	if (ep.Protocol != "https" && ep.Protocol != "ssh") || ep.Host == "" {
+		return fmt.Errorf("repository URL %q should be an https or ssh URL", repoURL)
+	}
+
+	return nil
+}
+
+// Validate checks the sync source and fills in the defaults
+func (pss *PatternSyncSource) Validate() error {
+	if pss.RepoURL == "" {
+		return fmt.Errorf("repository URL is required")
+	}
+	if err := ValidateRepoURL(pss.RepoURL); err != nil {
+		return err
+	}
+	if pss.Branch == "" {
+		pss.Branch = "master"
+	}
+	if pss.PathGlob == "" {
+		pss.PathGlob = "**.yaml"
+	}
+	if _, err := glob.Compile(pss.PathGlob, '/'); err != nil {
+		return fmt.Errorf("invalid path glob %q: %s", pss.PathGlob, err)
+	}
+	if pss.PollInterval == "" {
+		pss.PollInterval = "5m"
+	}
+	interval, err := time.ParseDuration(pss.PollInterval)
+	if err != nil {
+		return fmt.Errorf("invalid poll interval %q: %s", pss.PollInterval, err)
+	}
+	if interval < minPatternSyncInterval {
+		return fmt.Errorf("poll interval should be at least %s", minPatternSyncInterval)
+	}
+
+	return nil
+}
+
+// Interval returns the poll interval of the sync source
+func (pss *PatternSyncSource) Interval() time.Duration {
+	interval, err := time.ParseDuration(pss.PollInterval)
+	if err != nil || interval < minPatternSyncInterval {
+		return minPatternSyncInterval
+	}
+
+	return interval
+}
+
+// GetRemoteBranchHead returns the commit the branch of the repository points to
+func GetRemoteBranchHead(repoURL, branch string) (string, error) {
+	remote := git.NewRemote(memory.NewStorage(), &gitconfig.RemoteConfig{
+		Name: git.DefaultRemoteName,
+		URLs: []string{repoURL},
+	})
+
+	refs, err := remote.List(&git.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	name := plumbing.NewBranchReferenceName(branch)
+	for _, ref := range refs {
+		if ref.Name() == name {
+			return ref.Hash().String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("branch %s not found in %s", branch, repoURL)
+}
+
+// GetRepoFiles clones the branch of the repository in memory and returns the commit
+// it points to along with the files whose path matches the glob. The default branch
+// of the repository is cloned if no branch is given.
+func GetRepoFiles(repoURL, branch, pathGlob string) (string, []RepoFile, error) {
+	g, err := glob.Compile(pathGlob, '/')
+	if err != nil {
+		return "", nil, err
+	}
+
+	var ref plumbing.ReferenceName
+	if branch != "" {
+		ref = plumbing.NewBranchReferenceName(branch)
+	}
+	repo, err := git.Clone(memory.NewStorage(), nil, &git.CloneOptions{
+		URL:           repoURL,
+		ReferenceName: ref,
+		SingleBranch:  true,
+		NoCheckout:    true,
+		Tags:          git.NoTags,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return "", nil, err
+	}
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return "", nil, err
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return "", nil, err
+	}
+
+	files := []RepoFile{}
+	err = tree.Files().ForEach(func(f *object.File) error {
+		if !g.Match(strings.TrimPrefix(f.Name, "/")) {
+			return nil
+		}
+
+		r, err := f.Reader()
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, RepoFile{
+			Path:     f.Name,
+			BlobHash: f.Hash.String(),
+			Content:  content,
+		})
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return head.Hash().String(), files, nil
+}
@@ -0,0 +1,158 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// recentPatternSyncs is the number of syncs returned along with a sync source
+const recentPatternSyncs = 10
+
+// PatternSyncPersister is the persister for persisting pattern sync
+// sources, their syncs and the patterns imported from them on the database
+type PatternSyncPersister struct {
+	DB *database.Handler
+}
+
+// GetPatternSyncSources returns the sync sources of the given user
+func (psp *PatternSyncPersister) GetPatternSyncSources(userID, order string, page, pageSize uint64) ([]byte, error) {
+	order = sanitizeOrderInput(order, []string{"created_at", "updated_at", "repo_url", "last_synced_at"})
+	if order == "" {
+		order = "updated_at desc"
+	}
+
+	count := int64(0)
+	sources := []PatternSyncSource{}
+
+	query := psp.DB.Model(&PatternSyncSource{}).Order(order)
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	query.Count(&count)
+
+	if err := Paginate(uint(page), uint(pageSize))(query).Find(&sources).Error; err != nil {
+		return nil, err
+	}
+
+	return marshalPatternSyncSourcesPage(&PatternSyncSourcesAPIResponse{
+		Page:        uint(page),
+		PageSize:    uint(pageSize),
+		TotalCount:  uint(count),
+		SyncSources: sources,
+	}), nil
+}
+
+// GetPatternSyncSource returns the sync source with the given id along with its recent syncs
+func (psp *PatternSyncPersister) GetPatternSyncSource(id uuid.UUID) (*PatternSyncSource, error) {
+	var source PatternSyncSource
+	if err := psp.DB.First(&source, id).Error; err != nil {
+		return nil, err
+	}
+
+	syncs, err := psp.GetPatternSyncs(id, recentPatternSyncs)
+	if err != nil {
+		return nil, err
+	}
+	source.Syncs = syncs
+
+	return &source, nil
+}
+
+// GetAllPatternSyncSources returns every persisted sync source
+func (psp *PatternSyncPersister) GetAllPatternSyncSources() ([]PatternSyncSource, error) {
+	sources := []PatternSyncSource{}
+	err := psp.DB.Find(&sources).Error
+	return sources, err
+}
+
+// SavePatternSyncSource saves the sync source, it is validated by the provider
+func (psp *PatternSyncPersister) SavePatternSyncSource(source *PatternSyncSource) ([]byte, error) {
+	if source.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, ErrGenerateUUID(err)
+		}
+
+		source.ID = &id
+	}
+
+	return marshalPatternSyncSource(source), psp.DB.Save(source).Error
+}
+
+// DeletePatternSyncSource deletes the sync source with the given id along with its syncs,
+// the patterns imported from the source are kept
+func (psp *PatternSyncPersister) DeletePatternSyncSource(id uuid.UUID) ([]byte, error) {
+	source := PatternSyncSource{ID: &id}
+	if err := psp.DB.Delete(&source).Error; err != nil {
+		return nil, err
+	}
+	if err := psp.DB.Where("source_id = ?", id).Delete(&PatternSync{}).Error; err != nil {
+		return nil, err
+	}
+	if err := psp.DB.Where("source_id = ?", id).Delete(&PatternSyncFile{}).Error; err != nil {
+		return nil, err
+	}
+
+	return marshalPatternSyncSource(&source), nil
+}
+
+// SavePatternSync creates or updates the given sync
+func (psp *PatternSyncPersister) SavePatternSync(sync *PatternSync) error {
+	if sync.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+
+		sync.ID = &id
+	}
+
+	return psp.DB.Save(sync).Error
+}
+
+// GetPatternSyncs returns the latest syncs of the sync source with the given id
+func (psp *PatternSyncPersister) GetPatternSyncs(sourceID uuid.UUID, limit int) ([]PatternSync, error) {
+	syncs := []PatternSync{}
+	err := psp.DB.
+		Where("source_id = ?", sourceID).
+		Order("started_at desc").
+		Limit(limit).
+		Find(&syncs).Error
+
+	return syncs, err
+}
+
+// GetPatternSyncFiles returns the files imported from the sync source with the given id
+func (psp *PatternSyncPersister) GetPatternSyncFiles(sourceID uuid.UUID) ([]PatternSyncFile, error) {
+	files := []PatternSyncFile{}
+	err := psp.DB.Where("source_id = ?", sourceID).Find(&files).Error
+	return files, err
+}
+
+// SavePatternSyncFile creates or updates the given imported file
+func (psp *PatternSyncPersister) SavePatternSyncFile(file *PatternSyncFile) error {
+	if file.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+
+		file.ID = &id
+	}
+
+	return psp.DB.Save(file).Error
+}
+
+func marshalPatternSyncSourcesPage(page *PatternSyncSourcesAPIResponse) []byte {
+	res, _ := json.Marshal(page)
+
+	return res
+}
+
+func marshalPatternSyncSource(source *PatternSyncSource) []byte {
+	res, _ := json.Marshal(source)
+
+	return res
+}
@@ -0,0 +1,314 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/layer5io/meshkit/database"
+	"github.com/layer5io/meshkit/logger"
+	"github.com/spf13/viper"
+)
+
+const testPatternFile = `name: %s
+services:
+  web:
+    type: Deployment
+`
+
+// testRepo is a worktree pushing to a local bare repository
+type testRepo struct {
+	t    *testing.T
+	dir  string
+	repo *git.Repository
+	url  string
+}
+
+func newTestRepo(t *testing.T) *testRepo {
+	t.Helper()
+
+	bare := filepath.Join(t.TempDir(), "patterns.git")
+	if _, err := git.PlainInit(bare, true); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "file://" + bare
+	if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{url}}); err != nil {
+		t.Fatal(err)
+	}
+
+	return &testRepo{t: t, dir: dir, repo: repo, url: url}
+}
+
+// commit writes the files and pushes them to the bare repository
+func (tr *testRepo) commit(files map[string]string) string {
+	tr.t.Helper()
+
+	wt, err := tr.repo.Worktree()
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	for path, content := range files {
+		full := filepath.Join(tr.dir, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			tr.t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			tr.t.Fatal(err)
+		}
+		if _, err := wt.Add(path); err != nil {
+			tr.t.Fatal(err)
+		}
+	}
+
+	hash, err := wt.Commit("update patterns", &git.CommitOptions{
+		Author: &object.Signature{Name: "meshery", Email: "meshery@example.com", When: time.Now()},
+	})
+	if err != nil {
+		tr.t.Fatal(err)
+	}
+	if err := tr.repo.Push(&git.PushOptions{RemoteName: git.DefaultRemoteName}); err != nil {
+		tr.t.Fatal(err)
+	}
+
+	return hash.String()
+}
+
+func TestGetRepoFiles(t *testing.T) {
+	repo := newTestRepo(t)
+	sha := repo.commit(map[string]string{
+		"patterns/web.yaml":        fmt.Sprintf(testPatternFile, "web"),
+		"patterns/nested/db.yaml":  fmt.Sprintf(testPatternFile, "db"),
+		"README.md":                "# patterns",
+		"other/ignored.yaml":       fmt.Sprintf(testPatternFile, "ignored"),
+		"patterns/notes/notes.txt": "notes",
+	})
+
+	head, err := GetRemoteBranchHead(repo.url, "master")
+	if err != nil {
+		t.Fatalf("GetRemoteBranchHead() error = %v", err)
+	}
+	if head != sha {
+		t.Errorf("GetRemoteBranchHead() = %s, want %s", head, sha)
+	}
+
+	if _, err := GetRemoteBranchHead(repo.url, "missing"); err == nil {
+		t.Error("GetRemoteBranchHead() expected an error for a missing branch")
+	}
+
+	commit, files, err := GetRepoFiles(repo.url, "master", "patterns/**.yaml")
+	if err != nil {
+		t.Fatalf("GetRepoFiles() error = %v", err)
+	}
+	if commit != sha {
+		t.Errorf("GetRepoFiles() commit = %s, want %s", commit, sha)
+	}
+
+	got := map[string]bool{}
+	for _, f := range files {
+		got[f.Path] = true
+	}
+	want := map[string]bool{"patterns/web.yaml": true, "patterns/nested/db.yaml": true}
+	if len(got) != len(want) {
+		t.Fatalf("GetRepoFiles() files = %v, want %v", got, want)
+	}
+	for path := range want {
+		if !got[path] {
+			t.Errorf("GetRepoFiles() missing file %s", path)
+		}
+	}
+}
+
+func TestPatternSyncWorkerSync(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&MesheryPattern{}, &PatternSyncSource{}, &PatternSync{}, &PatternSyncFile{}); err != nil {
+		t.Fatal(err)
+	}
+
+	syncs := &PatternSyncPersister{DB: &db}
+	patterns := &MesheryPatternPersister{DB: &db}
+
+	var deployed []string
+	deploy := func(ctx context.Context, provider Provider, source *PatternSyncSource, imported []MesheryPattern) (string, error) {
+		for _, p := range imported {
+			deployed = append(deployed, p.Name)
+		}
+		return "deployed", nil
+	}
+	worker := NewPatternSyncWorker(log, nil, syncs, patterns, deploy)
+
+	repo := newTestRepo(t)
+	first := repo.commit(map[string]string{
+		"patterns/web.yaml": fmt.Sprintf(testPatternFile, "web"),
+		"patterns/db.yaml":  fmt.Sprintf(testPatternFile, "db"),
+	})
+
+	// The local repository is accepted once local repositories are allowed
+	source := &PatternSyncSource{RepoURL: repo.url, Branch: "master", PathGlob: "patterns/*.yaml", Deploy: true}
+	l := &DefaultLocalProvider{PatternSyncPersister: syncs}
+	if _, err := l.SavePatternSyncSource("", source); err == nil {
+		t.Fatal("SavePatternSyncSource() expected an error for a local repository")
+	}
+	allowLocalGitRepos(t)
+	if _, err := l.SavePatternSyncSource("", source); err != nil {
+		t.Fatal(err)
+	}
+
+	record, err := worker.Sync(context.Background(), source)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if record.CommitSHA != first || record.Status != PatternSyncSucceeded || record.DeployResult != "deployed" {
+		t.Errorf("Sync() = %+v, want a successful deploy of commit %s", record, first)
+	}
+	if len(record.ImportedPatterns) != 2 || len(deployed) != 2 {
+		t.Errorf("Sync() imported %v and deployed %v, want both patterns", record.ImportedPatterns, deployed)
+	}
+
+	// Nothing to do until a new commit is pushed
+	if record, err := worker.Sync(context.Background(), source); err != nil || record != nil {
+		t.Errorf("Sync() of the same commit = %+v, %v, want no sync", record, err)
+	}
+
+	second := repo.commit(map[string]string{
+		"patterns/web.yaml": fmt.Sprintf(testPatternFile, "web-v2"),
+	})
+	deployed = nil
+
+	record, err = worker.Sync(context.Background(), source)
+	if err != nil {
+		t.Fatalf("Sync() error = %v", err)
+	}
+	if record.CommitSHA != second || len(record.ImportedPatterns) != 1 || record.ImportedPatterns[0] != "patterns/web.yaml" {
+		t.Errorf("Sync() = %+v, want only patterns/web.yaml imported at %s", record, second)
+	}
+	if len(deployed) != 1 || deployed[0] != "web-v2" {
+		t.Errorf("Sync() deployed %v, want [web-v2]", deployed)
+	}
+
+	// The changed file updates the pattern it was imported as
+	count := int64(0)
+	db.Model(&MesheryPattern{}).Count(&count)
+	if count != 2 {
+		t.Errorf("got %d patterns, want 2", count)
+	}
+
+	saved, err := syncs.GetPatternSyncSource(*source.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.LastCommit != second || len(saved.Syncs) != 2 {
+		t.Errorf("GetPatternSyncSource() = %+v, want last commit %s with 2 syncs", saved, second)
+	}
+}
+
+// allowLocalGitRepos accepts local repositories until the end of the test
+func allowLocalGitRepos(t *testing.T) {
+	viper.Set("ALLOW_LOCAL_GIT_REPOS", true)
+	t.Cleanup(func() { viper.Set("ALLOW_LOCAL_GIT_REPOS", false) })
+}
+
+func TestPatternSyncSourceValidate(t *testing.T) {
+	tests := []struct {
+		url        string
+		valid      bool
+		validLocal bool
+	}{
+		{"https://github.com/layer5io/meshery.git", true, true},
+		{"ssh://git@github.com/layer5io/meshery.git", true, true},
+		{"git@github.com:layer5io/meshery.git", true, true},
+		{"http://github.com/layer5io/meshery.git", false, false},
+		{"file:///etc", false, true},
+		{"/var/lib/meshery", false, true},
+		{"git://github.com/layer5io/meshery.git", false, false},
+	}
+
+	for _, tc := range tests {
+		source := &PatternSyncSource{RepoURL: tc.url}
+		if err := source.Validate(); (err == nil) != tc.valid {
+			t.Errorf("Validate() of %s = %v, want valid %t", tc.url, err, tc.valid)
+		}
+	}
+
+	allowLocalGitRepos(t)
+	for _, tc := range tests {
+		source := &PatternSyncSource{RepoURL: tc.url}
+		if err := source.Validate(); (err == nil) != tc.validLocal {
+			t.Errorf("Validate() of %s with local repositories allowed = %v, want valid %t", tc.url, err, tc.validLocal)
+		}
+	}
+}
+
+func TestPatternSyncSourcesOfUser(t *testing.T) {
+	log, err := logger.New("test", logger.Options{Format: logger.SyslogLogFormat})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := database.New(database.Options{
+		Filename: fmt.Sprintf("file:%s/meshery.db?cache=private&mode=rwc", t.TempDir()),
+		Engine:   database.SQLITE,
+		Logger:   log,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(&PatternSyncSource{}, &PatternSync{}, &PatternSyncFile{}); err != nil {
+		t.Fatal(err)
+	}
+	l := &DefaultLocalProvider{PatternSyncPersister: &PatternSyncPersister{DB: &db}}
+
+	source := &PatternSyncSource{UserID: "alice", RepoURL: "https://github.com/layer5io/meshery.git"}
+	if _, err := l.SavePatternSyncSource("", source); err != nil {
+		t.Fatal(err)
+	}
+	sourceID := source.ID.String()
+
+	data, err := l.GetPatternSyncSources("", "bob", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := PatternSyncSourcesAPIResponse{}
+	if err := json.Unmarshal(data, &page); err != nil || page.TotalCount != 0 {
+		t.Errorf("sources of another user are listed: %s", data)
+	}
+	if _, err := l.GetPatternSyncSource("", "bob", sourceID); err == nil {
+		t.Error("source of another user is returned")
+	}
+	if _, err := l.DeletePatternSyncSource("", "bob", sourceID); err == nil {
+		t.Error("source of another user is deleted")
+	}
+	other := &PatternSyncSource{ID: source.ID, UserID: "bob", RepoURL: "https://github.com/layer5io/meshery.git"}
+	if _, err := l.SavePatternSyncSource("", other); err == nil {
+		t.Error("source of another user is overwritten")
+	}
+
+	if _, err := l.GetPatternSyncSource("", "alice", sourceID); err != nil {
+		t.Errorf("source of the user is not returned: %v", err)
+	}
+	if _, err := l.DeletePatternSyncSource("", "alice", sourceID); err != nil {
+		t.Errorf("source of the user is not deleted: %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/logger"
+)
+
+const (
+	// patternSyncTickInterval is the resolution at which the sync sources are polled
+	patternSyncTickInterval = time.Second
+
+	// patternSyncReloadInterval is the interval after which the sync sources are
+	// reloaded from the database even if no change was signalled
+	patternSyncReloadInterval = 30 * time.Second
+)
+
+// PatternSyncDeployer deploys the patterns imported by a sync of the source to
+// its kubernetes contexts and returns the outcome of the deploy
+type PatternSyncDeployer func(ctx context.Context, provider Provider, source *PatternSyncSource, patterns []MesheryPattern) (string, error)
+
+// PatternSyncWorker polls the pattern sync sources for new commits, imports the
+// pattern files which changed with them and optionally deploys the imported patterns.
+// Sync sources and their syncs are persisted with the local provider so that the
+// worker picks them up again after a restart.
+type PatternSyncWorker struct {
+	log      logger.Handler
+	provider Provider
+	syncs    *PatternSyncPersister
+	patterns *MesheryPatternPersister
+	deploy   PatternSyncDeployer
+
+	backgroundWorker
+	entries map[uuid.UUID]*patternSyncEntry
+}
+
+// patternSyncEntry tracks the next poll of a sync source
+type patternSyncEntry struct {
+	sourceID uuid.UUID
+	interval time.Duration
+	next     time.Time
+}
+
+// NewPatternSyncWorker returns an instance of PatternSyncWorker
+func NewPatternSyncWorker(
+	log logger.Handler,
+	provider Provider,
+	syncs *PatternSyncPersister,
+	patterns *MesheryPatternPersister,
+	deploy PatternSyncDeployer,
+) *PatternSyncWorker {
+	return &PatternSyncWorker{
+		log:              log,
+		provider:         provider,
+		syncs:            syncs,
+		patterns:         patterns,
+		deploy:           deploy,
+		backgroundWorker: newBackgroundWorker(patternSyncTickInterval, patternSyncReloadInterval),
+		entries:          map[uuid.UUID]*patternSyncEntry{},
+	}
+}
+
+// Start starts the worker loop, the loop stops when the context is cancelled
+func (pw *PatternSyncWorker) Start(ctx context.Context) {
+	go pw.loop(ctx, func(now time.Time) {
+		if err := pw.reload(now); err != nil {
+			pw.log.Error(ErrPatternSync(err, "unknown"))
+		}
+	}, pw.dispatch)
+}
+
+// Refresh signals the worker to reload the sync sources from the database
+func (pw *PatternSyncWorker) Refresh() {
+	if pw == nil {
+		return
+	}
+
+	pw.refresh()
+}
+
+// Wait blocks until all the in-flight syncs have finished
+func (pw *PatternSyncWorker) Wait() {
+	pw.wait()
+}
+
+// reload syncs the tracked entries with the sync sources persisted in the database,
+// new sources are polled right away while the next poll of unchanged entries is kept
+func (pw *PatternSyncWorker) reload(now time.Time) error {
+	sources, err := pw.syncs.GetAllPatternSyncSources()
+	if err != nil {
+		return err
+	}
+
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	seen := map[uuid.UUID]bool{}
+	for _, source := range sources {
+		if source.ID == nil {
+			continue
+		}
+		seen[*source.ID] = true
+
+		interval := source.Interval()
+		entry, ok := pw.entries[*source.ID]
+		if ok && entry.interval == interval {
+			continue
+		}
+
+		pw.entries[*source.ID] = &patternSyncEntry{
+			sourceID: *source.ID,
+			interval: interval,
+			next:     now,
+		}
+	}
+
+	for id := range pw.entries {
+		if !seen[id] {
+			delete(pw.entries, id)
+		}
+	}
+
+	return nil
+}
+
+// dispatch syncs every source whose poll time has passed, a source is never
+// synced concurrently with itself
+func (pw *PatternSyncWorker) dispatch(ctx context.Context, now time.Time) {
+	pw.mu.Lock()
+	defer pw.mu.Unlock()
+
+	for _, entry := range pw.entries {
+		if now.Before(entry.next) {
+			continue
+		}
+
+		sourceID := entry.sourceID
+		if pw.start(sourceID, func() { pw.execute(ctx, sourceID) }) {
+			entry.next = now.Add(entry.interval)
+		}
+	}
+}
+
+func (pw *PatternSyncWorker) execute(ctx context.Context, sourceID uuid.UUID) {
+	source, err := pw.syncs.GetPatternSyncSource(sourceID)
+	if err != nil {
+		pw.log.Error(ErrPatternSync(err, sourceID.String()))
+		return
+	}
+
+	if _, err := pw.Sync(ctx, source); err != nil {
+		pw.log.Error(err)
+	}
+}
+
+// Sync imports the pattern files of the sync source which changed since its last
+// synced commit and deploys them if enabled. No sync is recorded when the branch
+// still points to the last synced commit, in which case nil is returned.
+func (pw *PatternSyncWorker) Sync(ctx context.Context, source *PatternSyncSource) (*PatternSync, error) {
+	head, err := GetRemoteBranchHead(source.RepoURL, source.Branch)
+	if err != nil {
+		return nil, ErrPatternSync(err, source.ID.String())
+	}
+	if head == source.LastCommit {
+		return nil, nil
+	}
+
+	startedAt := time.Now()
+	record := &PatternSync{
+		SourceID:  source.ID,
+		CommitSHA: head,
+		StartedAt: &startedAt,
+	}
+
+	imported, err := pw.importPatterns(source, record)
+	if err == nil && source.Deploy && len(imported) > 0 {
+		if pw.deploy == nil {
+			err = fmt.Errorf("deploying the synced patterns is not supported")
+		} else {
+			pw.log.Info("deploying patterns synced from ", source.RepoURL, " at ", record.CommitSHA)
+			record.DeployResult, err = pw.deploy(ctx, pw.provider, source, imported)
+		}
+	}
+
+	finishedAt := time.Now()
+	record.FinishedAt = &finishedAt
+	if err != nil {
+		err = ErrPatternSync(err, source.ID.String())
+		record.Status = PatternSyncFailed
+		record.Message = err.Error()
+	} else {
+		record.Status = PatternSyncSucceeded
+		record.Message = fmt.Sprintf("imported %d pattern(s)", len(imported))
+	}
+
+	if serr := pw.syncs.SavePatternSync(record); serr != nil {
+		return record, ErrPatternSync(serr, source.ID.String())
+	}
+
+	// The commit is synced even if the deploy failed, the imported patterns
+	// are not deployed again until they change
+	if record.Status == PatternSyncSucceeded || len(record.ImportedPatterns) > 0 {
+		source.LastCommit = record.CommitSHA
+		source.LastSyncedAt = &finishedAt
+		if _, serr := pw.syncs.SavePatternSyncSource(source); serr != nil {
+			return record, ErrPatternSync(serr, source.ID.String())
+		}
+	}
+
+	return record, err
+}
+
+// importPatterns saves the pattern files which changed since they were last imported,
+// a file which was imported before updates the same pattern
+func (pw *PatternSyncWorker) importPatterns(source *PatternSyncSource, record *PatternSync) ([]MesheryPattern, error) {
+	commit, files, err := GetRepoFiles(source.RepoURL, source.Branch, source.PathGlob)
+	if err != nil {
+		return nil, err
+	}
+	// The branch may have moved on since its head was listed
+	record.CommitSHA = commit
+
+	synced, err := pw.syncs.GetPatternSyncFiles(*source.ID)
+	if err != nil {
+		return nil, err
+	}
+	known := map[string]PatternSyncFile{}
+	for _, f := range synced {
+		known[f.Path] = f
+	}
+
+	imported := []MesheryPattern{}
+	record.ImportedPatterns = []string{}
+	var skipped []string
+	for _, f := range files {
+		syncFile, ok := known[f.Path]
+		if ok && syncFile.BlobHash == f.BlobHash {
+			continue
+		}
+
+		name, err := GetPatternName(string(f.Content))
+		if err != nil {
+			skipped = append(skipped, f.Path)
+			continue
+		}
+
+		pattern := MesheryPattern{
+			ID:          syncFile.PatternID,
+			Name:        name,
+			PatternFile: string(f.Content),
+			Location: map[string]interface{}{
+				"type":   "git",
+				"host":   source.RepoURL,
+				"path":   f.Path,
+				"branch": source.Branch,
+				"commit": commit,
+			},
+		}
+		if _, err := pw.patterns.SaveMesheryPattern(&pattern); err != nil {
+			return imported, err
+		}
+
+		syncFile.SourceID = source.ID
+		syncFile.Path = f.Path
+		syncFile.PatternID = pattern.ID
+		syncFile.BlobHash = f.BlobHash
+		if err := pw.syncs.SavePatternSyncFile(&syncFile); err != nil {
+			return imported, err
+		}
+
+		imported = append(imported, pattern)
+		record.ImportedPatterns = append(record.ImportedPatterns, f.Path)
+	}
+
+	if len(skipped) > 0 {
+		pw.log.Warn(ErrPatternSync(fmt.Errorf("files are not valid patterns: %s", strings.Join(skipped, ", ")), source.ID.String()))
+	}
+
+	return imported, nil
+}
@@ -0,0 +1,260 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// PerformanceComparisonKey is the key of the runner results under which the
+	// comparison against the pinned baseline of the profile is stored
+	PerformanceComparisonKey = "baseline-comparison"
+
+	// significanceLevel is the p-value below which the latency distributions are
+	// considered to be different
+	significanceLevel = 0.05
+	// DefaultRegressionThreshold is the relative increase in percent of the mean or the p99
+	// latency below which a significant change of the latency is not a regression
+	DefaultRegressionThreshold = 5
+	// regressionQPSDropPercent is the drop in the achieved throughput which is a regression
+	regressionQPSDropPercent = 10
+	// regressionErrorRateIncrease is the increase of the error rate which is a regression
+	regressionErrorRateIncrease = 0.01
+)
+
+// MetricDelta is the change of a metric from the base to the candidate result
+type MetricDelta struct {
+	Base      float64 `json:"base"`
+	Candidate float64 `json:"candidate"`
+	Delta     float64 `json:"delta"`
+	// DeltaPercent is the delta relative to the base, it is omitted if the base is zero
+	DeltaPercent *float64 `json:"delta_percent,omitempty"`
+}
+
+// PercentileDelta is the change of the latency of a percentile in milliseconds
+type PercentileDelta struct {
+	Percentile float64 `json:"percentile"`
+	MetricDelta
+}
+
+// SignificanceTest is the outcome of testing whether the latency distributions differ
+type SignificanceTest struct {
+	Method      string  `json:"method"`
+	Statistic   float64 `json:"statistic"`
+	PValue      float64 `json:"p_value"`
+	Alpha       float64 `json:"alpha"`
+	Significant bool    `json:"significant"`
+}
+
+// PerformanceComparison compares a candidate result with a base result
+type PerformanceComparison struct {
+	ProfileID         *uuid.UUID `json:"profile_id,omitempty"`
+	BaseResultID      *uuid.UUID `json:"base_result_id,omitempty"`
+	CandidateResultID *uuid.UUID `json:"candidate_result_id,omitempty"`
+
+	LatencyPercentiles []PercentileDelta `json:"latency_percentiles_ms"`
+	MeanLatency        MetricDelta       `json:"mean_latency_ms"`
+	QPS                MetricDelta       `json:"qps"`
+	ErrorRate          MetricDelta       `json:"error_rate"`
+	Significance       SignificanceTest  `json:"significance"`
+	// RegressionThreshold is the relative increase in percent of the mean or the p99 latency
+	// which is a regression, provided the change of the latency is significant
+	RegressionThreshold float64 `json:"regression_threshold"`
+
+	// Regressed is set if the candidate performs worse than the base, the reasons list why
+	Regressed bool     `json:"regressed"`
+	Reasons   []string `json:"reasons,omitempty"`
+}
+
+// ComparePerformanceResults compares the runner results of the candidate with the ones of the
+// base. The results are in the format of the fortio runner results which all the load generators
+// report in. The significance of the latency change is tested with a two sample Kolmogorov-Smirnov
+// test on the latency histograms.
+//
+// With large samples even a negligible change of the latency is significant, hence the latency
+// only regressed if the mean or the p99 latency also increased by more than the threshold, which
+// is given in percent and defaults to DefaultRegressionThreshold.
+func ComparePerformanceResults(base, candidate map[string]interface{}, threshold float64) (*PerformanceComparison, error) {
+	if threshold <= 0 {
+		threshold = DefaultRegressionThreshold
+	}
+
+	baseHist, err := resultHistogram(base)
+	if err != nil {
+		return nil, fmt.Errorf("base result: %s", err)
+	}
+	candidateHist, err := resultHistogram(candidate)
+	if err != nil {
+		return nil, fmt.Errorf("candidate result: %s", err)
+	}
+
+	res := &PerformanceComparison{LatencyPercentiles: []PercentileDelta{}, RegressionThreshold: threshold}
+
+	basePercentiles := resultPercentilesMs(base)
+	candidatePercentiles := resultPercentilesMs(candidate)
+	for p, b := range basePercentiles {
+		if c, ok := candidatePercentiles[p]; ok {
+			res.LatencyPercentiles = append(res.LatencyPercentiles, PercentileDelta{Percentile: p, MetricDelta: newMetricDelta(b, c)})
+		}
+	}
+	sort.Slice(res.LatencyPercentiles, func(i, j int) bool {
+		return res.LatencyPercentiles[i].Percentile < res.LatencyPercentiles[j].Percentile
+	})
+
+	res.MeanLatency = newMetricDelta(baseHist.avg*1000, candidateHist.avg*1000)
+	baseQPS, _ := base["ActualQPS"].(float64)
+	candidateQPS, _ := candidate["ActualQPS"].(float64)
+	res.QPS = newMetricDelta(baseQPS, candidateQPS)
+	res.ErrorRate = newMetricDelta(resultErrorRate(base), resultErrorRate(candidate))
+
+	statistic, pValue := kolmogorovSmirnov(baseHist, candidateHist)
+	res.Significance = SignificanceTest{
+		Method:      "kolmogorov-smirnov",
+		Statistic:   statistic,
+		PValue:      pValue,
+		Alpha:       significanceLevel,
+		Significant: pValue < significanceLevel,
+	}
+
+	if res.Significance.Significant {
+		increases := []string{}
+		if exceedsThreshold(res.MeanLatency, threshold) {
+			increases = append(increases, fmt.Sprintf("mean latency went from %.2fms to %.2fms", res.MeanLatency.Base, res.MeanLatency.Candidate))
+		}
+		for _, p := range res.LatencyPercentiles {
+			if p.Percentile == 99 && exceedsThreshold(p.MetricDelta, threshold) {
+				increases = append(increases, fmt.Sprintf("p99 latency went from %.2fms to %.2fms", p.Base, p.Candidate))
+			}
+		}
+		if len(increases) > 0 {
+			res.Reasons = append(res.Reasons, fmt.Sprintf("latency increased significantly, %s (p=%.4f)", strings.Join(increases, " and "), pValue))
+		}
+	}
+	if res.QPS.DeltaPercent != nil && *res.QPS.DeltaPercent < -regressionQPSDropPercent {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("throughput dropped by %.1f%%", -*res.QPS.DeltaPercent))
+	}
+	if res.ErrorRate.Delta > regressionErrorRateIncrease {
+		res.Reasons = append(res.Reasons, fmt.Sprintf("error rate increased from %.4f to %.4f", res.ErrorRate.Base, res.ErrorRate.Candidate))
+	}
+	res.Regressed = len(res.Reasons) > 0
+
+	return res, nil
+}
+
+// exceedsThreshold returns true if the metric increased by more than the threshold in percent
+func exceedsThreshold(d MetricDelta, threshold float64) bool {
+	if d.DeltaPercent == nil {
+		return d.Delta > 0
+	}
+
+	return *d.DeltaPercent > threshold
+}
+
+func newMetricDelta(base, candidate float64) MetricDelta {
+	d := MetricDelta{
+		Base:      base,
+		Candidate: candidate,
+		Delta:     candidate - base,
+	}
+	if base != 0 {
+		percent := d.Delta / base * 100
+		d.DeltaPercent = &percent
+	}
+
+	return d
+}
+
+// latencyHistogram is the latency histogram of a result, the bounds are in seconds
+type latencyHistogram struct {
+	buckets []histogramBucket
+	count   float64
+	avg     float64
+}
+
+type histogramBucket struct {
+	start, end, count float64
+}
+
+func resultHistogram(results map[string]interface{}) (*latencyHistogram, error) {
+	histogram, ok := results["DurationHistogram"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("latency histogram is missing")
+	}
+
+	h := &latencyHistogram{}
+	h.avg, _ = histogram["Avg"].(float64)
+	data, _ := histogram["Data"].([]interface{})
+	for _, d := range data {
+		d, _ := d.(map[string]interface{})
+		b := histogramBucket{}
+		b.start, _ = d["Start"].(float64)
+		b.end, _ = d["End"].(float64)
+		b.count, _ = d["Count"].(float64)
+		h.buckets = append(h.buckets, b)
+		h.count += b.count
+	}
+	if h.count == 0 {
+		return nil, fmt.Errorf("latency histogram is empty")
+	}
+
+	return h, nil
+}
+
+// cdf returns the share of the requests with a latency of at most x, assuming the
+// latencies are spread evenly within a bucket
+func (h *latencyHistogram) cdf(x float64) float64 {
+	var c float64
+	for _, b := range h.buckets {
+		switch {
+		case x >= b.end:
+			c += b.count
+		case x > b.start:
+			c += b.count * (x - b.start) / (b.end - b.start)
+		}
+	}
+
+	return c / h.count
+}
+
+// kolmogorovSmirnov returns the statistic and the p-value of the two sample Kolmogorov-Smirnov
+// test, the statistic is the largest distance between the two distribution functions which is
+// reached at one of the bucket bounds of either histogram
+func kolmogorovSmirnov(a, b *latencyHistogram) (float64, float64) {
+	var d float64
+	for _, h := range []*latencyHistogram{a, b} {
+		for _, bucket := range h.buckets {
+			for _, x := range []float64{bucket.start, bucket.end} {
+				d = math.Max(d, math.Abs(a.cdf(x)-b.cdf(x)))
+			}
+		}
+	}
+
+	ne := a.count * b.count / (a.count + b.count)
+	lambda := (math.Sqrt(ne) + 0.12 + 0.11/math.Sqrt(ne)) * d
+
+	return d, kolmogorovProbability(lambda)
+}
+
+// kolmogorovProbability is the complementary distribution function of the Kolmogorov distribution
+func kolmogorovProbability(lambda float64) float64 {
+	if lambda < 0.2 {
+		return 1
+	}
+
+	var sum float64
+	sign := 1.0
+	for j := 1.0; j <= 100; j++ {
+		term := sign * 2 * math.Exp(-2*j*j*lambda*lambda)
+		sum += term
+		if math.Abs(term) < 1e-10 {
+			break
+		}
+		sign = -sign
+	}
+
+	return math.Min(math.Max(sum, 0), 1)
+}
@@ -0,0 +1,155 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testBaseRunnerResults = `{
+	"ActualQPS": 50,
+	"DurationHistogram": {
+		"Avg": 0.011,
+		"Data": [
+			{"Start": 0.005, "End": 0.010, "Count": 600},
+			{"Start": 0.010, "End": 0.020, "Count": 380},
+			{"Start": 0.020, "End": 0.050, "Count": 20}
+		],
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.009},
+			{"Percentile": 99, "Value": 0.035}
+		]
+	},
+	"RetCodes": {"200": 1000}
+}`
+
+const testSlowerRunnerResults = `{
+	"ActualQPS": 40,
+	"DurationHistogram": {
+		"Avg": 0.024,
+		"Data": [
+			{"Start": 0.010, "End": 0.020, "Count": 300},
+			{"Start": 0.020, "End": 0.050, "Count": 650},
+			{"Start": 0.050, "End": 0.100, "Count": 50}
+		],
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.025},
+			{"Percentile": 99, "Value": 0.080}
+		]
+	},
+	"RetCodes": {"200": 950, "503": 50}
+}`
+
+const testLargeBaseRunnerResults = `{
+	"ActualQPS": 1000,
+	"DurationHistogram": {
+		"Avg": 0.0100,
+		"Data": [
+			{"Start": 0.005, "End": 0.010, "Count": 600000},
+			{"Start": 0.010, "End": 0.020, "Count": 390000},
+			{"Start": 0.020, "End": 0.050, "Count": 10000}
+		],
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.0090},
+			{"Percentile": 99, "Value": 0.0300}
+		]
+	},
+	"RetCodes": {"200": 1000000}
+}`
+
+const testLargeSlightlySlowerRunnerResults = `{
+	"ActualQPS": 995,
+	"DurationHistogram": {
+		"Avg": 0.0102,
+		"Data": [
+			{"Start": 0.005, "End": 0.010, "Count": 590000},
+			{"Start": 0.010, "End": 0.020, "Count": 399500},
+			{"Start": 0.020, "End": 0.050, "Count": 10500}
+		],
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.0091},
+			{"Percentile": 99, "Value": 0.0306}
+		]
+	},
+	"RetCodes": {"200": 1000000}
+}`
+
+func TestComparePerformanceResults(t *testing.T) {
+	load := func(s string) map[string]interface{} {
+		results := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(s), &results); err != nil {
+			t.Fatal(err)
+		}
+		return results
+	}
+
+	t.Run("same results", func(t *testing.T) {
+		got, err := ComparePerformanceResults(load(testBaseRunnerResults), load(testBaseRunnerResults), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Regressed || got.Significance.Significant {
+			t.Errorf("ComparePerformanceResults() = %+v, want no regression", got)
+		}
+		if len(got.LatencyPercentiles) != 2 || got.LatencyPercentiles[0].Percentile != 50 {
+			t.Errorf("ComparePerformanceResults() percentiles = %+v, want p50 and p99", got.LatencyPercentiles)
+		}
+	})
+
+	t.Run("slower candidate", func(t *testing.T) {
+		got, err := ComparePerformanceResults(load(testBaseRunnerResults), load(testSlowerRunnerResults), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Significance.Significant {
+			t.Errorf("ComparePerformanceResults() significance = %+v, want significant", got.Significance)
+		}
+		if !got.Regressed || len(got.Reasons) != 3 {
+			t.Errorf("ComparePerformanceResults() reasons = %v, want latency, throughput and error rate", got.Reasons)
+		}
+		if got.QPS.DeltaPercent == nil || *got.QPS.DeltaPercent != -20 {
+			t.Errorf("ComparePerformanceResults() qps = %+v, want a drop of 20%%", got.QPS)
+		}
+		if p50 := got.LatencyPercentiles[0]; p50.Delta < 15.9 || p50.Delta > 16.1 {
+			t.Errorf("ComparePerformanceResults() p50 delta = %v, want 16ms", p50.Delta)
+		}
+	})
+
+	t.Run("faster candidate", func(t *testing.T) {
+		got, err := ComparePerformanceResults(load(testSlowerRunnerResults), load(testBaseRunnerResults), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Significance.Significant || got.Regressed {
+			t.Errorf("ComparePerformanceResults() = %+v, want a significant improvement", got)
+		}
+	})
+
+	t.Run("slightly slower candidate of a large sample", func(t *testing.T) {
+		// A million requests each, the candidate is about 2% slower which is significant
+		// but below the regression threshold
+		got, err := ComparePerformanceResults(load(testLargeBaseRunnerResults), load(testLargeSlightlySlowerRunnerResults), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Significance.Significant {
+			t.Errorf("ComparePerformanceResults() significance = %+v, want significant", got.Significance)
+		}
+		if got.Regressed || got.RegressionThreshold != DefaultRegressionThreshold {
+			t.Errorf("ComparePerformanceResults() = %+v, want no regression below the default threshold", got)
+		}
+
+		got, err = ComparePerformanceResults(load(testLargeBaseRunnerResults), load(testLargeSlightlySlowerRunnerResults), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Regressed || len(got.Reasons) != 1 {
+			t.Errorf("ComparePerformanceResults() reasons = %v, want a latency regression above a threshold of 1%%", got.Reasons)
+		}
+	})
+
+	t.Run("missing histogram", func(t *testing.T) {
+		if _, err := ComparePerformanceResults(load(testBaseRunnerResults), load(testRunnerResults), 0); err == nil {
+			t.Error("ComparePerformanceResults() expected an error for a result without histogram data")
+		}
+	})
+}
@@ -0,0 +1,402 @@
+package models
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// PerformanceExportFormat is a format the performance results are exported in
+type PerformanceExportFormat string
+
+const (
+	// PerformanceExportCSV is a summary of every result on a line
+	PerformanceExportCSV PerformanceExportFormat = "csv"
+	// PerformanceExportJSONL is the summary along with the runner results of every result on a line
+	PerformanceExportJSONL PerformanceExportFormat = "jsonl"
+	// PerformanceExportHDR is a HdrHistogram log of the latencies with an interval for every result
+	PerformanceExportHDR PerformanceExportFormat = "hdr"
+	// PerformanceExportJUnit is a JUnit report with a test case for every SLO threshold of every result
+	PerformanceExportJUnit PerformanceExportFormat = "junit"
+)
+
+// PerformanceExportFormats are the formats the performance results can be exported in
+var PerformanceExportFormats = []PerformanceExportFormat{PerformanceExportCSV, PerformanceExportJSONL, PerformanceExportHDR, PerformanceExportJUnit}
+
+// IsValid checks if the results can be exported in the format
+func (f PerformanceExportFormat) IsValid() bool {
+	for _, format := range PerformanceExportFormats {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContentType returns the media type of the exported results
+func (f PerformanceExportFormat) ContentType() string {
+	switch f {
+	case PerformanceExportCSV:
+		return "text/csv"
+	case PerformanceExportJSONL:
+		return "application/x-ndjson"
+	case PerformanceExportJUnit:
+		return "application/xml"
+	default:
+		return "text/plain"
+	}
+}
+
+// Extension returns the file extension of the exported results
+func (f PerformanceExportFormat) Extension() string {
+	switch f {
+	case PerformanceExportHDR:
+		return "hlog"
+	case PerformanceExportJUnit:
+		return "xml"
+	default:
+		return string(f)
+	}
+}
+
+// exportedPercentiles are the latency percentiles of the summaries
+var exportedPercentiles = []float64{50, 75, 90, 99, 99.9}
+
+// PerformanceResultSummary holds the figures of a result which are exported, the
+// latencies are in milliseconds
+type PerformanceResultSummary struct {
+	ResultID           string     `json:"result_id"`
+	Name               string     `json:"name"`
+	Mesh               string     `json:"mesh,omitempty"`
+	PerformanceProfile string     `json:"performance_profile,omitempty"`
+	TestStartTime      *time.Time `json:"test_start_time,omitempty"`
+	LoadGenerator      string     `json:"load_generator,omitempty"`
+	URL                string     `json:"url,omitempty"`
+
+	RequestedQPS    string  `json:"requested_qps,omitempty"`
+	ActualQPS       float64 `json:"actual_qps"`
+	DurationSeconds float64 `json:"duration_s"`
+	Requests        int64   `json:"requests"`
+	ErrorRate       float64 `json:"error_rate"`
+
+	LatencyMin         float64            `json:"latency_min_ms"`
+	LatencyAvg         float64            `json:"latency_avg_ms"`
+	LatencyMax         float64            `json:"latency_max_ms"`
+	LatencyPercentiles map[string]float64 `json:"latency_percentiles_ms,omitempty"`
+
+	SLOVerdict PerformanceVerdictStatus `json:"slo_verdict,omitempty"`
+}
+
+// SummarizePerformanceResult returns the summary of the result. The runner results are
+// in the format of the fortio runner results which all the load generators report in.
+func SummarizePerformanceResult(m *MesheryResult) *PerformanceResultSummary {
+	s := &PerformanceResultSummary{
+		ResultID:      m.ID.String(),
+		Name:          m.Name,
+		Mesh:          m.Mesh,
+		TestStartTime: m.TestStartTime,
+		ErrorRate:     resultErrorRate(m.Result),
+	}
+	if m.PerformanceProfile != nil {
+		s.PerformanceProfile = m.PerformanceProfile.String()
+	}
+	if s.TestStartTime == nil {
+		if start, err := time.Parse(time.RFC3339Nano, fmt.Sprint(m.Result["StartTime"])); err == nil {
+			s.TestStartTime = &start
+		}
+	}
+	s.LoadGenerator, _ = m.Result["load-generator"].(string)
+	s.URL, _ = m.Result["URL"].(string)
+	s.RequestedQPS, _ = m.Result["RequestedQPS"].(string)
+	s.ActualQPS, _ = m.Result["ActualQPS"].(float64)
+	duration, _ := m.Result["ActualDuration"].(float64)
+	s.DurationSeconds = time.Duration(duration).Seconds()
+
+	// fortio reports the latencies in seconds
+	histogram, _ := m.Result["DurationHistogram"].(map[string]interface{})
+	count, _ := histogram["Count"].(float64)
+	s.Requests = int64(count)
+	for key, v := range map[string]*float64{"Min": &s.LatencyMin, "Avg": &s.LatencyAvg, "Max": &s.LatencyMax} {
+		latency, _ := histogram[key].(float64)
+		*v = latency * 1000
+	}
+	percentiles := resultPercentilesMs(m.Result)
+	for _, p := range exportedPercentiles {
+		if latency, ok := percentiles[p]; ok {
+			if s.LatencyPercentiles == nil {
+				s.LatencyPercentiles = map[string]float64{}
+			}
+			s.LatencyPercentiles[percentileName(p)] = latency
+		}
+	}
+
+	if verdict := m.GetVerdict(); verdict != nil {
+		s.SLOVerdict = verdict.Status
+	}
+
+	return s
+}
+
+func percentileName(p float64) string {
+	return "p" + strconv.FormatFloat(p, 'f', -1, 64)
+}
+
+// ExportPerformanceResults writes the results in the format. The JUnit report checks the
+// results against the SLO of the profile, or the SLO they were run with if there is no profile.
+func ExportPerformanceResults(w io.Writer, format PerformanceExportFormat, results []*MesheryResult, profile *PerformanceProfile) error {
+	switch format {
+	case PerformanceExportCSV:
+		return exportResultsCSV(w, results)
+	case PerformanceExportJSONL:
+		return exportResultsJSONL(w, results)
+	case PerformanceExportHDR:
+		return exportResultsHDRLog(w, results)
+	case PerformanceExportJUnit:
+		return exportResultsJUnit(w, results, profile)
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+func exportResultsCSV(w io.Writer, results []*MesheryResult) error {
+	header := []string{
+		"result_id", "name", "mesh", "performance_profile", "test_start_time", "load_generator", "url",
+		"requested_qps", "actual_qps", "duration_s", "requests", "error_rate",
+		"latency_min_ms", "latency_avg_ms",
+	}
+	for _, p := range exportedPercentiles {
+		header = append(header, "latency_"+percentileName(p)+"_ms")
+	}
+	header = append(header, "latency_max_ms", "slo_verdict")
+
+	formatFloat := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, m := range results {
+		s := SummarizePerformanceResult(m)
+		startTime := ""
+		if s.TestStartTime != nil {
+			startTime = s.TestStartTime.UTC().Format(time.RFC3339)
+		}
+		record := []string{
+			s.ResultID, s.Name, s.Mesh, s.PerformanceProfile, startTime, s.LoadGenerator, s.URL,
+			s.RequestedQPS, formatFloat(s.ActualQPS), formatFloat(s.DurationSeconds), strconv.FormatInt(s.Requests, 10), formatFloat(s.ErrorRate),
+			formatFloat(s.LatencyMin), formatFloat(s.LatencyAvg),
+		}
+		for _, p := range exportedPercentiles {
+			latency := ""
+			if l, ok := s.LatencyPercentiles[percentileName(p)]; ok {
+				latency = formatFloat(l)
+			}
+			record = append(record, latency)
+		}
+		record = append(record, formatFloat(s.LatencyMax), string(s.SLOVerdict))
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// performanceResultRecord is a line of the JSON Lines export
+type performanceResultRecord struct {
+	*PerformanceResultSummary
+	RunnerResults map[string]interface{} `json:"runner_results"`
+}
+
+func exportResultsJSONL(w io.Writer, results []*MesheryResult) error {
+	// the encoder ends every value with a newline
+	enc := json.NewEncoder(w)
+	for _, m := range results {
+		if err := enc.Encode(performanceResultRecord{
+			PerformanceResultSummary: SummarizePerformanceResult(m),
+			RunnerResults:            m.Result,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// exportResultsHDRLog writes a HdrHistogram log with an interval for every result, tagged
+// with the id of the result. The latencies are recorded in microseconds and the interval
+// maximums are in milliseconds.
+func exportResultsHDRLog(w io.Writer, results []*MesheryResult) error {
+	summaries := make([]*PerformanceResultSummary, 0, len(results))
+	histograms := map[string]*hdrHistogram{}
+	var base time.Time
+	for _, m := range results {
+		s := SummarizePerformanceResult(m)
+		h := newHDRHistogram()
+		if rh, err := resultHistogram(m.Result); err == nil {
+			for _, b := range rh.buckets {
+				h.record(int64((b.start+b.end)/2*1e6+0.5), int64(b.count))
+			}
+		}
+		summaries = append(summaries, s)
+		histograms[s.ResultID] = h
+		if s.TestStartTime != nil && (base.IsZero() || s.TestStartTime.Before(base)) {
+			base = *s.TestStartTime
+		}
+	}
+	if base.IsZero() {
+		base = time.Now()
+	}
+	// the intervals of a log are in the order they started in
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].TestStartTime == nil || summaries[j].TestStartTime == nil {
+			return summaries[j].TestStartTime == nil && summaries[i].TestStartTime != nil
+		}
+		return summaries[i].TestStartTime.Before(*summaries[j].TestStartTime)
+	})
+
+	baseSeconds := float64(base.UnixNano()) / 1e9
+	if _, err := fmt.Fprintf(w, "#[Histogram log format version 1.3]\n#[StartTime: %.3f (seconds since epoch), %s]\n\"StartTimestamp\",\"Interval_Length\",\"Interval_Max\",\"Interval_Compressed_Histogram\"\n",
+		baseSeconds, base.UTC().Format(time.UnixDate)); err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		encoded, err := histograms[s.ResultID].encode()
+		if err != nil {
+			return err
+		}
+		var start float64
+		if s.TestStartTime != nil {
+			start = s.TestStartTime.Sub(base).Seconds()
+		}
+		if _, err := fmt.Fprintf(w, "Tag=%s,%.3f,%.3f,%.3f,%s\n", s.ResultID, start, s.DurationSeconds, s.LatencyMax, encoded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name       string          `xml:"name,attr"`
+	ID         string          `xml:"id,attr,omitempty"`
+	Timestamp  string          `xml:"timestamp,attr,omitempty"`
+	Tests      int             `xml:"tests,attr"`
+	Failures   int             `xml:"failures,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Cases      []junitTestCase `xml:"testcase"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// exportResultsJUnit writes a test suite for every result with a test case for every threshold
+// of the SLO, a result without any SLO has a single test case which passes
+func exportResultsJUnit(w io.Writer, results []*MesheryResult, profile *PerformanceProfile) error {
+	report := junitTestSuites{Name: "meshery performance"}
+	if profile != nil && profile.Name != "" {
+		report.Name = profile.Name
+	}
+
+	var total float64
+	for _, m := range results {
+		s := SummarizePerformanceResult(m)
+		seconds := strconv.FormatFloat(s.DurationSeconds, 'f', 3, 64)
+		total += s.DurationSeconds
+		summary, _ := json.Marshal(s)
+
+		slo := PerformanceSLO{}
+		if profile != nil && profile.SLO != nil {
+			slo = *profile.SLO
+		} else if verdict := m.GetVerdict(); verdict != nil {
+			slo = verdict.SLO
+		}
+
+		suite := junitTestSuite{
+			Name: s.Name,
+			ID:   s.ResultID,
+			Time: seconds,
+			Properties: []junitProperty{
+				{Name: "url", Value: s.URL},
+				{Name: "load_generator", Value: s.LoadGenerator},
+				{Name: "mesh", Value: s.Mesh},
+				{Name: "requested_qps", Value: s.RequestedQPS},
+				{Name: "actual_qps", Value: strconv.FormatFloat(s.ActualQPS, 'f', -1, 64)},
+				{Name: "requests", Value: strconv.FormatInt(s.Requests, 10)},
+			},
+		}
+		if s.TestStartTime != nil {
+			suite.Timestamp = s.TestStartTime.UTC().Format("2006-01-02T15:04:05")
+		}
+
+		thresholds := slo.thresholds()
+		if len(thresholds) == 0 {
+			suite.Cases = append(suite.Cases, junitTestCase{Name: "load test", Classname: report.Name, Time: seconds, SystemOut: string(summary)})
+		}
+		breaches := map[string]SLOBreach{}
+		for _, b := range slo.Evaluate(m.Result).Breaches {
+			breaches[b.Metric] = b
+		}
+		for _, t := range thresholds {
+			tc := junitTestCase{Name: t.name, Classname: report.Name, Time: seconds}
+			if b, ok := breaches[t.metric]; ok {
+				tc.Failure = &junitFailure{Message: b.Message, Type: "SLOBreach", Text: string(summary)}
+				suite.Failures++
+			}
+			suite.Cases = append(suite.Cases, tc)
+		}
+		suite.Tests = len(suite.Cases)
+
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Suites = append(report.Suites, suite)
+	}
+	report.Time = strconv.FormatFloat(total, 'f', 3, 64)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,146 @@
+package models
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"time"
+)
+
+const (
+	// hdrEncodingCookie and hdrCompressedEncodingCookie mark the V2 encoding of HdrHistogram
+	hdrEncodingCookie           = 0x1c849303 | 0x10
+	hdrCompressedEncodingCookie = 0x1c849304 | 0x10
+
+	hdrSignificantFigures = 3
+	// the values are latencies in microseconds from 1µs up to an hour
+	hdrLowestTrackableValue  = 1
+	hdrHighestTrackableValue = int64(time.Hour / time.Microsecond)
+)
+
+// hdrHistogram is the subset of a HdrHistogram needed to encode it, it has the layout of
+// the counts of the HdrHistogram libraries so that they can decode it
+type hdrHistogram struct {
+	subBucketHalfCountMagnitude int
+	subBucketHalfCount          int64
+	subBucketMask               int64
+	counts                      []int64
+	maxIndex                    int
+}
+
+func newHDRHistogram() *hdrHistogram {
+	// values are tracked with a resolution of 1 up to 2 * 10^significant figures
+	largestValueWithSingleUnitResolution := 2 * math.Pow10(hdrSignificantFigures)
+	subBucketCountMagnitude := int(math.Ceil(math.Log2(largestValueWithSingleUnitResolution)))
+	subBucketCount := int64(1) << subBucketCountMagnitude
+
+	bucketCount := 1
+	for smallestUntrackableValue := subBucketCount; smallestUntrackableValue <= hdrHighestTrackableValue; smallestUntrackableValue <<= 1 {
+		bucketCount++
+	}
+
+	return &hdrHistogram{
+		subBucketHalfCountMagnitude: subBucketCountMagnitude - 1,
+		subBucketHalfCount:          subBucketCount / 2,
+		subBucketMask:               subBucketCount - 1,
+		counts:                      make([]int64, (bucketCount+1)*int(subBucketCount/2)),
+		maxIndex:                    -1,
+	}
+}
+
+// index returns the index of the count of the value
+func (h *hdrHistogram) index(v int64) int {
+	bucket := 63 - h.subBucketHalfCountMagnitude - bits.LeadingZeros64(uint64(v|h.subBucketMask))
+	subBucket := v >> uint(bucket)
+
+	return (bucket+1)<<h.subBucketHalfCountMagnitude + int(subBucket-h.subBucketHalfCount)
+}
+
+// record records n occurrences of the value, values out of range are clamped
+func (h *hdrHistogram) record(v, n int64) {
+	if n <= 0 {
+		return
+	}
+	if v < hdrLowestTrackableValue {
+		v = hdrLowestTrackableValue
+	}
+	if v > hdrHighestTrackableValue {
+		v = hdrHighestTrackableValue
+	}
+
+	i := h.index(v)
+	h.counts[i] += n
+	if i > h.maxIndex {
+		h.maxIndex = i
+	}
+}
+
+// encode returns the base64 of the compressed V2 encoding of the histogram, the format of
+// the intervals of a HdrHistogram log
+func (h *hdrHistogram) encode() (string, error) {
+	// the counts are zig zag LEB128 encoded, a run of zeros is encoded as its negated length
+	payload := &bytes.Buffer{}
+	for i := 0; i <= h.maxIndex; {
+		count := h.counts[i]
+		i++
+		if count == 0 {
+			zeros := int64(1)
+			for i <= h.maxIndex && h.counts[i] == 0 {
+				zeros++
+				i++
+			}
+			if zeros > 1 {
+				putZigZag(payload, -zeros)
+				continue
+			}
+		}
+		putZigZag(payload, count)
+	}
+
+	encoded := &bytes.Buffer{}
+	for _, v := range []interface{}{
+		int32(hdrEncodingCookie),
+		int32(payload.Len()),
+		int32(0), // normalizing index offset
+		int32(hdrSignificantFigures),
+		int64(hdrLowestTrackableValue),
+		hdrHighestTrackableValue,
+		1.0, // integer to double value conversion ratio
+	} {
+		if err := binary.Write(encoded, binary.BigEndian, v); err != nil {
+			return "", err
+		}
+	}
+	encoded.Write(payload.Bytes())
+
+	compressed := &bytes.Buffer{}
+	zw := zlib.NewWriter(compressed)
+	if _, err := zw.Write(encoded.Bytes()); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	res := &bytes.Buffer{}
+	for _, v := range []int32{hdrCompressedEncodingCookie, int32(compressed.Len())} {
+		if err := binary.Write(res, binary.BigEndian, v); err != nil {
+			return "", err
+		}
+	}
+	res.Write(compressed.Bytes())
+
+	return base64.StdEncoding.EncodeToString(res.Bytes()), nil
+}
+
+func putZigZag(buf *bytes.Buffer, v int64) {
+	u := uint64(v<<1) ^ uint64(v>>63)
+	for u >= 0x80 {
+		buf.WriteByte(byte(u) | 0x80)
+		u >>= 7
+	}
+	buf.WriteByte(byte(u))
+}
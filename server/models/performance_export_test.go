@@ -0,0 +1,167 @@
+package models
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+func testExportResults(t *testing.T) []*MesheryResult {
+	results := []*MesheryResult{}
+	for i, r := range []string{testSlowerRunnerResults, testBaseRunnerResults} {
+		m := &MesheryResult{Name: "result", Mesh: "istio"}
+		if err := json.Unmarshal([]byte(r), &m.Result); err != nil {
+			t.Fatal(err)
+		}
+		m.ID, _ = uuid.NewV4()
+		start := time.Date(2022, 3, 1, 10, i, 0, 0, time.UTC)
+		m.TestStartTime = &start
+		m.Result["ActualDuration"] = float64(30 * time.Second)
+		results = append(results, m)
+	}
+
+	return results
+}
+
+func TestExportPerformanceResultsCSV(t *testing.T) {
+	b := &bytes.Buffer{}
+	if err := ExportPerformanceResults(b, PerformanceExportCSV, testExportResults(t), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(b).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("exported %d records, want a header and 2 results", len(records))
+	}
+	row := map[string]string{}
+	for i, column := range records[1] {
+		row[records[0][i]] = column
+	}
+	if row["latency_p99_ms"] != "80" || row["latency_p75_ms"] != "" || row["error_rate"] != "0.05" || row["duration_s"] != "30" {
+		t.Errorf("exported row %v", row)
+	}
+}
+
+func TestExportPerformanceResultsJSONL(t *testing.T) {
+	b := &bytes.Buffer{}
+	if err := ExportPerformanceResults(b, PerformanceExportJSONL, testExportResults(t), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("exported %d lines, want 2", len(lines))
+	}
+	record := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatal(err)
+	}
+	if record["actual_qps"] != 50.0 || record["runner_results"] == nil {
+		t.Errorf("exported record %v", record)
+	}
+}
+
+func TestExportPerformanceResultsJUnit(t *testing.T) {
+	profile := &PerformanceProfile{Name: "checkout", SLO: &PerformanceSLO{P99LatencyMs: 50, MaxErrorRate: 0.01}}
+	b := &bytes.Buffer{}
+	if err := ExportPerformanceResults(b, PerformanceExportJUnit, testExportResults(t), profile); err != nil {
+		t.Fatal(err)
+	}
+
+	report := junitTestSuites{}
+	if err := xml.Unmarshal(b.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Name != "checkout" || report.Tests != 4 || report.Failures != 2 || len(report.Suites) != 2 {
+		t.Fatalf("exported report %+v, want the slower result to breach both thresholds", report)
+	}
+	if f := report.Suites[0].Cases[0].Failure; f == nil || !strings.Contains(f.Message, "p99 latency") {
+		t.Errorf("exported test case %+v", report.Suites[0].Cases[0])
+	}
+	if report.Suites[1].Failures != 0 {
+		t.Errorf("exported test suite %+v, want the base result to meet the SLO", report.Suites[1])
+	}
+}
+
+func TestExportPerformanceResultsHDR(t *testing.T) {
+	b := &bytes.Buffer{}
+	if err := ExportPerformanceResults(b, PerformanceExportHDR, testExportResults(t), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[1], "#[StartTime: 1646128800.000") {
+		t.Fatalf("exported log %q", b.String())
+	}
+	// the intervals are in the order the results started in
+	fields := strings.Split(lines[3], ",")
+	if len(fields) != 5 || fields[1] != "0.000" || fields[2] != "30.000" {
+		t.Fatalf("exported interval %q", lines[3])
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(fields[4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie := binary.BigEndian.Uint32(raw); cookie != hdrCompressedEncodingCookie {
+		t.Fatalf("compressed encoding cookie %x", cookie)
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(raw[8:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cookie := binary.BigEndian.Uint32(encoded); cookie != hdrEncodingCookie || int(binary.BigEndian.Uint32(encoded[4:])) != len(encoded)-40 {
+		t.Fatalf("encoding header %x", encoded[:40])
+	}
+
+	// decode the counts to check the total and the value of the highest one
+	h := newHDRHistogram()
+	var total int64
+	index := 0
+	payload := bytes.NewReader(encoded[40:])
+	for payload.Len() > 0 {
+		u, err := binary.ReadUvarint(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v := int64(u>>1) ^ -int64(u&1)
+		if v < 0 {
+			index += int(-v)
+			continue
+		}
+		total += v
+		index++
+	}
+	if total != 1000 {
+		t.Errorf("decoded %d values, want 1000", total)
+	}
+	if want := h.index(75000) + 1; index != want {
+		t.Errorf("decoded %d counts, want %d up to the midpoint of the slowest bucket", index, want)
+	}
+}
+
+func TestHDRHistogramIndex(t *testing.T) {
+	h := newHDRHistogram()
+	for v, want := range map[int64]int{1: 1, 2047: 2047, 2048: 2048, 3000: 2524, 4096: 3072} {
+		if got := h.index(v); got != want {
+			t.Errorf("index(%d) = %d, want %d", v, got, want)
+		}
+	}
+}
@@ -82,6 +82,14 @@ func (ppp *PerformanceProfilePersister) GetPerformanceProfile(id uuid.UUID) (*Pe
 	return &performanceProfile, err
 }
 
+// GetScheduledPerformanceProfiles returns the performance profiles which are linked to a schedule
+func (ppp *PerformanceProfilePersister) GetScheduledPerformanceProfiles() ([]*PerformanceProfile, error) {
+	profiles := []*PerformanceProfile{}
+
+	err := ppp.DB.Where("schedule IS NOT NULL").Find(&profiles).Error
+	return profiles, err
+}
+
 func marshalPerformanceProfilePage(ppp *PerformanceProfilePage) []byte {
 	res, _ := json.Marshal(ppp)
 
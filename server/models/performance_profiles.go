@@ -28,6 +28,27 @@ type PerformanceProfile struct {
 	RequestCookies string `json:"request_cookies,omitempty"`
 	RequestBody    string `json:"request_body,omitempty"`
 	ContentType    string `json:"content_type,omitempty"`
+	// PayloadSize is the size in bytes of a random payload sent instead of the request body,
+	// it is echoed back by the targets of TCP and UDP load tests
+	PayloadSize int `json:"payload_size,omitempty"`
+	// DisableConnectionReuse opens a new connection for every request of the load tests
+	DisableConnectionReuse bool `json:"disable_connection_reuse,omitempty"`
+
+	// Scenario lists the endpoints driven at once, each with its own request and traffic weight
+	Scenario LoadTestEndpoints `json:"scenario,omitempty" gorm:"type:text"`
+	// Stages are run back to back instead of a single constant load when set
+	Stages LoadTestStages `json:"stages,omitempty" gorm:"type:text"`
+	// Workers is the number of load test workers the load is fanned out to, the load
+	// is generated by the server when 0
+	Workers int `json:"workers,omitempty"`
+
+	// SLO holds the thresholds each result of the profile is evaluated against
+	SLO *PerformanceSLO `json:"slo,omitempty" gorm:"embedded;embeddedPrefix:slo_"`
+	// BaselineResult is the pinned result every new result of the profile is compared against
+	BaselineResult *uuid.UUID `json:"baseline_result,omitempty"`
+	// RegressionThreshold is the relative increase in percent of the mean or the p99 latency over
+	// the baseline which is a regression, DefaultRegressionThreshold if unset
+	RegressionThreshold float64 `json:"regression_threshold,omitempty"`
 
 	UpdatedAt *sql.Time `json:"updated_at,omitempty"`
 	CreatedAt *sql.Time `json:"created_at,omitempty"`
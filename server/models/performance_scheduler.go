@@ -0,0 +1,235 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/logger"
+)
+
+const (
+	// schedulerTickInterval is the resolution at which schedules are activated
+	schedulerTickInterval = time.Second
+
+	// schedulerReloadInterval is the interval after which the schedules are
+	// reloaded from the database even if no change was signalled
+	schedulerReloadInterval = 30 * time.Second
+)
+
+// ScheduledLoadTestRunner runs the given performance profile for the schedule and
+// returns the ids of the results which were persisted for the run
+type ScheduledLoadTestRunner func(ctx context.Context, provider Provider, schedule *Schedule, profile *PerformanceProfile) ([]string, error)
+
+// PerformanceScheduler activates the performance profiles linked to a schedule
+// whenever their cron expression fires. Schedules and runs are persisted with
+// the local provider so that the scheduler picks them up again after a restart.
+type PerformanceScheduler struct {
+	log       logger.Handler
+	provider  Provider
+	schedules *SchedulePersister
+	profiles  *PerformanceProfilePersister
+	run       ScheduledLoadTestRunner
+
+	backgroundWorker
+	entries map[uuid.UUID]*scheduleEntry
+}
+
+// scheduleEntry tracks the next activation of a scheduled performance profile
+type scheduleEntry struct {
+	profileID  uuid.UUID
+	scheduleID uuid.UUID
+	expression string
+	next       time.Time
+	schedule   Schedule
+}
+
+// NewPerformanceScheduler returns an instance of PerformanceScheduler
+func NewPerformanceScheduler(
+	log logger.Handler,
+	provider Provider,
+	schedules *SchedulePersister,
+	profiles *PerformanceProfilePersister,
+	run ScheduledLoadTestRunner,
+) *PerformanceScheduler {
+	return &PerformanceScheduler{
+		log:              log,
+		provider:         provider,
+		schedules:        schedules,
+		profiles:         profiles,
+		run:              run,
+		backgroundWorker: newBackgroundWorker(schedulerTickInterval, schedulerReloadInterval),
+		entries:          map[uuid.UUID]*scheduleEntry{},
+	}
+}
+
+// Start starts the scheduler loop, the loop stops when the context is cancelled
+func (ps *PerformanceScheduler) Start(ctx context.Context) {
+	if err := ps.schedules.AbortRunningScheduleRuns(); err != nil {
+		ps.log.Error(ErrScheduledRun(err, "unknown"))
+	}
+
+	go ps.loop(ctx, func(now time.Time) {
+		if err := ps.reload(now); err != nil {
+			ps.log.Error(ErrScheduledRun(err, "unknown"))
+		}
+	}, ps.dispatch)
+}
+
+// Refresh signals the scheduler to reload the schedules from the database
+func (ps *PerformanceScheduler) Refresh() {
+	if ps == nil {
+		return
+	}
+
+	ps.refresh()
+}
+
+// Wait blocks until all the in-flight runs have finished
+func (ps *PerformanceScheduler) Wait() {
+	ps.wait()
+}
+
+// reload syncs the tracked entries with the scheduled performance profiles
+// persisted in the database, the next activation of unchanged entries is kept
+func (ps *PerformanceScheduler) reload(now time.Time) error {
+	profiles, err := ps.profiles.GetScheduledPerformanceProfiles()
+	if err != nil {
+		return err
+	}
+
+	schedules, err := ps.schedules.GetAllSchedules()
+	if err != nil {
+		return err
+	}
+	byID := map[uuid.UUID]Schedule{}
+	for _, s := range schedules {
+		if s.ID != nil {
+			byID[*s.ID] = s
+		}
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	seen := map[uuid.UUID]bool{}
+	for _, profile := range profiles {
+		if profile.ID == nil || profile.Schedule == nil {
+			continue
+		}
+
+		schedule, ok := byID[*profile.Schedule]
+		if !ok {
+			continue
+		}
+		expr := schedule.CronExpression
+		seen[*profile.ID] = true
+
+		entry, ok := ps.entries[*profile.ID]
+		if ok && entry.scheduleID == *profile.Schedule && entry.expression == expr {
+			entry.schedule = schedule
+			continue
+		}
+
+		sched, err := ParseCronExpression(expr)
+		if err != nil {
+			ps.log.Error(err)
+			delete(ps.entries, *profile.ID)
+			continue
+		}
+
+		ps.entries[*profile.ID] = &scheduleEntry{
+			profileID:  *profile.ID,
+			scheduleID: *profile.Schedule,
+			expression: expr,
+			next:       sched.Next(now),
+			schedule:   schedule,
+		}
+	}
+
+	for id := range ps.entries {
+		if !seen[id] {
+			delete(ps.entries, id)
+		}
+	}
+
+	return nil
+}
+
+// dispatch runs every entry whose activation time has passed
+func (ps *PerformanceScheduler) dispatch(ctx context.Context, now time.Time) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	for _, entry := range ps.entries {
+		if now.Before(entry.next) {
+			continue
+		}
+
+		sched, err := ParseCronExpression(entry.expression)
+		if err != nil {
+			ps.log.Error(err)
+			continue
+		}
+		entry.next = sched.Next(now)
+
+		e := *entry
+		if !ps.start(e.profileID, func() { ps.execute(ctx, e, now) }) {
+			ps.recordSkippedRun(entry, now)
+		}
+	}
+}
+
+func (ps *PerformanceScheduler) execute(ctx context.Context, entry scheduleEntry, startedAt time.Time) {
+	run := &ScheduleRun{
+		ScheduleID:         &entry.scheduleID,
+		PerformanceProfile: &entry.profileID,
+		Status:             ScheduleRunRunning,
+		StartedAt:          &startedAt,
+	}
+	if err := ps.schedules.SaveScheduleRun(run); err != nil {
+		ps.log.Error(ErrScheduledRun(err, entry.profileID.String()))
+	}
+
+	var resultIDs []string
+	profile, err := ps.profiles.GetPerformanceProfile(entry.profileID)
+	if err == nil {
+		ps.log.Info("running scheduled performance profile: ", profile.Name)
+		resultIDs, err = ps.run(ctx, ps.provider, &entry.schedule, profile)
+	}
+
+	finishedAt := time.Now()
+	run.FinishedAt = &finishedAt
+	run.ResultIDs = strings.Join(resultIDs, ",")
+	if err != nil {
+		err = ErrScheduledRun(err, entry.profileID.String())
+		ps.log.Error(err)
+		run.Status = ScheduleRunFailed
+		run.Message = err.Error()
+	} else {
+		run.Status = ScheduleRunSuccess
+	}
+
+	if err := ps.schedules.SaveScheduleRun(run); err != nil {
+		ps.log.Error(ErrScheduledRun(err, entry.profileID.String()))
+	}
+}
+
+func (ps *PerformanceScheduler) recordSkippedRun(entry *scheduleEntry, at time.Time) {
+	const msg = "previous run of the performance profile is still in progress"
+	ps.log.Warn(ErrScheduledRun(fmt.Errorf(msg), entry.profileID.String()))
+
+	run := &ScheduleRun{
+		ScheduleID:         &entry.scheduleID,
+		PerformanceProfile: &entry.profileID,
+		Status:             ScheduleRunSkipped,
+		Message:            msg,
+		StartedAt:          &at,
+		FinishedAt:         &at,
+	}
+	if err := ps.schedules.SaveScheduleRun(run); err != nil {
+		ps.log.Error(ErrScheduledRun(err, entry.profileID.String()))
+	}
+}
@@ -0,0 +1,236 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PerformanceSLOVerdictKey is the key of the runner results under which the verdict is stored
+const PerformanceSLOVerdictKey = "slo-verdict"
+
+// PerformanceSLO holds the thresholds which the results of a performance profile
+// should meet, a threshold of zero is not checked
+type PerformanceSLO struct {
+	// Latencies are the maximum latencies in milliseconds
+	P50LatencyMs float64 `json:"p50_latency_ms,omitempty"`
+	P90LatencyMs float64 `json:"p90_latency_ms,omitempty"`
+	P99LatencyMs float64 `json:"p99_latency_ms,omitempty"`
+	// MaxErrorRate is the highest share of the requests which may fail, between 0 and 1
+	MaxErrorRate float64 `json:"max_error_rate,omitempty"`
+	// MinQPS is the lowest number of queries per second which the test should achieve
+	MinQPS float64 `json:"min_qps,omitempty"`
+}
+
+// IsEmpty checks if none of the thresholds are set
+func (slo PerformanceSLO) IsEmpty() bool {
+	return slo == PerformanceSLO{}
+}
+
+// Validate checks that the thresholds are within range
+func (slo PerformanceSLO) Validate() error {
+	if slo.P50LatencyMs < 0 || slo.P90LatencyMs < 0 || slo.P99LatencyMs < 0 || slo.MinQPS < 0 {
+		return fmt.Errorf("slo thresholds can't be negative")
+	}
+	if slo.MaxErrorRate < 0 || slo.MaxErrorRate > 1 {
+		return fmt.Errorf("max error rate should be between 0 and 1")
+	}
+
+	return nil
+}
+
+// sloThreshold is a threshold of the SLO along with the metric its breaches are reported under
+type sloThreshold struct {
+	metric string
+	name   string
+}
+
+// thresholds returns the thresholds which are set
+func (slo PerformanceSLO) thresholds() []sloThreshold {
+	res := []sloThreshold{}
+	for _, l := range []struct {
+		percentile float64
+		threshold  float64
+	}{{50, slo.P50LatencyMs}, {90, slo.P90LatencyMs}, {99, slo.P99LatencyMs}} {
+		if l.threshold > 0 {
+			res = append(res, sloThreshold{fmt.Sprintf("p%g_latency_ms", l.percentile), fmt.Sprintf("p%g latency <= %gms", l.percentile, l.threshold)})
+		}
+	}
+	if slo.MaxErrorRate > 0 {
+		res = append(res, sloThreshold{"error_rate", fmt.Sprintf("error rate <= %g", slo.MaxErrorRate)})
+	}
+	if slo.MinQPS > 0 {
+		res = append(res, sloThreshold{"qps", fmt.Sprintf("qps >= %g", slo.MinQPS)})
+	}
+
+	return res
+}
+
+// PerformanceVerdictStatus is the outcome of evaluating a result against the SLO
+type PerformanceVerdictStatus string
+
+const (
+	PerformanceVerdictPass PerformanceVerdictStatus = "pass"
+	PerformanceVerdictFail PerformanceVerdictStatus = "fail"
+)
+
+// SLOBreach is a single threshold which the result did not meet
+type SLOBreach struct {
+	Metric    string  `json:"metric"`
+	Threshold float64 `json:"threshold"`
+	Actual    float64 `json:"actual"`
+	Message   string  `json:"message"`
+}
+
+// PerformanceVerdict is the outcome of evaluating a result against the SLO of its profile
+type PerformanceVerdict struct {
+	Status   PerformanceVerdictStatus `json:"status"`
+	SLO      PerformanceSLO           `json:"slo"`
+	Breaches []SLOBreach              `json:"breaches,omitempty"`
+}
+
+// Evaluate checks the runner results against the thresholds. The results are in
+// the format of the fortio runner results which all the load generators report in.
+func (slo PerformanceSLO) Evaluate(results map[string]interface{}) *PerformanceVerdict {
+	verdict := &PerformanceVerdict{
+		Status:   PerformanceVerdictPass,
+		SLO:      slo,
+		Breaches: []SLOBreach{},
+	}
+
+	breach := func(metric string, threshold, actual float64, msg string) {
+		verdict.Breaches = append(verdict.Breaches, SLOBreach{
+			Metric:    metric,
+			Threshold: threshold,
+			Actual:    actual,
+			Message:   msg,
+		})
+	}
+
+	latencies := []struct {
+		metric     string
+		percentile float64
+		threshold  float64
+	}{
+		{"p50_latency_ms", 50, slo.P50LatencyMs},
+		{"p90_latency_ms", 90, slo.P90LatencyMs},
+		{"p99_latency_ms", 99, slo.P99LatencyMs},
+	}
+	percentiles := resultPercentilesMs(results)
+	for _, l := range latencies {
+		if l.threshold <= 0 {
+			continue
+		}
+
+		actual, ok := percentiles[l.percentile]
+		if !ok {
+			breach(l.metric, l.threshold, 0, fmt.Sprintf("p%g latency is missing from the results", l.percentile))
+			continue
+		}
+		if actual > l.threshold {
+			breach(l.metric, l.threshold, actual, fmt.Sprintf("p%g latency %.2fms exceeds %gms", l.percentile, actual, l.threshold))
+		}
+	}
+
+	if slo.MaxErrorRate > 0 {
+		if actual := resultErrorRate(results); actual > slo.MaxErrorRate {
+			breach("error_rate", slo.MaxErrorRate, actual, fmt.Sprintf("error rate %.4f exceeds %g", actual, slo.MaxErrorRate))
+		}
+	}
+
+	if slo.MinQPS > 0 {
+		actual, _ := results["ActualQPS"].(float64)
+		if actual < slo.MinQPS {
+			breach("qps", slo.MinQPS, actual, fmt.Sprintf("achieved %.2f qps, below %g", actual, slo.MinQPS))
+		}
+	}
+
+	if len(verdict.Breaches) > 0 {
+		verdict.Status = PerformanceVerdictFail
+	}
+
+	return verdict
+}
+
+// resultPercentilesMs returns the latencies of the percentiles in milliseconds
+func resultPercentilesMs(results map[string]interface{}) map[float64]float64 {
+	res := map[float64]float64{}
+
+	histogram, _ := results["DurationHistogram"].(map[string]interface{})
+	percentiles, _ := histogram["Percentiles"].([]interface{})
+	for _, p := range percentiles {
+		p, _ := p.(map[string]interface{})
+		pc, ok := p["Percentile"].(float64)
+		if !ok {
+			continue
+		}
+		if value, ok := p["Value"].(float64); ok {
+			// fortio reports the latencies in seconds
+			res[pc] = value * 1000
+		}
+	}
+
+	return res
+}
+
+// resultErrorRate returns the share of the requests which did not succeed. Requests
+// succeed with a 2xx status code, SERVING for the gRPC health checks or OK for the
+// TCP and UDP echo requests.
+func resultErrorRate(results map[string]interface{}) float64 {
+	var total, failed float64
+	switch retCodes := results["RetCodes"].(type) {
+	case map[string]interface{}:
+		for code, count := range retCodes {
+			n, _ := count.(float64)
+			total += n
+			if !isSuccessRetCode(code) {
+				failed += n
+			}
+		}
+	case map[int]int64:
+		for code, count := range retCodes {
+			total += float64(count)
+			if code < 200 || code > 299 {
+				failed += float64(count)
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+
+	return failed / total
+}
+
+func isSuccessRetCode(code string) bool {
+	if strings.EqualFold(code, "SERVING") || code == "OK" {
+		return true
+	}
+
+	c, err := strconv.Atoi(code)
+	return err == nil && c >= 200 && c <= 299
+}
+
+// GetVerdict returns the verdict stored along with the runner results, if any
+func (m *MesheryResult) GetVerdict() *PerformanceVerdict {
+	v, ok := m.Result[PerformanceSLOVerdictKey]
+	if !ok {
+		return nil
+	}
+
+	if verdict, ok := v.(*PerformanceVerdict); ok {
+		return verdict
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	verdict := &PerformanceVerdict{}
+	if err := json.Unmarshal(b, verdict); err != nil {
+		return nil
+	}
+
+	return verdict
+}
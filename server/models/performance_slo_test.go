@@ -0,0 +1,116 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testRunnerResults = `{
+	"ActualQPS": 48.5,
+	"DurationHistogram": {
+		"Percentiles": [
+			{"Percentile": 50, "Value": 0.012},
+			{"Percentile": 90, "Value": 0.045},
+			{"Percentile": 99, "Value": 0.180}
+		]
+	},
+	"RetCodes": {"200": 95, "503": 5}
+}`
+
+func TestPerformanceSLOEvaluate(t *testing.T) {
+	results := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(testRunnerResults), &results); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		slo         PerformanceSLO
+		wantStatus  PerformanceVerdictStatus
+		wantMetrics []string
+	}{
+		{
+			name:       "no thresholds",
+			wantStatus: PerformanceVerdictPass,
+		},
+		{
+			name: "all thresholds met",
+			slo: PerformanceSLO{
+				P50LatencyMs: 20,
+				P90LatencyMs: 50,
+				P99LatencyMs: 200,
+				MaxErrorRate: 0.1,
+				MinQPS:       45,
+			},
+			wantStatus: PerformanceVerdictPass,
+		},
+		{
+			name: "latency and error rate breached",
+			slo: PerformanceSLO{
+				P50LatencyMs: 20,
+				P99LatencyMs: 100,
+				MaxErrorRate: 0.01,
+			},
+			wantStatus:  PerformanceVerdictFail,
+			wantMetrics: []string{"p99_latency_ms", "error_rate"},
+		},
+		{
+			name:        "qps below the minimum",
+			slo:         PerformanceSLO{MinQPS: 50},
+			wantStatus:  PerformanceVerdictFail,
+			wantMetrics: []string{"qps"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.slo.Evaluate(results)
+			if got.Status != tt.wantStatus {
+				t.Errorf("Evaluate() status = %s, want %s", got.Status, tt.wantStatus)
+			}
+
+			metrics := []string{}
+			for _, b := range got.Breaches {
+				metrics = append(metrics, b.Metric)
+			}
+			if len(metrics) != len(tt.wantMetrics) {
+				t.Fatalf("Evaluate() breaches = %v, want %v", metrics, tt.wantMetrics)
+			}
+			for i := range metrics {
+				if metrics[i] != tt.wantMetrics[i] {
+					t.Errorf("Evaluate() breaches = %v, want %v", metrics, tt.wantMetrics)
+				}
+			}
+		})
+	}
+}
+
+func TestMesheryResultGetVerdict(t *testing.T) {
+	results := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(testRunnerResults), &results); err != nil {
+		t.Fatal(err)
+	}
+	results[PerformanceSLOVerdictKey] = PerformanceSLO{MinQPS: 50}.Evaluate(results)
+
+	// The verdict is read back the same after the result is persisted
+	b, err := json.Marshal(&MesheryResult{Result: results})
+	if err != nil {
+		t.Fatal(err)
+	}
+	persisted := &MesheryResult{}
+	if err := json.Unmarshal(b, persisted); err != nil {
+		t.Fatal(err)
+	}
+
+	verdict := persisted.GetVerdict()
+	if verdict == nil {
+		t.Fatal("GetVerdict() = nil, want the stored verdict")
+	}
+	if verdict.Status != PerformanceVerdictFail || len(verdict.Breaches) != 1 || verdict.SLO.MinQPS != 50 {
+		t.Errorf("GetVerdict() = %+v, want the failed qps verdict", verdict)
+	}
+
+	if (&MesheryResult{Result: map[string]interface{}{}}).GetVerdict() != nil {
+		t.Errorf("GetVerdict() of a result without a verdict is not nil")
+	}
+}
@@ -151,6 +151,10 @@ const (
 
 	PersistMesheryPatternResources Feature = "persist-meshery-pattern-resources" // /patterns/resources
 
+	PersistMesheryPatternDeployments Feature = "persist-meshery-pattern-deployments" // /patterns/deployments
+
+	PersistPatternSyncSources Feature = "persist-pattern-sync-sources" // /patterns/sync
+
 	PersistMesheryFilters Feature = "persist-meshery-filters" // /filter
 
 	PersistMesheryApplications Feature = "persist-meshery-applications" // /applications
@@ -184,6 +188,14 @@ const (
 	// UserCtxKey is the context key for persisting user to context
 	UserCtxKey ContextKey = "user"
 
+	// APITokenCtxKey is the context key for persisting the API token of the request to context
+	APITokenCtxKey ContextKey = "api_token"
+	// AuditEventCtxKey is the context key for persisting the audit event of the request to context
+	AuditEventCtxKey ContextKey = "audit_event"
+	// ScheduledRunUserCtxKey is the context key for persisting the owner of the schedule a
+	// background run is performed for, the local provider authenticates the run as this user
+	ScheduledRunUserCtxKey ContextKey = "scheduled_run_user"
+
 	// UserPrefsCtxKey is the context key for persisting user preferences to context
 	PerfObjCtxKey ContextKey = "perf_obj"
 
@@ -290,6 +302,13 @@ type Provider interface {
 	GetMesheryPatternResource(token, resourceID string) (*PatternResource, error)
 	GetMesheryPatternResources(token, page, pageSize, search, order, name, namespace, typ, oamType string) (*PatternResourcePage, error)
 	DeleteMesheryPatternResource(token, resourceID string) error
+	SavePatternDeployment(tokenString string, deployment *PatternDeployment) ([]byte, error)
+	GetPatternDeployments(tokenString, page, pageSize, order, patternID string) ([]byte, error)
+	GetPatternDeployment(tokenString, deploymentID string) ([]byte, error)
+	SavePatternSyncSource(tokenString string, source *PatternSyncSource) ([]byte, error)
+	GetPatternSyncSources(tokenString, userID, page, pageSize, order string) ([]byte, error)
+	GetPatternSyncSource(tokenString, userID, sourceID string) ([]byte, error)
+	DeletePatternSyncSource(tokenString, userID, sourceID string) ([]byte, error)
 
 	SaveMesheryFilter(tokenString string, filter *MesheryFilter) ([]byte, error)
 	GetMesheryFilters(tokenString, page, pageSize, search, order string) ([]byte, error)
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// rateLimiterIdleTTL is the time after which the limiter of an idle caller is forgotten
+const rateLimiterIdleTTL = 10 * time.Minute
+
+// RateLimiter limits the rate of the requests of every caller with a token bucket,
+// a nil RateLimiter allows every request
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mx        sync.Mutex
+	limiters  map[string]*rateLimiterEntry
+	lastSweep time.Time
+}
+
+type rateLimiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewRateLimiter returns a limiter allowing perMinute requests a minute to every caller,
+// in bursts of at most perMinute requests. It returns nil if perMinute is not positive
+func NewRateLimiter(perMinute int) *RateLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		limit:    rate.Every(time.Minute / time.Duration(perMinute)),
+		burst:    perMinute,
+		limiters: map[string]*rateLimiterEntry{},
+	}
+}
+
+// Allow consumes n requests of the caller, if the caller is over its limit it returns
+// false along with the time to wait before retrying, or 0 if n is over the burst
+func (rl *RateLimiter) Allow(caller string, n int) (bool, time.Duration) {
+	if rl == nil {
+		return true, 0
+	}
+
+	rl.mx.Lock()
+	defer rl.mx.Unlock()
+
+	now := time.Now()
+	if now.Sub(rl.lastSweep) > rateLimiterIdleTTL {
+		for key, entry := range rl.limiters {
+			if now.Sub(entry.lastSeen) > rateLimiterIdleTTL {
+				delete(rl.limiters, key)
+			}
+		}
+		rl.lastSweep = now
+	}
+	entry, ok := rl.limiters[caller]
+	if !ok {
+		entry = &rateLimiterEntry{limiter: rate.NewLimiter(rl.limit, rl.burst)}
+		rl.limiters[caller] = entry
+	}
+	entry.lastSeen = now
+
+	reservation := entry.limiter.ReserveN(now, n)
+	if !reservation.OK() {
+		return false, 0
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return false, delay
+	}
+
+	return true, 0
+}
+
+// Burst returns the most requests a caller can make at once
+func (rl *RateLimiter) Burst() int {
+	if rl == nil {
+		return 0
+	}
+
+	return rl.burst
+}
@@ -0,0 +1,27 @@
+package models
+
+import "testing"
+
+func TestRateLimiter(t *testing.T) {
+	if ok, _ := (*RateLimiter)(nil).Allow("alice", 100); !ok {
+		t.Error("nil limiter limits")
+	}
+	if NewRateLimiter(0) != nil {
+		t.Error("limiter without limit is not nil")
+	}
+
+	rl := NewRateLimiter(3)
+	if ok, _ := rl.Allow("alice", 2); !ok {
+		t.Error("first requests are limited")
+	}
+	ok, retryAfter := rl.Allow("alice", 2)
+	if ok || retryAfter <= 0 {
+		t.Errorf("requests over the limit are allowed %t, retry after %s", ok, retryAfter)
+	}
+	if ok, _ := rl.Allow("bob", 3); !ok {
+		t.Error("requests of another caller are limited")
+	}
+	if ok, retryAfter := rl.Allow("carol", 4); ok || retryAfter != 0 {
+		t.Errorf("requests over the burst are allowed %t, retry after %s", ok, retryAfter)
+	}
+}
@@ -1249,6 +1249,287 @@ func (l *RemoteProvider) DeleteMesheryPatternResource(token, resourceID string)
 	return ErrDelete(fmt.Errorf("error while deleting pattern resource"), "pattern: "+resourceID, resp.StatusCode)
 }
 
+// SavePatternDeployment records a deployment of a pattern with the provider
+func (l *RemoteProvider) SavePatternDeployment(tokenString string, deployment *PatternDeployment) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistMesheryPatternDeployments) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistMesheryPatternDeployments", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistMesheryPatternDeployments)
+
+	data, err := json.Marshal(deployment)
+	if err != nil {
+		return nil, ErrMarshal(err, "pattern deployment")
+	}
+
+	logrus.Infof("attempting to save pattern deployment to remote provider")
+	remoteProviderURL, _ := url.Parse(l.RemoteProviderURL + ep)
+	cReq, err := http.NewRequest(http.MethodPost, remoteProviderURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		logrus.Errorf("unable to send pattern deployment: %v", err)
+		return nil, ErrPost(err, "pattern deployment", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "pattern deployment")
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		logrus.Infof("pattern deployment successfully sent to remote provider")
+		return bdr, nil
+	}
+
+	return bdr, ErrPost(fmt.Errorf("failed to send pattern deployment to remote provider: %s", string(bdr)), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// GetPatternDeployments returns the pattern deployments recorded with the provider
+func (l *RemoteProvider) GetPatternDeployments(tokenString, page, pageSize, order, patternID string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistMesheryPatternDeployments) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistMesheryPatternDeployments", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistMesheryPatternDeployments)
+
+	logrus.Infof("attempting to fetch pattern deployments from cloud")
+
+	remoteProviderURL, _ := url.Parse(l.RemoteProviderURL + ep)
+	q := remoteProviderURL.Query()
+	if page != "" {
+		q.Set("page", page)
+	}
+	if pageSize != "" {
+		q.Set("page_size", pageSize)
+	}
+	if order != "" {
+		q.Set("order", order)
+	}
+	if patternID != "" {
+		q.Set("pattern_id", patternID)
+	}
+	remoteProviderURL.RawQuery = q.Encode()
+	logrus.Debugf("constructed pattern deployments url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrFetch(err, "Pattern Deployments Page", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Pattern Deployments Page")
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("pattern deployments successfully retrieved from remote provider")
+		return bdr, nil
+	}
+	return nil, ErrFetch(fmt.Errorf("error while fetching pattern deployments: %s", bdr), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// GetPatternDeployment returns the pattern deployment with the given id
+func (l *RemoteProvider) GetPatternDeployment(tokenString, deploymentID string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistMesheryPatternDeployments) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistMesheryPatternDeployments", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistMesheryPatternDeployments)
+
+	logrus.Infof("attempting to fetch pattern deployment from cloud for id: %s", deploymentID)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, deploymentID))
+	logrus.Debugf("constructed pattern deployment url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrFetch(err, "Pattern Deployment :"+deploymentID, http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Pattern Deployment :"+deploymentID)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("pattern deployment successfully retrieved from remote provider")
+		return bdr, nil
+	}
+	return nil, ErrFetch(fmt.Errorf("could not retrieve pattern deployment from remote provider"), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// SavePatternSyncSource saves a pattern sync source with the provider
+func (l *RemoteProvider) SavePatternSyncSource(tokenString string, source *PatternSyncSource) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistPatternSyncSources) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistPatternSyncSources", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistPatternSyncSources)
+
+	data, err := json.Marshal(source)
+	if err != nil {
+		return nil, ErrMarshal(err, "pattern sync source")
+	}
+
+	logrus.Infof("attempting to save pattern sync source to remote provider")
+	remoteProviderURL, _ := url.Parse(l.RemoteProviderURL + ep)
+	cReq, err := http.NewRequest(http.MethodPost, remoteProviderURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		logrus.Errorf("unable to send pattern sync source: %v", err)
+		return nil, ErrPost(err, "pattern sync source", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "pattern sync source")
+	}
+
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		logrus.Infof("pattern sync source successfully sent to remote provider")
+		return bdr, nil
+	}
+
+	return bdr, ErrPost(fmt.Errorf("failed to send pattern sync source to remote provider: %s", string(bdr)), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// GetPatternSyncSources returns the pattern sync sources stored with the provider,
+// the provider scopes them to the user of the token
+func (l *RemoteProvider) GetPatternSyncSources(tokenString, userID, page, pageSize, order string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistPatternSyncSources) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistPatternSyncSources", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistPatternSyncSources)
+
+	logrus.Infof("attempting to fetch pattern sync sources from cloud")
+
+	remoteProviderURL, _ := url.Parse(l.RemoteProviderURL + ep)
+	q := remoteProviderURL.Query()
+	if page != "" {
+		q.Set("page", page)
+	}
+	if pageSize != "" {
+		q.Set("page_size", pageSize)
+	}
+	if order != "" {
+		q.Set("order", order)
+	}
+	remoteProviderURL.RawQuery = q.Encode()
+	logrus.Debugf("constructed pattern sync sources url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrFetch(err, "Pattern Sync Sources Page", http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Pattern Sync Sources Page")
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("pattern sync sources successfully retrieved from remote provider")
+		return bdr, nil
+	}
+	return nil, ErrFetch(fmt.Errorf("error while fetching pattern sync sources: %s", bdr), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// GetPatternSyncSource returns the pattern sync source with the given id
+func (l *RemoteProvider) GetPatternSyncSource(tokenString, userID, sourceID string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistPatternSyncSources) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistPatternSyncSources", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistPatternSyncSources)
+
+	logrus.Infof("attempting to fetch pattern sync source from cloud for id: %s", sourceID)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, sourceID))
+	logrus.Debugf("constructed pattern sync source url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodGet, remoteProviderURL.String(), nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		return nil, ErrFetch(err, "Pattern Sync Source :"+sourceID, http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Pattern Sync Source :"+sourceID)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("pattern sync source successfully retrieved from remote provider")
+		return bdr, nil
+	}
+	return nil, ErrFetch(fmt.Errorf("could not retrieve pattern sync source from remote provider"), fmt.Sprint(bdr), resp.StatusCode)
+}
+
+// DeletePatternSyncSource deletes the pattern sync source with the given id
+func (l *RemoteProvider) DeletePatternSyncSource(tokenString, userID, sourceID string) ([]byte, error) {
+	if !l.Capabilities.IsSupported(PersistPatternSyncSources) {
+		logrus.Error("operation not available")
+		return nil, ErrInvalidCapability("PersistPatternSyncSources", l.ProviderName)
+	}
+
+	ep, _ := l.Capabilities.GetEndpointForFeature(PersistPatternSyncSources)
+
+	logrus.Infof("attempting to delete pattern sync source from cloud for id: %s", sourceID)
+
+	remoteProviderURL, _ := url.Parse(fmt.Sprintf("%s%s/%s", l.RemoteProviderURL, ep, sourceID))
+	logrus.Debugf("constructed pattern sync source url: %s", remoteProviderURL.String())
+	cReq, _ := http.NewRequest(http.MethodDelete, remoteProviderURL.String(), nil)
+
+	resp, err := l.DoRequest(cReq, tokenString)
+	if err != nil {
+		logrus.Errorf("unable to delete pattern sync source: %v", err)
+		return nil, ErrDelete(err, "Pattern Sync Source :"+sourceID, http.StatusInternalServerError)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	bdr, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ErrDataRead(err, "Pattern Sync Source :"+sourceID)
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		logrus.Infof("pattern sync source successfully deleted from remote provider")
+		return bdr, nil
+	}
+	return nil, ErrDelete(fmt.Errorf("could not delete pattern sync source from remote provider"), fmt.Sprint(bdr), resp.StatusCode)
+}
+
 // SaveMesheryPattern saves given pattern with the provider
 func (l *RemoteProvider) SaveMesheryPattern(tokenString string, pattern *MesheryPattern) ([]byte, error) {
 	if !l.Capabilities.IsSupported(PersistMesheryPatterns) {
@@ -1,6 +1,15 @@
 package models
 
-import "github.com/gofrs/uuid"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/robfig/cron/v3"
+)
 
 // API response model for SchedulesAPI
 type SchedulesAPIResponse struct {
@@ -12,11 +21,169 @@ type SchedulesAPIResponse struct {
 
 // Schedule is the struct for representing schedules
 type Schedule struct {
-	ID uuid.UUID `json:"id,omitempty"`
+	ID *uuid.UUID `json:"id,omitempty"`
 
 	// CronExpression is the UNIX cron expression (quartz expression)
 	//
 	// Example:
 	// 	0 15 5 ? * WED,SUN *
 	CronExpression string `json:"cron_expression,omitempty"`
+
+	// UserID is the user who created the schedule, the scheduled runs are run as this user
+	UserID string `json:"user_id,omitempty"`
+	// ContextIDs restricts the scheduled runs to the kubernetes contexts with the given ids,
+	// the allow-list of the API token the schedule was saved with by default. The runs use
+	// every context of the user if empty
+	ContextIDs pq.StringArray `json:"context_ids,omitempty" gorm:"type:text[]"`
+
+	// Runs holds the most recent runs of the schedule, only populated
+	// by the local provider
+	Runs []ScheduleRun `json:"runs,omitempty" gorm:"-"`
+
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// ScheduleRunStatus represents the outcome of a single scheduled run
+type ScheduleRunStatus string
+
+const (
+	// ScheduleRunRunning - the run has started and has not finished yet
+	ScheduleRunRunning ScheduleRunStatus = "running"
+
+	// ScheduleRunSuccess - the linked performance profile ran successfully
+	ScheduleRunSuccess ScheduleRunStatus = "success"
+
+	// ScheduleRunFailed - the linked performance profile failed to run
+	ScheduleRunFailed ScheduleRunStatus = "failed"
+
+	// ScheduleRunSkipped - the run was skipped because the previous run
+	// of the same performance profile was still in progress
+	ScheduleRunSkipped ScheduleRunStatus = "skipped"
+)
+
+// ScheduleRun records a single run of a performance profile that was
+// triggered by a schedule
+type ScheduleRun struct {
+	ID                 *uuid.UUID        `json:"id,omitempty"`
+	ScheduleID         *uuid.UUID        `json:"schedule_id,omitempty"`
+	PerformanceProfile *uuid.UUID        `json:"performance_profile,omitempty"`
+	Status             ScheduleRunStatus `json:"status,omitempty"`
+	Message            string            `json:"message,omitempty"`
+	ResultIDs          string            `json:"result_ids,omitempty"`
+
+	StartedAt  *time.Time `json:"started_at,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+}
+
+// quartzParser parses the seconds based six field expressions that
+// remain after the quartz year field has been stripped
+var quartzParser = cron.NewParser(
+	cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// ParseCronExpression parses the given quartz cron expression
+//
+// Quartz expressions have six or seven fields: seconds, minutes, hours,
+// day of month, month, day of week and an optional year. The year field
+// is only supported as a wildcard. Unlike UNIX cron, quartz numbers the
+// days of the week from 1 (SUN) to 7 (SAT).
+func ParseCronExpression(expr string) (cron.Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "@") {
+		sched, err := quartzParser.Parse(expr)
+		if err != nil {
+			return nil, ErrCronExpression(err, expr)
+		}
+		return sched, nil
+	}
+
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 6:
+	case 7:
+		if fields[6] != "*" && fields[6] != "?" {
+			return nil, ErrCronExpression(fmt.Errorf("only a wildcard is supported in the year field"), expr)
+		}
+		fields = fields[:6]
+	default:
+		return nil, ErrCronExpression(fmt.Errorf("expected 6 or 7 fields, found %d", len(fields)), expr)
+	}
+
+	dow, err := quartzToCronDow(fields[5])
+	if err != nil {
+		return nil, ErrCronExpression(err, expr)
+	}
+	fields[5] = dow
+
+	sched, err := quartzParser.Parse(strings.Join(fields, " "))
+	if err != nil {
+		return nil, ErrCronExpression(err, expr)
+	}
+	return sched, nil
+}
+
+// quartzToCronDow shifts the numeric day of week values in a quartz
+// day of week field (1-7) to the zero based values cron expects (0-6)
+func quartzToCronDow(field string) (string, error) {
+	var b strings.Builder
+	num := ""
+	flush := func(isStep bool) error {
+		if num == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return err
+		}
+		if !isStep {
+			if n < 1 || n > 7 {
+				return fmt.Errorf("day of week %d is out of range 1-7", n)
+			}
+			n--
+		}
+		b.WriteString(strconv.Itoa(n))
+		num = ""
+		return nil
+	}
+
+	isStep := false
+	for _, r := range field {
+		if r >= '0' && r <= '9' {
+			num += string(r)
+			continue
+		}
+		if err := flush(isStep); err != nil {
+			return "", err
+		}
+		isStep = r == '/'
+		b.WriteRune(r)
+	}
+	if err := flush(isStep); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// AllowsContext returns true if the scheduled runs may use the kubernetes context
+func (s *Schedule) AllowsContext(id string) bool {
+	if len(s.ContextIDs) == 0 {
+		return true
+	}
+	for _, c := range s.ContextIDs {
+		if c == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Next returns the first activation time of the schedule after the given time
+func (s *Schedule) Next(after time.Time) (time.Time, error) {
+	sched, err := ParseCronExpression(s.CronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return sched.Next(after), nil
 }
@@ -0,0 +1,150 @@
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/layer5io/meshkit/database"
+)
+
+// recentScheduleRuns is the number of runs returned along with a schedule
+const recentScheduleRuns = 10
+
+// SchedulePersister is the persister for persisting
+// schedules and their runs on the database
+type SchedulePersister struct {
+	DB *database.Handler
+}
+
+// GetSchedules returns all of the schedules
+func (sp *SchedulePersister) GetSchedules(order string, page, pageSize uint64) ([]byte, error) {
+	order = sanitizeOrderInput(order, []string{"created_at", "updated_at"})
+	if order == "" {
+		order = "updated_at desc"
+	}
+
+	count := int64(0)
+	schedules := []Schedule{}
+
+	query := sp.DB.Order(order)
+	query.Table("schedules").Count(&count)
+
+	Paginate(uint(page), uint(pageSize))(query).Find(&schedules)
+
+	schedulesPage := &SchedulesAPIResponse{
+		Page:       uint(page),
+		PageSize:   uint(pageSize),
+		TotalCount: uint(count),
+		Schedules:  schedules,
+	}
+
+	return marshalSchedulesPage(schedulesPage), nil
+}
+
+// GetSchedule returns the schedule with the given id along with its recent runs
+func (sp *SchedulePersister) GetSchedule(id uuid.UUID) ([]byte, error) {
+	var schedule Schedule
+
+	if err := sp.DB.First(&schedule, id).Error; err != nil {
+		return nil, err
+	}
+
+	runs, err := sp.GetScheduleRuns(id, recentScheduleRuns)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Runs = runs
+
+	return marshalSchedule(&schedule), nil
+}
+
+// GetAllSchedules returns every persisted schedule
+func (sp *SchedulePersister) GetAllSchedules() ([]Schedule, error) {
+	schedules := []Schedule{}
+	err := sp.DB.Find(&schedules).Error
+	return schedules, err
+}
+
+// SaveSchedule validates the cron expression and saves the schedule
+func (sp *SchedulePersister) SaveSchedule(schedule *Schedule) ([]byte, error) {
+	if _, err := ParseCronExpression(schedule.CronExpression); err != nil {
+		return nil, err
+	}
+
+	if schedule.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, ErrGenerateUUID(err)
+		}
+
+		schedule.ID = &id
+	} else {
+		existing := Schedule{}
+		if err := sp.DB.Where("id = ?", *schedule.ID).First(&existing).Error; err == nil && existing.UserID != "" {
+			// the owner of the schedule is kept across its updates
+			schedule.UserID = existing.UserID
+		}
+	}
+
+	return marshalSchedule(schedule), sp.DB.Save(schedule).Error
+}
+
+// DeleteSchedule takes in a schedule id and delete it if it already exists
+func (sp *SchedulePersister) DeleteSchedule(id uuid.UUID) ([]byte, error) {
+	schedule := Schedule{ID: &id}
+	if err := sp.DB.Delete(&schedule).Error; err != nil {
+		return nil, err
+	}
+
+	return marshalSchedule(&schedule), nil
+}
+
+// SaveScheduleRun creates or updates the given schedule run
+func (sp *SchedulePersister) SaveScheduleRun(run *ScheduleRun) error {
+	if run.ID == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return ErrGenerateUUID(err)
+		}
+
+		run.ID = &id
+	}
+
+	return sp.DB.Save(run).Error
+}
+
+// GetScheduleRuns returns the latest runs of the schedule with the given id
+func (sp *SchedulePersister) GetScheduleRuns(scheduleID uuid.UUID, limit int) ([]ScheduleRun, error) {
+	runs := []ScheduleRun{}
+	err := sp.DB.
+		Where("schedule_id = ?", scheduleID).
+		Order("started_at desc").
+		Limit(limit).
+		Find(&runs).Error
+
+	return runs, err
+}
+
+// AbortRunningScheduleRuns marks the runs which were left in the running state,
+// for instance by a server restart, as failed
+func (sp *SchedulePersister) AbortRunningScheduleRuns() error {
+	return sp.DB.
+		Model(&ScheduleRun{}).
+		Where("status = ?", ScheduleRunRunning).
+		Updates(map[string]interface{}{
+			"status":  ScheduleRunFailed,
+			"message": "interrupted by a server restart",
+		}).Error
+}
+
+func marshalSchedulesPage(sp *SchedulesAPIResponse) []byte {
+	res, _ := json.Marshal(sp)
+
+	return res
+}
+
+func marshalSchedule(s *Schedule) []byte {
+	res, _ := json.Marshal(s)
+
+	return res
+}
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	// Monday, 17 October 2022
+	from := time.Date(2022, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		expr    string
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "quartz expression with year and named days",
+			expr: "0 15 5 ? * WED,SUN *",
+			want: time.Date(2022, time.October, 19, 5, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "quartz expression without year",
+			expr: "30 0 12 * * ?",
+			want: time.Date(2022, time.October, 17, 12, 0, 30, 0, time.UTC),
+		},
+		{
+			name: "numeric day of week starts at sunday",
+			expr: "0 0 9 ? * 1",
+			want: time.Date(2022, time.October, 23, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "numeric day of week range",
+			expr: "0 0 9 ? * 3-4",
+			want: time.Date(2022, time.October, 18, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "descriptor",
+			expr: "@hourly",
+			want: time.Date(2022, time.October, 17, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "unix expression with five fields",
+			expr:    "0 5 * * *",
+			wantErr: true,
+		},
+		{
+			name:    "specific year",
+			expr:    "0 0 0 1 1 ? 2030",
+			wantErr: true,
+		},
+		{
+			name:    "day of week out of range",
+			expr:    "0 0 0 ? * 8",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Schedule{CronExpression: tt.expr}
+			got, err := s.Next(from)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Next() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
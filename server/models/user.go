@@ -22,4 +22,6 @@ type User struct {
 	Provider  string `json:"provider,omitempty" db:"provider"`
 	Email     string `json:"email,omitempty" db:"email"`
 	Bio       string `json:"bio,omitempty" db:"bio"`
+	// Role is the role of the user of the local provider, empty for the users of remote providers
+	Role string `json:"role,omitempty"`
 }
@@ -2,6 +2,7 @@ package router
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 
@@ -22,6 +23,7 @@ type Router struct {
 // NewRouter returns a new ServeMux with app routes.
 func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.Handler, gp http.Handler) *Router {
 	gMux := mux.NewRouter()
+	gMux.Use(h.AuditMiddleware)
 
 	gMux.Handle("/api/system/graphql/query", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.MesheryControllersMiddleware(h.GraphqlMiddleware(g))))))).Methods("GET", "POST")
 	gMux.Handle("/api/system/graphql/playground", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.MesheryControllersMiddleware(h.GraphqlMiddleware(gp))))))).Methods("GET", "POST")
@@ -53,6 +55,24 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 
 	gMux.Handle("/api/user", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/users", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetLocalUsersHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/users", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveLocalUserHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/users/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UpdateLocalUserHandler)))).
+		Methods("PUT")
+	gMux.Handle("/api/users/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteLocalUserHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/user/tokens", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetAPITokensHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/tokens", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SaveAPITokenHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/user/tokens/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteAPITokenHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/system/audit", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetAuditEventsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/system/audit/verify", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.VerifyAuditLogHandler)))).
+		Methods("GET")
 	gMux.Handle("/api/user/prefs", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.UserPrefsHandler)))).
 		Methods("GET", "POST")
 
@@ -79,6 +99,12 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/perf/profile/result/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetResultHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/perf/profile/result/{id}/export", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExportResultHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/perf/run", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetLoadTestRunsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/perf/run/{uuid}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.CancelLoadTestHandler)))).
+		Methods("DELETE")
 	gMux.Handle("/api/mesh", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSMPServiceMeshes)))).
 		Methods("GET")
 
@@ -130,6 +156,24 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("POST", "GET")
 	gMux.Handle("/api/pattern/catalog", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetCatalogMesheryPatternsHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/pattern/deployments", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternDeploymentsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/deployments/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternDeploymentHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/deployments/{id}/drift", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.GetPatternDriftHandler))))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/deployments/{id}/reconcile", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.ReconcilePatternDeploymentHandler))))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/sync", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.SavePatternSyncSourceHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/pattern/sync", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternSyncSourcesHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/sync/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetPatternSyncSourceHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/pattern/sync/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeletePatternSyncSourceHandler)))).
+		Methods("DELETE")
+	gMux.Handle("/api/pattern/{id}/export", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternExportHandler)))).
+		Methods("GET")
 	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetMesheryPatternHandler)))).
 		Methods("GET")
 	gMux.Handle("/api/pattern/{id}", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMesheryPatternHandler)))).
@@ -138,12 +182,14 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("POST")
 	gMux.Handle("/api/patterns/delete", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.DeleteMultiMesheryPatternsHandler)))).
 		Methods("POST")
-	gMux.HandleFunc("/api/oam/{type}", h.OAMRegisterHandler).Methods("GET", "POST")
+	gMux.HandleFunc("/api/oam/{type}", h.OAMRegisterHandler).Methods("GET")
+	gMux.Handle("/api/oam/{type}", h.AdapterAuthMiddleware(http.HandlerFunc(h.OAMRegisterHandler))).Methods("POST")
 	gMux.HandleFunc("/api/oam/{type}/{name}", h.OAMComponentDetailsHandler).Methods("GET")
 	gMux.HandleFunc("/api/oam/{type}/{name}/{id}", h.OAMComponentDetailByIDHandler).Methods("GET")
-	gMux.HandleFunc("/api/oam/{type}", h.OAMRegisterHandler).Methods("GET", "POST")
-	gMux.HandleFunc("/api/meshmodel/validate", h.ValidationHandler).Methods("POST")
-	gMux.HandleFunc("/api/meshmodel/component/generate", h.ComponentGenerationHandler).Methods("POST")
+	gMux.Handle("/api/meshmodel/validate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ValidationHandler)))).
+		Methods("POST")
+	gMux.Handle("/api/meshmodel/component/generate", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ComponentGenerationHandler)))).
+		Methods("POST")
 
 	gMux.Handle("/api/filter/deploy", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.FilterFileHandler))))).
 		Methods("POST", "DELETE")
@@ -189,6 +235,12 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		Methods("GET")
 	gMux.Handle("/api/user/performance/profiles/{id}/results", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.FetchResultsHandler)))).
 		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/results/export", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ExportProfileResultsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/compare", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.ComparePerformanceResultsHandler)))).
+		Methods("GET")
+	gMux.Handle("/api/user/performance/profiles/{id}/overhead", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.KubernetesMiddleware(h.MeshOverheadBenchmarkHandler))))).
+		Methods("POST")
 
 	gMux.Handle("/api/user/schedules", h.ProviderMiddleware(h.AuthMiddleware(h.SessionInjectorMiddleware(h.GetSchedulesHandler)))).
 		Methods("GET")
@@ -224,6 +276,15 @@ func NewRouter(ctx context.Context, h models.HandlerInterface, port int, g http.
 		}
 		h.LoginHandler(w, req, provider, false)
 	})))
+	gMux.Handle("/api/user/login", h.ProviderMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		providerI := req.Context().Value(models.ProviderCtxKey)
+		provider, ok := providerI.(models.Provider)
+		if !ok {
+			http.Redirect(w, req, "/provider", http.StatusFound)
+			return
+		}
+		h.LocalLoginHandler(w, req, provider)
+	}))).Methods("POST")
 	gMux.Handle("/api/user/token", h.ProviderMiddleware(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		providerI := req.Context().Value(models.ProviderCtxKey)
 		provider, ok := providerI.(models.Provider)
@@ -275,3 +336,14 @@ func (r *Router) Run() error {
 	// return s.ListenAndServe()
 	return http.ListenAndServe(fmt.Sprintf(":%d", r.port), r.S)
 }
+
+// RunTLS listens over TLS with the given certificate, the config tells how the client
+// certificates are verified
+func (r *Router) RunTLS(certFile, keyFile string, config *tls.Config) error {
+	s := &http.Server{
+		Addr:      fmt.Sprintf(":%d", r.port),
+		Handler:   r.S,
+		TLSConfig: config,
+	}
+	return s.ListenAndServeTLS(certFile, keyFile)
+}
@@ -485,6 +485,17 @@ class MesheryPerformanceComponent extends React.Component {
             track++;
           }
           break;
+        case "progress":
+          self.props.enqueueSnackbar(data.message, {
+            variant : "info",
+            autoHideDuration : 2000,
+            action : (key) => (
+              <IconButton key="close" aria-label="Close" color="inherit" onClick={() => self.props.closeSnackbar(key)}>
+                <CloseIcon />
+              </IconButton>
+            ),
+          });
+          break;
         case "error":
           self.handleError("Load test did not run successfully with msg")(data.message);
           break;